@@ -0,0 +1,87 @@
+package cancelall
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/config"
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/spf13/cobra"
+)
+
+const (
+	usage = "cancel-all"
+	short = "Cancels the open orders of a running adapter."
+	long  = `Cancels the open orders of a running adapter.
+
+Calls the /orders/cancel-all API of the adapter, which sends an
+OrderMassCancelRequest for the symbol and side, or every open order without
+them. Orders of an account are canceled one by one.`
+)
+
+var (
+	// Cmd is the executor command.
+	Cmd = &cobra.Command{
+		Use:   usage,
+		Short: short,
+		Long:  long,
+		Example: `  waanx-adapter cancel-all
+  waanx-adapter cancel-all --symbol BTC-USDT --side buy
+  waanx-adapter cancel-all --account A1 --addr localhost:8080`,
+		Args: cobra.NoArgs,
+		RunE: execute,
+	}
+
+	addr  string
+	scope domain.OrderScope
+	side  string
+)
+
+func init() {
+	Cmd.Flags().StringVar(&addr, "addr", "", "address of the adapter API, admin.addr by default")
+	Cmd.Flags().StringVar(&scope.Account, "account", "", "only cancel the orders of this account")
+	Cmd.Flags().StringVar(&scope.Symbol, "symbol", "", "only cancel the orders of this symbol")
+	Cmd.Flags().StringVar(&side, "side", "", "only cancel the orders of this side, buy or sell")
+}
+
+func execute(cmd *cobra.Command, args []string) error {
+	switch s := domain.Side(strings.ToUpper(side)); s {
+	case "", domain.SideBuy, domain.SideSell:
+		scope.Side = s
+	default:
+		return fmt.Errorf("invalid side %q", side)
+	}
+	if addr == "" {
+		addr = config.GetConfig().Admin.Addr
+	}
+	if addr == "" {
+		return errors.New("the adapter API is disabled, set admin.addr or --addr")
+	}
+
+	body, err := json.Marshal(scope)
+	if err != nil {
+		return err
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Post("http://"+addr+"/orders/cancel-all", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error calling the adapter: %w", err)
+	}
+	defer resp.Body.Close()
+
+	out, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading the response: %w", err)
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("cancel all failed with %s: %s", resp.Status, bytes.TrimSpace(out))
+	}
+	fmt.Fprint(cmd.OutOrStdout(), string(out))
+	return nil
+}
@@ -1,7 +1,11 @@
 package cmd
 
 import (
+	cancelall "github.com/phimaker/waanx-fix-simpler/cmd/cancel-all"
+	"github.com/phimaker/waanx-fix-simpler/cmd/console"
+	"github.com/phimaker/waanx-fix-simpler/cmd/fixlog"
 	marketdata "github.com/phimaker/waanx-fix-simpler/cmd/market-data"
+	"github.com/phimaker/waanx-fix-simpler/cmd/replay"
 	"github.com/phimaker/waanx-fix-simpler/internal/version"
 	"github.com/spf13/cobra"
 )
@@ -28,6 +32,10 @@ func Execute() error {
 	c.Flags().BoolVarP(&versionF, "version", "v", false, "show the version and exit")
 
 	c.AddCommand(marketdata.Cmd)
+	c.AddCommand(fixlog.Cmd)
+	c.AddCommand(replay.Cmd)
+	c.AddCommand(console.Cmd)
+	c.AddCommand(cancelall.Cmd)
 
 	return c.Execute()
 }
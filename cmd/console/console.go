@@ -0,0 +1,208 @@
+package console
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/config"
+	"github.com/phimaker/waanx-fix-simpler/internal/idgen"
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/fix"
+	"github.com/phimaker/waanx-fix-simpler/internal/logger"
+	"github.com/phimaker/waanx-fix-simpler/internal/service"
+	"github.com/phimaker/waanx-fix-simpler/internal/throttle"
+	"github.com/quickfixgo/enum"
+	"github.com/quickfixgo/quickfix"
+	"github.com/spf13/cobra"
+)
+
+const (
+	usage = "console"
+	short = "Opens an interactive FIX console on a live session."
+	long  = `Opens an interactive FIX console on a live session.
+
+Logs on with the normal session config, then reads messages from stdin, either
+as raw fields (35=1|112=TEST) or as a named template (testrequest), sends them
+and prints every response as it arrives. Type "help" for the list of templates.`
+)
+
+var (
+	// Cmd is the executor command.
+	Cmd = &cobra.Command{
+		Use:     usage,
+		Short:   short,
+		Long:    long,
+		Example: "waanx-adapter console --heartbeats",
+		RunE:    execute,
+	}
+
+	logLevel   string
+	heartbeats bool
+	logonWait  time.Duration
+)
+
+func init() {
+	Cmd.Flags().StringVar(&logLevel, "log-level", "warn", "console log level while the REPL runs")
+	Cmd.Flags().BoolVar(&heartbeats, "heartbeats", false, "also print heartbeats")
+	Cmd.Flags().DurationVar(&logonWait, "logon-timeout", 30*time.Second, "how long to wait for the session to log on")
+}
+
+type console struct {
+	mu        sync.Mutex
+	out       io.Writer
+	decoder   *fix.Decoder
+	ids       *idgen.Generator
+	throttle  *throttle.Throttler
+	sessionID quickfix.SessionID
+}
+
+func execute(cmd *cobra.Command, args []string) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	if err := logger.SetLevel(logLevel); err != nil {
+		return err
+	}
+
+	cfg := config.GetConfig()
+	dd, err := fix.LoadDataDictionary(cfg.Fix.DataDictionary)
+	if err != nil {
+		return err
+	}
+	c := &console{out: cmd.OutOrStdout(), decoder: fix.NewDecoder(dd, cfg.Fix.MaskTags...)}
+
+	srv, err := service.NewServices(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer srv.Close()
+	c.ids, c.throttle = srv.IDs, srv.Throttle
+	srv.Fix.Application().AddObserver(c.observe)
+
+	if err := srv.Fix.Start(ctx); err != nil {
+		return err
+	}
+	defer srv.Fix.Stop()
+
+	c.printf("Waiting for logon...\n")
+	select {
+	case c.sessionID = <-srv.Fix.OnLoggedOn():
+	case <-time.After(logonWait):
+		return fmt.Errorf("session did not log on within %s", logonWait)
+	case <-ctx.Done():
+		return nil
+	}
+	c.printf("Logged on %s, type \"help\" for commands\n", c.sessionID)
+
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(cmd.InOrStdin())
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+
+	for {
+		c.printf("> ")
+		select {
+		case <-ctx.Done():
+			return nil
+		case line, ok := <-lines:
+			if !ok {
+				return nil
+			}
+			if quit := c.handle(ctx, strings.TrimSpace(line)); quit {
+				return nil
+			}
+		}
+	}
+}
+
+func (c *console) handle(ctx context.Context, line string) (quit bool) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return false
+	}
+
+	var text string
+	switch name := fields[0]; {
+	case name == "quit" || name == "exit":
+		return true
+	case name == "help":
+		c.help()
+		return false
+	case strings.Contains(name, "="):
+		text = line
+	default:
+		t, ok := templates[name]
+		if !ok {
+			c.printf("unknown command %q, type \"help\"\n", name)
+			return false
+		}
+		id, err := c.ids.Next(idgen.KindConsole)
+		if err != nil {
+			c.printf("error: %v\n", err)
+			return false
+		}
+		if text, err = t.expand(id, fields[1:]); err != nil {
+			c.printf("error: %v\n", err)
+			return false
+		}
+	}
+
+	msg, err := fix.ComposeMessage(c.decoder.DataDictionary(), text)
+	if err != nil {
+		c.printf("error: %v\n", err)
+		return false
+	}
+	if err := c.throttle.Send(ctx, msg, c.sessionID); err != nil {
+		c.printf("error sending message: %v\n", err)
+	}
+	return false
+}
+
+func (c *console) help() {
+	c.printf("Commands:\n")
+	c.printf("  35=<type>|<tag>=<value>|...  send raw fields, session fields are filled in\n")
+	for _, name := range templateNames() {
+		c.printf("  %-28s %s\n", name, templates[name].help)
+	}
+	c.printf("  %-28s %s\n", "quit", "log out and exit")
+}
+
+// observe prints every message of the session as it is sent or received.
+func (c *console) observe(direction string, msg *quickfix.Message, sessionID quickfix.SessionID) {
+	if msgType, err := msg.MsgType(); err == nil && !heartbeats && enum.MsgType(msgType) == enum.MsgType_HEARTBEAT {
+		return
+	}
+	m, err := c.decoder.Decode(msg)
+	if err != nil {
+		c.printf("\n%s undecodable message: %v\n", arrow(direction), err)
+		return
+	}
+	name := m.MsgTypeName
+	if name == "" {
+		name = m.MsgType
+	}
+	c.printf("\n%s %s %s\n", arrow(direction), name, m.Pretty())
+}
+
+func (c *console) printf(format string, args ...any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fmt.Fprintf(c.out, format, args...)
+}
+
+func arrow(direction string) string {
+	if direction == fix.DirectionOut {
+		return ">>"
+	}
+	return "<<"
+}
@@ -0,0 +1,83 @@
+package console
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// template is a named message with {placeholders} filled from key=value
+// arguments. {id} defaults to a fresh request ID.
+type template struct {
+	help     string
+	text     string
+	defaults map[string]string
+}
+
+var templates = map[string]template{
+	"testrequest": {
+		help: "TestRequest (1)",
+		text: "35=1|112={id}",
+	},
+	"securitylist": {
+		help: "SecurityListRequest (x) for all securities",
+		text: "35=x|320={id}|559=4",
+	},
+	"securitystatus": {
+		help:     "SecurityStatusRequest (e), args: symbol= [subscription=0|1|2]",
+		text:     "35=e|324={id}|55={symbol}|263={subscription}",
+		defaults: map[string]string{"subscription": "0"},
+	},
+	"marketdata": {
+		help:     "MarketDataRequest (V) for bids and offers, args: symbol= [depth=0] [subscription=1]",
+		text:     "35=V|262={id}|263={subscription}|264={depth}|265=1|267=2|269=0|269=1|146=1|55={symbol}",
+		defaults: map[string]string{"subscription": "1", "depth": "0"},
+	},
+	"tradingsession": {
+		help:     "TradingSessionStatusRequest (g), args: [subscription=1]",
+		text:     "35=g|335={id}|263={subscription}",
+		defaults: map[string]string{"subscription": "1"},
+	},
+}
+
+var placeholder = regexp.MustCompile(`\{([a-z]+)\}`)
+
+// expand fills the template with args given as key=value, {id} with id
+// unless given.
+func (t template) expand(id string, args []string) (string, error) {
+	values := map[string]string{"id": id}
+	for k, v := range t.defaults {
+		values[k] = v
+	}
+	for _, arg := range args {
+		k, v, ok := strings.Cut(arg, "=")
+		if !ok {
+			return "", fmt.Errorf("invalid argument %q, expected key=value", arg)
+		}
+		values[k] = v
+	}
+
+	var missing []string
+	text := placeholder.ReplaceAllStringFunc(t.text, func(m string) string {
+		key := m[1 : len(m)-1]
+		v, ok := values[key]
+		if !ok {
+			missing = append(missing, key)
+		}
+		return v
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("missing arguments: %s", strings.Join(missing, ", "))
+	}
+	return text, nil
+}
+
+func templateNames() []string {
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
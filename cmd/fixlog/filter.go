@@ -0,0 +1,142 @@
+package fixlog
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/fix"
+	"github.com/quickfixgo/quickfix/datadictionary"
+	"github.com/quickfixgo/tag"
+)
+
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.000",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+type filterOptions struct {
+	msgTypes []string
+	symbols  []string
+	clOrdIDs []string
+	session  string
+	since    string
+	until    string
+}
+
+type filter struct {
+	msgTypes map[string]bool
+	symbols  map[string]bool
+	clOrdIDs map[string]bool
+	session  string
+	since    time.Time
+	until    time.Time
+}
+
+func newFilter(dd *datadictionary.DataDictionary, o filterOptions) (*filter, error) {
+	f := &filter{
+		msgTypes: set(o.msgTypes),
+		symbols:  set(o.symbols),
+		clOrdIDs: set(o.clOrdIDs),
+		session:  o.session,
+	}
+
+	// Accept message names as well as MsgType codes.
+	for _, def := range dd.Messages {
+		if f.msgTypes[def.Name] {
+			f.msgTypes[def.MsgType] = true
+		}
+	}
+
+	var err error
+	if f.since, err = parseTime(o.since); err != nil {
+		return nil, err
+	}
+	if f.until, err = parseTime(o.until); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *filter) match(e fix.LogEntry, m *fix.DecodedMessage) bool {
+	if len(f.msgTypes) > 0 && !f.msgTypes[m.MsgType] {
+		return false
+	}
+	if !f.since.IsZero() && e.Time.Before(f.since) {
+		return false
+	}
+	if !f.until.IsZero() && !e.Time.Before(f.until) {
+		return false
+	}
+	if f.session != "" && !matchSession(f.session, m) {
+		return false
+	}
+	if len(f.symbols) > 0 && !anyValue(m.Fields, int(tag.Symbol), f.symbols) {
+		return false
+	}
+	if len(f.clOrdIDs) > 0 &&
+		!anyValue(m.Fields, int(tag.ClOrdID), f.clOrdIDs) &&
+		!anyValue(m.Fields, int(tag.OrigClOrdID), f.clOrdIDs) {
+		return false
+	}
+	return true
+}
+
+// matchSession matches messages in both directions of a session.
+func matchSession(session string, m *fix.DecodedMessage) bool {
+	sender, target, ok := strings.Cut(session, "->")
+	if !ok {
+		return m.SenderCompID == session || m.TargetCompID == session
+	}
+	return (m.SenderCompID == sender && m.TargetCompID == target) ||
+		(m.SenderCompID == target && m.TargetCompID == sender)
+}
+
+func anyValue(fields []fix.DecodedField, t int, values map[string]bool) bool {
+	for _, f := range fields {
+		if f.Tag == t && values[f.Value] {
+			return true
+		}
+		for _, g := range f.Groups {
+			if anyValue(g, t, values) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sendingTime(m *fix.DecodedMessage) time.Time {
+	v, ok := m.Get(int(tag.SendingTime))
+	if !ok {
+		return time.Time{}
+	}
+	for _, layout := range []string{"20060102-15:04:05.000", "20060102-15:04:05"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q", s)
+}
+
+func set(values []string) map[string]bool {
+	m := make(map[string]bool, len(values))
+	for _, v := range values {
+		m[v] = true
+	}
+	return m
+}
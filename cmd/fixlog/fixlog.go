@@ -0,0 +1,115 @@
+package fixlog
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/fix"
+	"github.com/spf13/cobra"
+)
+
+const (
+	usage = "fixlog [file]"
+	short = "Decodes and filters FIX message logs."
+	long  = `Decodes and filters FIX message logs.
+
+Reads quickfix message logs, the zap message logs under FileLogPath or the
+adapter's JSON logs (with log.fix-format=raw) from a file or stdin, decodes
+each message with the data dictionary and prints it as a table or JSON.`
+)
+
+var (
+	// Cmd is the executor command.
+	Cmd = &cobra.Command{
+		Use:   usage,
+		Short: short,
+		Long:  long,
+		Example: `  waanx-adapter fixlog logs/FIX.4.4-999-waanx.messages.current.log --msg-type W --symbol BTC-USDT
+  waanx-adapter fixlog -f logs/FIX.4.4-999-waanx.messages.current.log --since "2024-06-01 09:00:00"
+  cat messages.log | waanx-adapter fixlog -o json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: execute,
+	}
+
+	dictPath string
+	output   string
+	follow   bool
+	opts     filterOptions
+)
+
+func init() {
+	Cmd.Flags().StringVarP(&dictPath, "dict", "d", "", "path to the data dictionary, defaults to the embedded waanx FIX44 dictionary")
+	Cmd.Flags().StringVarP(&output, "output", "o", "table", "output format: table or json")
+	Cmd.Flags().BoolVarP(&follow, "follow", "f", false, "keep reading as the file grows, like tail -f")
+	Cmd.Flags().StringSliceVarP(&opts.msgTypes, "msg-type", "t", nil, "only messages of these types, by code (W) or name (MarketDataSnapshotFullRefresh)")
+	Cmd.Flags().StringSliceVarP(&opts.symbols, "symbol", "s", nil, "only messages for these symbols")
+	Cmd.Flags().StringSliceVar(&opts.clOrdIDs, "clordid", nil, "only messages with these ClOrdID or OrigClOrdID")
+	Cmd.Flags().StringVar(&opts.session, "session", "", "only messages of this session, as SENDER->TARGET or a single CompID")
+	Cmd.Flags().StringVar(&opts.since, "since", "", "only messages at or after this time (RFC3339, \"2006-01-02 15:04:05\" or \"2006-01-02\", UTC)")
+	Cmd.Flags().StringVar(&opts.until, "until", "", "only messages before this time")
+}
+
+func execute(cmd *cobra.Command, args []string) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	dd, err := fix.LoadDataDictionary(dictPath)
+	if err != nil {
+		return err
+	}
+	decoder := fix.NewDecoder(dd)
+
+	f, err := newFilter(dd, opts)
+	if err != nil {
+		return err
+	}
+
+	p, err := newPrinter(output, cmd.OutOrStdout())
+	if err != nil {
+		return err
+	}
+
+	var in io.Reader = os.Stdin
+	if len(args) == 1 && args[0] != "-" {
+		file, err := os.Open(args[0])
+		if err != nil {
+			return fmt.Errorf("error opening log file(%s): %w", args[0], err)
+		}
+		defer file.Close()
+		in = file
+		if follow {
+			in = newFollowReader(ctx, file)
+		}
+	}
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		entry, ok := fix.ParseLogLine(scanner.Text())
+		if !ok {
+			continue
+		}
+		m, err := decoder.DecodeRaw(entry.Raw)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "error decoding %q: %v\n", entry.Raw, err)
+			continue
+		}
+		if entry.Time.IsZero() {
+			entry.Time = sendingTime(m)
+		}
+		if !f.match(entry, m) {
+			continue
+		}
+		if err := p.print(entry, m); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("error reading log: %w", err)
+	}
+	return nil
+}
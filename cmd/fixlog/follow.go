@@ -0,0 +1,48 @@
+package fixlog
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+)
+
+const followInterval = 250 * time.Millisecond
+
+// followReader keeps reading a file past EOF until ctx is done. When the file
+// is truncated, e.g. after log rotation, it starts over from the beginning.
+type followReader struct {
+	ctx    context.Context
+	file   *os.File
+	offset int64
+}
+
+func newFollowReader(ctx context.Context, file *os.File) *followReader {
+	return &followReader{ctx: ctx, file: file}
+}
+
+func (r *followReader) Read(p []byte) (int, error) {
+	for {
+		n, err := r.file.Read(p)
+		r.offset += int64(n)
+		if err != io.EOF {
+			return n, err
+		}
+		if n > 0 {
+			return n, nil
+		}
+
+		select {
+		case <-r.ctx.Done():
+			return 0, io.EOF
+		case <-time.After(followInterval):
+		}
+
+		if fi, err := r.file.Stat(); err == nil && fi.Size() < r.offset {
+			if _, err := r.file.Seek(0, io.SeekStart); err != nil {
+				return 0, err
+			}
+			r.offset = 0
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package fixlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/fix"
+	"github.com/quickfixgo/tag"
+)
+
+// Framing fields carry no information once a message is decoded.
+var skipTags = map[int]bool{
+	int(tag.BeginString): true,
+	int(tag.BodyLength):  true,
+	int(tag.CheckSum):    true,
+}
+
+type printer interface {
+	print(e fix.LogEntry, m *fix.DecodedMessage) error
+}
+
+func newPrinter(format string, w io.Writer) (printer, error) {
+	switch format {
+	case "table":
+		return &tablePrinter{w: w}, nil
+	case "json":
+		return &jsonPrinter{enc: json.NewEncoder(w)}, nil
+	}
+	return nil, fmt.Errorf("unknown output format %q", format)
+}
+
+type tablePrinter struct {
+	w io.Writer
+}
+
+func (p *tablePrinter) print(e fix.LogEntry, m *fix.DecodedMessage) error {
+	name := m.MsgType
+	if m.MsgTypeName != "" {
+		name = fmt.Sprintf("%s(%s)", m.MsgTypeName, m.MsgType)
+	}
+	fmt.Fprintf(p.w, "%s %-3s %s seq=%d %s->%s\n",
+		e.Time.UTC().Format(time.RFC3339Nano), e.Direction, name, m.SeqNum, m.SenderCompID, m.TargetCompID)
+
+	tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+	writeRows(tw, m.Fields, 1)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(p.w)
+	return err
+}
+
+func writeRows(w io.Writer, fields []fix.DecodedField, depth int) {
+	indent := strings.Repeat("  ", depth)
+	for _, f := range fields {
+		if skipTags[f.Tag] {
+			continue
+		}
+		value := f.Value
+		if f.Enum != "" {
+			value = fmt.Sprintf("%s (%s)", f.Value, f.Enum)
+		}
+		fmt.Fprintf(w, "%s%d\t%s\t%s\n", indent, f.Tag, f.Name, value)
+		for i, g := range f.Groups {
+			fmt.Fprintf(w, "%s  #%d\t\t\n", indent, i+1)
+			writeRows(w, g, depth+2)
+		}
+	}
+}
+
+type jsonPrinter struct {
+	enc *json.Encoder
+}
+
+type jsonEntry struct {
+	Time         time.Time      `json:"time"`
+	Direction    string         `json:"direction,omitempty"`
+	MsgType      string         `json:"msgType"`
+	MsgTypeName  string         `json:"msgTypeName,omitempty"`
+	SeqNum       int            `json:"seqNum"`
+	SenderCompID string         `json:"senderCompID"`
+	TargetCompID string         `json:"targetCompID"`
+	Fields       map[string]any `json:"fields"`
+}
+
+func (p *jsonPrinter) print(e fix.LogEntry, m *fix.DecodedMessage) error {
+	return p.enc.Encode(jsonEntry{
+		Time:         e.Time,
+		Direction:    e.Direction,
+		MsgType:      m.MsgType,
+		MsgTypeName:  m.MsgTypeName,
+		SeqNum:       m.SeqNum,
+		SenderCompID: m.SenderCompID,
+		TargetCompID: m.TargetCompID,
+		Fields:       m.Map(),
+	})
+}
@@ -4,10 +4,22 @@ import (
 	"context"
 	"os"
 	"os/signal"
+	"slices"
+	"syscall"
 
+	"github.com/phimaker/waanx-fix-simpler/internal/analytics"
 	"github.com/phimaker/waanx-fix-simpler/internal/config"
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/dq"
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/api"
 	"github.com/phimaker/waanx-fix-simpler/internal/logger"
+	"github.com/phimaker/waanx-fix-simpler/internal/metrics"
+	"github.com/phimaker/waanx-fix-simpler/internal/position"
+	"github.com/phimaker/waanx-fix-simpler/internal/publisher"
+	"github.com/phimaker/waanx-fix-simpler/internal/risk"
 	"github.com/phimaker/waanx-fix-simpler/internal/service"
+	"github.com/phimaker/waanx-fix-simpler/internal/tape"
 	"github.com/quickfixgo/quickfix"
 	"github.com/spf13/cobra"
 )
@@ -31,6 +43,8 @@ var (
 
 	configPath string
 	sessionID  quickfix.SessionID
+
+	log = logger.Named(logger.ComponentMarketData)
 )
 
 func init() {
@@ -39,38 +53,208 @@ func init() {
 
 func execute(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
-	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, os.Kill)
+	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 	logger.InitLogger()
 	cfg := config.GetConfig()
+	applyLogLevel(cfg.Log)
+	config.OnChange(func(e config.ChangeEvent) {
+		if e.Changed(config.SectionLog) {
+			applyLogLevel(e.New.Log)
+		}
+	})
+	config.WatchConfig()
+	logger.WatchSignals(ctx)
 
-	heartbeatSrv := service.NewHeartbeatService()
-	securityListSrv := service.NewSecurityListService()
-
-	fixSrv, err := service.NewFIXService(cfg.Fix, heartbeatSrv, securityListSrv)
+	srv, err := service.NewServices(ctx, cfg)
 	if err != nil {
-		logger.Fatalf("error creating FIX service: %w", err)
+		log.Fatalf("error creating services: %v", err)
 	}
+	defer srv.Close()
 
-	fixSrv.RegisterRouters(ctx)
+	if cfg.Admin.Addr != "" {
+		apiSrv := api.NewServer(cfg.Admin.Addr)
+		apiSrv.Handle("/log/level", api.LogLevelHandler())
+		apiSrv.Handle("/metrics", metrics.Handler())
+		apiSrv.Handle("/tape", tape.Handler(srv.Tape))
+		apiSrv.Handle("/analytics", analytics.Handler(srv.Analytics))
+		apiSrv.Handle("/dq", dq.Handler(srv.Quality))
+		apiSrv.Handle("/orders", service.OrderHandler(srv.Orders, srv.Fix))
+		apiSrv.Handle("/orders/cancel-all", service.CancelAllHandler(srv.Orders, srv.Fix))
+		apiSrv.Handle("/orders/mass-status", service.MassStatusHandler(srv.Orders, srv.Fix))
+		apiSrv.Handle("/risk", risk.Handler(srv.Risk))
+		apiSrv.Handle("/positions", position.Handler(srv.Positions))
+		go apiSrv.Start(ctx)
+	}
+	fixSrv := srv.Fix
+	securityListSrv := srv.SecurityList
+	srv.Bus.Subscribe(event.TopicAll, func(e event.Event) {
+		log.Debugw("Event published", "topic", e.Topic(), "event", e)
+	})
+	go srv.Bars.Run(ctx)
+	go srv.Quality.Run(ctx)
+
+	pub := publisher.New(srv.Bus)
+	pub.Apply(cfg.Publisher.Targets)
+	defer pub.Close()
+	config.OnChange(func(e config.ChangeEvent) {
+		if e.Changed(config.SectionPublisher) {
+			pub.Apply(e.New.Publisher.Targets)
+		}
+	})
+	config.OnChange(func(e config.ChangeEvent) {
+		if e.Changed(config.SectionMarketData) {
+			resubscribe(ctx, srv, e.Old.MarketData, e.New.MarketData)
+		}
+	})
 
-	go fixSrv.Start(ctx)
+	if srv.Schedule != nil {
+		go srv.Schedule.Run(ctx)
+	} else if err := fixSrv.Start(ctx); err != nil {
+		log.Fatalf("error starting FIX service: %v", err)
+	}
 	defer fixSrv.Stop()
 
 	for {
 		select {
 		case sID := <-fixSrv.OnLoggedOn():
 			sessionID = sID
-			logger.Infof("Logged on: %s", sessionID)
+			log.Infof("Logged on: %s", sessionID)
 			slrId, err := securityListSrv.SecurityListRequest(ctx, sessionID)
 			if err != nil {
-				logger.Errorf("Error sending security list request: %v", err)
+				log.Errorf("Error sending security list request: %v", err)
+			}
+			log.Infof("Sent SecurityListRequest with ID: %s", slrId)
+			if _, err := srv.TradingSession.TradingSessionStatusRequest(ctx, sessionID); err != nil {
+				log.Errorf("Error sending trading session status request: %v", err)
+			}
+			mdCfg := config.GetConfig().MarketData
+			if len(mdCfg.Symbols) > 0 {
+				if _, err := srv.MarketData.MarketDataRequest(ctx, sessionID, mdCfg.Symbols, mdCfg.Depth); err != nil {
+					log.Errorf("Error sending market data request: %v", err)
+				}
+			}
+			for _, symbol := range mdCfg.Symbols {
+				if _, err := srv.SecurityStatus.SecurityStatusRequest(ctx, sessionID, symbol); err != nil {
+					log.Errorf("Error sending security status request for %s: %v", symbol, err)
+				}
+			}
+			// Orders may have been sent, filled or canceled while the
+			// adapter was down.
+			if _, err := srv.Orders.MassStatus(ctx, sessionID, domain.OrderScope{}); err != nil {
+				log.Errorf("Error sending order mass status request: %v", err)
+			}
+			if config.GetConfig().Orders.CancelOnReconnect {
+				if _, err := srv.Orders.CancelAll(ctx, sessionID, domain.OrderScope{}); err != nil {
+					log.Errorf("Error canceling open orders after logon: %v", err)
+				}
+			}
+			if posCfg := config.GetConfig().Positions; posCfg.Reconcile {
+				for _, account := range posCfg.Accounts {
+					if _, err := srv.PositionReports.RequestForPositions(ctx, sessionID, account); err != nil {
+						log.Errorf("Error sending request for positions of %s: %v", account, err)
+					}
+				}
 			}
-			logger.Infof("Sent SecurityListRequest with ID: %s", slrId)
 		case <-ctx.Done():
-			logger.Info("Shutting down market data service")
+			log.Info("Shutting down market data service")
+			if config.GetConfig().Orders.CancelOnShutdown {
+				cancelOpenOrders(srv)
+			}
 			return nil
 		}
 	}
 
 }
+
+// cancelOpenOrders cancels every open order and waits for the venue to
+// confirm, up to the cancel timeout, before the session logs out.
+func cancelOpenOrders(srv *service.Services) {
+	if len(srv.Orders.Orders(true)) == 0 {
+		return
+	}
+	sessionID, ok := srv.Fix.SessionID()
+	if !ok {
+		log.Warnf("Not logged on, %d orders may stay open at the venue", len(srv.Orders.Orders(true)))
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), config.GetConfig().Orders.CancelTimeout)
+	defer cancel()
+	if _, err := srv.Orders.CancelAll(ctx, sessionID, domain.OrderScope{}); err != nil {
+		log.Errorf("Error canceling open orders: %v", err)
+	}
+	if err := srv.Orders.AwaitClosed(ctx, domain.OrderScope{}); err != nil {
+		log.Errorf("Error waiting for the cancels: %v", err)
+		return
+	}
+	log.Info("Open orders canceled")
+}
+
+// resubscribe follows the symbols and the depth of the market-data section:
+// the symbols removed are unsubscribed and the ones added subscribed, all of
+// them again when the depth changed. Logged out, the next logon subscribes
+// with the new section.
+func resubscribe(ctx context.Context, srv *service.Services, prev, next *config.MarketData) {
+	removed, added := diffSymbols(prev.Symbols, next.Symbols)
+	if prev.Depth != next.Depth {
+		removed, added = prev.Symbols, next.Symbols
+	}
+	srv.Quality.Publish(srv.Quality.Forget(removed...))
+	srv.Quality.Watch(added...)
+
+	sessionID, ok := srv.Fix.SessionID()
+	if !ok {
+		return
+	}
+	if len(removed) > 0 {
+		if err := srv.MarketData.Unsubscribe(ctx, sessionID, removed); err != nil {
+			log.Errorf("Error unsubscribing from %v: %v", removed, err)
+		}
+	}
+	if len(added) > 0 {
+		if _, err := srv.MarketData.MarketDataRequest(ctx, sessionID, added, next.Depth); err != nil {
+			log.Errorf("Error subscribing to %v: %v", added, err)
+		}
+		for _, symbol := range added {
+			if _, err := srv.SecurityStatus.SecurityStatusRequest(ctx, sessionID, symbol); err != nil {
+				log.Errorf("Error sending security status request for %s: %v", symbol, err)
+			}
+		}
+	}
+}
+
+// diffSymbols returns the symbols of prev missing from next and the ones of
+// next missing from prev.
+func diffSymbols(prev, next []string) (removed, added []string) {
+	for _, symbol := range prev {
+		if !slices.Contains(next, symbol) {
+			removed = append(removed, symbol)
+		}
+	}
+	for _, symbol := range next {
+		if !slices.Contains(prev, symbol) {
+			added = append(added, symbol)
+		}
+	}
+	return removed, added
+}
+
+func applyLogLevel(cfg *config.Log) {
+	if cfg.Level != "" {
+		if err := logger.SetLevel(cfg.Level); err != nil {
+			log.Errorf("Invalid log level %q: %v", cfg.Level, err)
+		} else {
+			log.Infof("Log level set to %s", cfg.Level)
+		}
+	}
+	for _, name := range logger.Components() {
+		if _, ok := cfg.Components[name]; !ok {
+			logger.Named(name).ResetLevel()
+		}
+	}
+	for name, level := range cfg.Components {
+		if err := logger.Named(name).SetLevel(level); err != nil {
+			log.Errorf("Invalid log level %q for %s: %v", level, name, err)
+		}
+	}
+}
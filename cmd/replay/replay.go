@@ -0,0 +1,171 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"reflect"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/config"
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/fix"
+	"github.com/phimaker/waanx-fix-simpler/internal/logger"
+	"github.com/phimaker/waanx-fix-simpler/internal/service"
+	"github.com/spf13/cobra"
+)
+
+const (
+	usage = "replay <recording>"
+	short = "Replays a message recording through the services."
+	long  = `Replays a message recording through the services.
+
+Inbound messages captured with fix.record-path are fed to the same FIX
+application and routers as a live session, without connecting to the venue.
+The NewOrderSingle and OrderCancelRequest sent are recorded by the order
+service as if it had sent them, so the execution reports find their orders;
+the other outbound messages are not replayed. Only the configuration shaping
+the service state is used: nothing is recorded, stored, published or loaded
+from the start of day positions. The resulting service state can be checked
+against a golden file.`
+)
+
+var (
+	// Cmd is the executor command.
+	Cmd = &cobra.Command{
+		Use:   usage,
+		Short: short,
+		Long:  long,
+		Example: `  waanx-adapter replay data/session.rec --speed 10
+  waanx-adapter replay data/session.rec --golden testdata/session.golden.json
+  waanx-adapter replay data/session.rec --golden testdata/session.golden.json --update`,
+		Args: cobra.ExactArgs(1),
+		RunE: execute,
+	}
+
+	speed      float64
+	goldenPath string
+	update     bool
+
+	log = logger.Named(logger.ComponentFix)
+)
+
+func init() {
+	Cmd.Flags().Float64Var(&speed, "speed", 0, "pacing relative to the recording: 1 is real time, 10 ten times faster, 0 as fast as possible")
+	Cmd.Flags().StringVarP(&goldenPath, "golden", "g", "", "compare the final service state with this JSON file")
+	Cmd.Flags().BoolVar(&update, "update", false, "write the final state to the golden file instead of comparing")
+}
+
+func execute(cmd *cobra.Command, args []string) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("error opening recording(%s): %w", args[0], err)
+	}
+	defer file.Close()
+
+	rr, err := fix.NewRecordingReader(file)
+	if err != nil {
+		return fmt.Errorf("error reading recording(%s): %w", args[0], err)
+	}
+
+	cfg := replayConfig(config.GetConfig())
+	srv, err := service.NewServices(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer srv.Close()
+
+	replayer := fix.NewReplayer(srv.Fix.Application(), speed, fix.WithOutbound(srv.Orders.OnSent))
+	stats, err := replayer.Replay(ctx, rr)
+	log.Infof("Replayed %d of %d records, %d rejected, %d failed", stats.Replayed, stats.Records, stats.Rejected, stats.Failed)
+	if err != nil {
+		return err
+	}
+
+	if goldenPath == "" {
+		return nil
+	}
+	actual, err := json.MarshalIndent(srv.Snapshot(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding state: %w", err)
+	}
+	actual = append(actual, '\n')
+
+	if update {
+		if err := os.WriteFile(goldenPath, actual, 0o644); err != nil {
+			return fmt.Errorf("error writing golden file(%s): %w", goldenPath, err)
+		}
+		log.Infof("Golden file %s updated", goldenPath)
+		return nil
+	}
+	return compareGolden(goldenPath, actual)
+}
+
+// replayConfig keeps the parts of live that shape the service state, and
+// only those: the replay never records itself, stores or recovers orders,
+// takes IDs from the sequence of the live adapter, appends bars, loads start
+// of day positions, publishes, serves the admin API or connects to a
+// database.
+func replayConfig(live *config.Config) *config.Config {
+	cfg := &config.Config{
+		Fix: &config.Fix{
+			ConfigPath:       live.Fix.ConfigPath,
+			DataDictionary:   live.Fix.DataDictionary,
+			Validation:       live.Fix.Validation,
+			ValidationSample: live.Fix.ValidationSample,
+			MaskTags:         live.Fix.MaskTags,
+		},
+		Log:        live.Log,
+		MarketData: &config.MarketData{},
+		Publisher:  &config.Publisher{},
+		Throttle:   live.Throttle,
+		Orders:     &config.Orders{CancelTimeout: live.Orders.CancelTimeout},
+		IDs: &config.IDs{
+			Instance:  live.IDs.Instance,
+			Format:    live.IDs.Format,
+			Formats:   live.IDs.Formats,
+			MaxLength: live.IDs.MaxLength,
+		},
+		Calendar:  live.Calendar,
+		Risk:      live.Risk,
+		Positions: &config.Positions{},
+		Admin:     &config.Admin{},
+		Db:        &config.Db{},
+		Redis:     &config.Redis{},
+	}
+	*cfg.MarketData = *live.MarketData
+	cfg.MarketData.Bars.StorePath = ""
+	return cfg
+}
+
+// compareGolden compares the JSON documents semantically, so formatting
+// changes in the golden file don't matter. On mismatch the actual state is
+// written next to the golden file for inspection.
+func compareGolden(path string, actual []byte) error {
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading golden file(%s): %w", path, err)
+	}
+
+	var want, got any
+	if err := json.Unmarshal(expected, &want); err != nil {
+		return fmt.Errorf("error parsing golden file(%s): %w", path, err)
+	}
+	if err := json.Unmarshal(actual, &got); err != nil {
+		return err
+	}
+	if reflect.DeepEqual(want, got) || bytes.Equal(expected, actual) {
+		log.Infof("State matches golden file %s", path)
+		return nil
+	}
+
+	actualPath := path + ".actual"
+	if err := os.WriteFile(actualPath, actual, 0o644); err != nil {
+		return fmt.Errorf("error writing %s: %w", actualPath, err)
+	}
+	return fmt.Errorf("state differs from golden file %s, actual state written to %s", path, actualPath)
+}
@@ -0,0 +1,17 @@
+package replay
+
+import "testing"
+
+// TestReplayGolden replays the recording of testdata, a logon, a snapshot,
+// a trade, and an order partially filled then canceled, and compares the
+// resulting state with its golden file. Regenerate it with
+//
+//	waanx-adapter replay testdata/session.rec -g testdata/session.golden.json --update
+func TestReplayGolden(t *testing.T) {
+	goldenPath, update = "../../testdata/session.golden.json", false
+	t.Cleanup(func() { goldenPath = "" })
+
+	if err := execute(Cmd, []string{"../../testdata/session.rec"}); err != nil {
+		t.Fatal(err)
+	}
+}
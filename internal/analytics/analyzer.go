@@ -0,0 +1,162 @@
+// Package analytics derives per-symbol metrics from order books: spread, mid,
+// microprice, imbalance and the realized volatility of the mid.
+package analytics
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// DefaultLevels is the number of levels per side of the imbalance.
+	DefaultLevels = 5
+	// DefaultVolWindow is the rolling window of the realized volatility.
+	DefaultVolWindow = 5 * time.Minute
+	// DefaultVolSample is the interval the mid is sampled at for the
+	// volatility.
+	DefaultVolSample = time.Second
+)
+
+type logReturn struct {
+	time  time.Time
+	value float64
+}
+
+type series struct {
+	last    domain.Analytics
+	sampled time.Time // bucket of the last mid sample
+	mid     float64
+	returns []logReturn
+}
+
+// Analyzer keeps the analytics of every symbol. It is fed with the books of
+// the market data service and safe for concurrent use.
+type Analyzer struct {
+	mu      sync.Mutex
+	levels  int
+	window  time.Duration
+	sample  time.Duration
+	symbols map[string]*series
+}
+
+type AnalyzerOpt func(*Analyzer)
+
+// WithLevels sets the levels per side of the imbalance, DefaultLevels by
+// default.
+func WithLevels(n int) AnalyzerOpt {
+	return func(a *Analyzer) {
+		a.levels = n
+	}
+}
+
+// WithVolatility sets the rolling window of the realized volatility and the
+// interval the mid is sampled at within it.
+func WithVolatility(window, sample time.Duration) AnalyzerOpt {
+	return func(a *Analyzer) {
+		a.window = window
+		a.sample = sample
+	}
+}
+
+func NewAnalyzer(opts ...AnalyzerOpt) *Analyzer {
+	a := &Analyzer{
+		levels:  DefaultLevels,
+		window:  DefaultVolWindow,
+		sample:  DefaultVolSample,
+		symbols: make(map[string]*series),
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Update computes the analytics of book and reports whether they changed
+// since its previous book.
+func (a *Analyzer) Update(book domain.Book) (domain.Analytics, bool) {
+	at := book.Time
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+	q := book.Quote()
+	an := domain.Analytics{
+		Symbol:     book.Symbol,
+		Spread:     q.Spread(),
+		Mid:        q.Mid(),
+		Microprice: q.Microprice(),
+		Imbalance:  book.Imbalance(a.levels),
+		Levels:     a.levels,
+		Time:       at,
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	s, ok := a.symbols[book.Symbol]
+	if !ok {
+		s = &series{}
+		a.symbols[book.Symbol] = s
+	}
+	an.Volatility = a.volatility(s, an.Mid, at)
+
+	changed := !ok || !an.Spread.Equal(s.last.Spread) || !an.Mid.Equal(s.last.Mid) ||
+		!an.Microprice.Equal(s.last.Microprice) || !an.Imbalance.Equal(s.last.Imbalance) ||
+		an.Volatility != s.last.Volatility
+	s.last = an
+	return an, changed
+}
+
+// volatility samples mid at most once per sample interval and returns the
+// realized volatility of the samples within the window. a.mu must be held.
+func (a *Analyzer) volatility(s *series, mid decimal.Decimal, at time.Time) float64 {
+	if m := mid.InexactFloat64(); m > 0 {
+		if bucket := at.Truncate(a.sample); bucket.After(s.sampled) {
+			if s.mid > 0 {
+				s.returns = append(s.returns, logReturn{time: at, value: math.Log(m / s.mid)})
+			}
+			s.sampled, s.mid = bucket, m
+		}
+	}
+
+	cutoff := at.Add(-a.window)
+	i := sort.Search(len(s.returns), func(i int) bool { return s.returns[i].time.After(cutoff) })
+	s.returns = s.returns[i:]
+
+	var sum float64
+	for _, r := range s.returns {
+		sum += r.value * r.value
+	}
+	return math.Sqrt(sum)
+}
+
+// Get returns the last analytics of symbol.
+func (a *Analyzer) Get(symbol string) (domain.Analytics, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	s, ok := a.symbols[symbol]
+	if !ok {
+		return domain.Analytics{}, false
+	}
+	return s.last, true
+}
+
+// All returns the last analytics of every symbol, by symbol.
+func (a *Analyzer) All() []domain.Analytics {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	out := make([]domain.Analytics, 0, len(a.symbols))
+	for _, s := range a.symbols {
+		out = append(out, s.last)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Symbol < out[j].Symbol })
+	return out
+}
+
+// Snapshot implements service.Snapshotter.
+func (a *Analyzer) Snapshot() any {
+	return a.All()
+}
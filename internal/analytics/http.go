@@ -0,0 +1,34 @@
+package analytics
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/api"
+)
+
+// Handler serves the analytics of the analyzer.
+//
+//	GET ?symbol=BTC-USDT  the analytics of a symbol
+//	GET                   the analytics of every symbol
+func Handler(a *Analyzer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			api.WriteError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+
+		symbol := r.URL.Query().Get("symbol")
+		if symbol == "" {
+			api.WriteJSON(w, http.StatusOK, a.All())
+			return
+		}
+		an, ok := a.Get(symbol)
+		if !ok {
+			api.WriteError(w, http.StatusNotFound, fmt.Errorf("no analytics for %s", symbol))
+			return
+		}
+		api.WriteJSON(w, http.StatusOK, an)
+	})
+}
@@ -0,0 +1,206 @@
+// Package bar aggregates trade prints into OHLCV bars.
+package bar
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+	"github.com/phimaker/waanx-fix-simpler/internal/logger"
+	"github.com/phimaker/waanx-fix-simpler/internal/metrics"
+)
+
+var (
+	log = logger.Named(logger.ComponentMarketData)
+
+	closedCounter    = metrics.NewCounterVec("bars_closed")
+	lateTradeCounter = metrics.NewCounterVec("bars_late_trades")
+)
+
+// DefaultGrace is how long a bar stays open after its end for late trades.
+const DefaultGrace = 2 * time.Second
+
+type series struct {
+	symbol   string
+	interval string
+}
+
+// Aggregator builds bars for every interval from the trades published on the
+// bus. A bar is closed, stored and published as event.Bar once its end plus
+// the grace window has passed; trades arriving later for it are dropped.
+type Aggregator struct {
+	mu        sync.Mutex
+	intervals []Interval
+	grace     time.Duration
+	calendar  Calendar
+	store     Store
+	bus       *event.Bus
+
+	open       map[series]map[time.Time]*domain.Bar // by bar start
+	closedTill map[series]time.Time
+	last       map[series]domain.Bar
+}
+
+type AggregatorOpt func(*Aggregator)
+
+func WithIntervals(intervals ...Interval) AggregatorOpt {
+	return func(a *Aggregator) {
+		a.intervals = intervals
+	}
+}
+
+// WithGrace sets how long bars wait for late trades, DefaultGrace by default.
+func WithGrace(d time.Duration) AggregatorOpt {
+	return func(a *Aggregator) {
+		a.grace = d
+	}
+}
+
+// WithCalendar aligns bars to the trading days of c, UTC days by default.
+func WithCalendar(c Calendar) AggregatorOpt {
+	return func(a *Aggregator) {
+		a.calendar = c
+	}
+}
+
+// WithStore persists every closed bar to s.
+func WithStore(s Store) AggregatorOpt {
+	return func(a *Aggregator) {
+		a.store = s
+	}
+}
+
+func NewAggregator(bus *event.Bus, opts ...AggregatorOpt) *Aggregator {
+	a := &Aggregator{
+		grace:      DefaultGrace,
+		calendar:   LocationCalendar{Location: time.UTC},
+		bus:        bus,
+		open:       make(map[series]map[time.Time]*domain.Bar),
+		closedTill: make(map[series]time.Time),
+		last:       make(map[series]domain.Bar),
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	if a.intervals == nil {
+		a.intervals, _ = ParseIntervals(nil)
+	}
+
+	bus.Subscribe(event.TopicTrade, func(e event.Event) {
+		if t, ok := e.(event.Trade); ok {
+			a.OnTrade(t.Trade)
+		}
+	})
+	return a
+}
+
+// OnTrade adds t to the bars of every interval.
+func (a *Aggregator) OnTrade(t domain.Trade) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, iv := range a.intervals {
+		s := series{symbol: t.Symbol, interval: iv.Name}
+		start, end := bounds(a.calendar, iv, t.Time)
+		if start.Before(a.closedTill[s]) {
+			lateTradeCounter.Inc(iv.Name, "dropped")
+			log.Debugf("Dropping late trade %s %s at %s, %s bar already closed", t.Symbol, t.TradeID, t.Time, iv.Name)
+			continue
+		}
+
+		bars := a.open[s]
+		if bars == nil {
+			bars = make(map[time.Time]*domain.Bar)
+			a.open[s] = bars
+		}
+		b, ok := bars[start]
+		if !ok {
+			b = &domain.Bar{Symbol: t.Symbol, Interval: iv.Name, Start: start, End: end}
+			bars[start] = b
+		} else if t.Time.Before(b.LastTrade) {
+			lateTradeCounter.Inc(iv.Name, "accepted")
+		}
+		b.Add(t)
+	}
+}
+
+// Flush closes the bars whose grace window has passed at now.
+func (a *Aggregator) Flush(now time.Time) {
+	a.mu.Lock()
+	var closed []domain.Bar
+	for s, bars := range a.open {
+		for start, b := range bars {
+			if b.End.Add(a.grace).After(now) {
+				continue
+			}
+			closed = append(closed, *b)
+			delete(bars, start)
+			if b.End.After(a.closedTill[s]) {
+				a.closedTill[s] = b.End
+				a.last[s] = *b
+			}
+		}
+		if len(bars) == 0 {
+			delete(a.open, s)
+		}
+	}
+	a.mu.Unlock()
+
+	sort.Slice(closed, func(i, j int) bool { return closed[i].End.Before(closed[j].End) })
+	for _, b := range closed {
+		closedCounter.Inc(b.Interval)
+		if a.store != nil {
+			if err := a.store.SaveBar(b); err != nil {
+				log.Errorf("Error saving %s bar of %s: %v", b.Interval, b.Symbol, err)
+			}
+		}
+		a.bus.Publish(event.NewBar(b))
+	}
+}
+
+// Run flushes bars every second until ctx is done.
+func (a *Aggregator) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			a.Flush(now)
+		}
+	}
+}
+
+// Last returns the last closed bar of symbol for interval.
+func (a *Aggregator) Last(symbol, interval string) (domain.Bar, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	b, ok := a.last[series{symbol: symbol, interval: interval}]
+	return b, ok
+}
+
+// Snapshot returns the open bars, oldest first.
+func (a *Aggregator) Snapshot() any {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	var out []event.Bar
+	for _, bars := range a.open {
+		for _, b := range bars {
+			out = append(out, event.NewBar(*b))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].Start.Equal(out[j].Start) {
+			return out[i].Start.Before(out[j].Start)
+		}
+		if out[i].Symbol != out[j].Symbol {
+			return out[i].Symbol < out[j].Symbol
+		}
+		return out[i].End.Before(out[j].End)
+	})
+	return out
+}
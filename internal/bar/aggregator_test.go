@@ -0,0 +1,134 @@
+package bar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+	"github.com/shopspring/decimal"
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{in: "1s", want: time.Second},
+		{in: "5m", want: 5 * time.Minute},
+		{in: "4h", want: 4 * time.Hour},
+		{in: "1d", want: day},
+		{in: "2d", wantErr: true},
+		{in: "7m", wantErr: true},
+		{in: "0s", wantErr: true},
+		{in: "-1m", wantErr: true},
+		{in: "xd", wantErr: true},
+		{in: "1w", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			iv, err := ParseInterval(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseInterval(%q) = %v, want error %t", tt.in, err, tt.wantErr)
+			}
+			if err == nil && (iv.Duration != tt.want || iv.Name != tt.in) {
+				t.Errorf("ParseInterval(%q) = %+v, want %s", tt.in, iv, tt.want)
+			}
+		})
+	}
+}
+
+func TestBounds(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip(err)
+	}
+	at := time.Date(2024, 6, 3, 16, 7, 30, 0, time.UTC) // 01:07:30 on June 4 in Tokyo
+	tests := []struct {
+		name     string
+		location *time.Location
+		interval string
+		start    time.Time
+	}{
+		{name: "5m", location: time.UTC, interval: "5m", start: time.Date(2024, 6, 3, 16, 5, 0, 0, time.UTC)},
+		{name: "1d UTC", location: time.UTC, interval: "1d", start: time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "1d Tokyo", location: tokyo, interval: "1d", start: time.Date(2024, 6, 4, 0, 0, 0, 0, tokyo)},
+		{name: "4h Tokyo", location: tokyo, interval: "4h", start: time.Date(2024, 6, 4, 0, 0, 0, 0, tokyo)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iv, err := ParseInterval(tt.interval)
+			if err != nil {
+				t.Fatal(err)
+			}
+			start, end := bounds(LocationCalendar{Location: tt.location}, iv, at)
+			if !start.Equal(tt.start) || !end.Equal(tt.start.Add(iv.Duration)) {
+				t.Errorf("bounds() = %s, %s, want %s and %s later", start, end, tt.start, iv.Duration)
+			}
+		})
+	}
+}
+
+func TestAggregator(t *testing.T) {
+	t0 := time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC)
+	trade := func(offset time.Duration, price, size string) domain.Trade {
+		return domain.Trade{Symbol: "BTC-USDT", Price: decimal.RequireFromString(price),
+			Size: decimal.RequireFromString(size), Time: t0.Add(offset)}
+	}
+
+	bus := event.NewBus()
+	var closed []domain.Bar
+	bus.Subscribe(event.TopicBar+".*", func(e event.Event) {
+		if b, ok := e.(event.Bar); ok {
+			closed = append(closed, b.Bar)
+		}
+	})
+	iv, _ := ParseInterval("1m")
+	a := NewAggregator(bus, WithIntervals(iv), WithGrace(2*time.Second))
+
+	for _, tr := range []domain.Trade{
+		trade(10*time.Second, "100", "1"),
+		trade(30*time.Second, "103", "2"),
+		trade(5*time.Second, "99", "1"), // late within the bar, becomes the open
+		trade(50*time.Second, "101", "1"),
+		trade(70*time.Second, "105", "1"), // next bar
+	} {
+		a.OnTrade(tr)
+	}
+
+	a.Flush(t0.Add(61 * time.Second)) // within the grace window
+	if len(closed) != 0 {
+		t.Fatalf("closed %d bars within the grace window", len(closed))
+	}
+	a.Flush(t0.Add(62 * time.Second))
+	if len(closed) != 1 {
+		t.Fatalf("closed %d bars, want 1", len(closed))
+	}
+
+	want := domain.Bar{
+		Symbol: "BTC-USDT", Interval: "1m", Start: t0, End: t0.Add(time.Minute),
+		Open: decimal.RequireFromString("99"), High: decimal.RequireFromString("103"),
+		Low: decimal.RequireFromString("99"), Close: decimal.RequireFromString("101"),
+		Volume: decimal.RequireFromString("5"), Notional: decimal.RequireFromString("506"), Trades: 4,
+		FirstTrade: t0.Add(5 * time.Second), LastTrade: t0.Add(50 * time.Second),
+	}
+	got := closed[0]
+	if got.Symbol != want.Symbol || got.Interval != want.Interval || !got.Start.Equal(want.Start) ||
+		!got.End.Equal(want.End) || !got.Open.Equal(want.Open) || !got.High.Equal(want.High) ||
+		!got.Low.Equal(want.Low) || !got.Close.Equal(want.Close) || !got.Volume.Equal(want.Volume) ||
+		!got.Notional.Equal(want.Notional) || got.Trades != want.Trades ||
+		!got.FirstTrade.Equal(want.FirstTrade) || !got.LastTrade.Equal(want.LastTrade) {
+		t.Errorf("closed bar = %+v, want %+v", got, want)
+	}
+	if last, ok := a.Last("BTC-USDT", "1m"); !ok || !last.Start.Equal(t0) {
+		t.Errorf("Last() = %+v, %t, want the closed bar", last, ok)
+	}
+
+	// A trade for the closed bar is dropped, the open one is untouched.
+	a.OnTrade(trade(59*time.Second, "90", "1"))
+	a.Flush(t0.Add(3 * time.Minute))
+	if len(closed) != 2 || closed[1].Trades != 1 || !closed[1].Start.Equal(t0.Add(time.Minute)) {
+		t.Fatalf("closed bars = %+v, want the second with its single trade", closed)
+	}
+}
@@ -0,0 +1,94 @@
+package bar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const day = 24 * time.Hour
+
+// DefaultIntervals are used when none are configured.
+var DefaultIntervals = []string{"1s", "1m", "5m", "1h", "1d"}
+
+// Interval is a bar length. Name is used in topics and bar records.
+type Interval struct {
+	Name     string
+	Duration time.Duration
+}
+
+// ParseInterval parses a Go duration ("1m", "4h") or "1d", dividing a day.
+func ParseInterval(s string) (Interval, error) {
+	var d time.Duration
+	if n, ok := strings.CutSuffix(s, "d"); ok {
+		days, err := strconv.Atoi(n)
+		if err != nil {
+			return Interval{}, fmt.Errorf("invalid interval %q: %w", s, err)
+		}
+		d = time.Duration(days) * day
+	} else {
+		var err error
+		if d, err = time.ParseDuration(s); err != nil {
+			return Interval{}, fmt.Errorf("invalid interval %q: %w", s, err)
+		}
+	}
+	if d <= 0 {
+		return Interval{}, fmt.Errorf("invalid interval %q: must be positive", s)
+	}
+	if d > day {
+		// Bars start with the trading day, so longer ones would overlap.
+		return Interval{}, fmt.Errorf("invalid interval %q: must be a day at most", s)
+	}
+	if day%d != 0 {
+		return Interval{}, fmt.Errorf("invalid interval %q: must divide a day", s)
+	}
+	return Interval{Name: s, Duration: d}, nil
+}
+
+func ParseIntervals(names []string) ([]Interval, error) {
+	if len(names) == 0 {
+		names = DefaultIntervals
+	}
+	out := make([]Interval, 0, len(names))
+	for _, n := range names {
+		iv, err := ParseInterval(n)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, iv)
+	}
+	return out, nil
+}
+
+// Calendar gives the start of the venue trading day, to which bars are
+// aligned.
+type Calendar interface {
+	DayStart(t time.Time) time.Time
+}
+
+// LocationCalendar starts trading days at midnight in a time zone.
+type LocationCalendar struct {
+	Location *time.Location
+}
+
+func (c LocationCalendar) DayStart(t time.Time) time.Time {
+	loc := c.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	lt := t.In(loc)
+	return time.Date(lt.Year(), lt.Month(), lt.Day(), 0, 0, 0, 0, loc)
+}
+
+// bounds returns the interval [start, end) containing t. Intraday bars are
+// counted from the start of the trading day, daily ones start with it.
+func bounds(cal Calendar, iv Interval, t time.Time) (time.Time, time.Time) {
+	dayStart := cal.DayStart(t)
+	if iv.Duration >= day {
+		return dayStart, dayStart.Add(iv.Duration)
+	}
+	n := t.Sub(dayStart) / iv.Duration
+	start := dayStart.Add(n * iv.Duration)
+	return start, start.Add(iv.Duration)
+}
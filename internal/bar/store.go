@@ -0,0 +1,49 @@
+package bar
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+)
+
+// Store persists closed bars.
+type Store interface {
+	SaveBar(b domain.Bar) error
+}
+
+// StoreFunc adapts a function to Store.
+type StoreFunc func(b domain.Bar) error
+
+func (f StoreFunc) SaveBar(b domain.Bar) error {
+	return f(b)
+}
+
+// FileStore appends bars to a file as JSON lines.
+type FileStore struct {
+	mu  sync.Mutex
+	f   *os.File
+	enc *json.Encoder
+}
+
+func NewFileStore(path string) (*FileStore, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("error opening bar store(%s): %w", path, err)
+	}
+	return &FileStore{f: f, enc: json.NewEncoder(f)}, nil
+}
+
+func (s *FileStore) SaveBar(b domain.Bar) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enc.Encode(b)
+}
+
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Close()
+}
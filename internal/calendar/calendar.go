@@ -0,0 +1,339 @@
+// Package calendar holds the trading calendar of the venue: the weekly
+// sessions, holidays and half days in its time zone, the start of its trading
+// day and the time its sequence numbers are reset.
+//
+// A calendar is read from a YAML file:
+//
+//	time-zone: Asia/Bangkok
+//	day-start: "00:00"       # trading days, and daily bars, start at
+//	sequence-reset: "06:00"  # sequence numbers reset every day at, optional
+//	sessions:
+//	  - { days: [weekdays], open: "10:00", close: "12:30" }
+//	  - { days: [weekdays], open: "14:30", close: "16:30" }
+//	  - { days: [sun], open: "22:00", close: "06:00" } # overnight, closes Monday
+//	holidays: [2026-12-25, 2027-01-01]
+//	half-days:
+//	  - { date: 2026-12-24, close: "12:00" }
+//
+// Times are HH:MM or HH:MM:SS in the time zone, close may be 24:00. A session
+// closing at or before its open closes the next day. Holidays and half days
+// apply to the sessions opening that date, the sessions of a half day opening
+// at or after its close not opening at all. Without sessions the venue is open
+// all day every day but holidays.
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const day = 24 * 60 * 60 // seconds
+
+// horizon is how many days ahead NextChange looks for a change.
+const horizon = 400
+
+type file struct {
+	TimeZone      string `yaml:"time-zone"`
+	DayStart      string `yaml:"day-start"`
+	SequenceReset string `yaml:"sequence-reset"`
+	Sessions      []struct {
+		Days  []string
+		Open  string
+		Close string
+	}
+	Holidays []string
+	HalfDays []struct {
+		Date  string
+		Close string
+	} `yaml:"half-days"`
+}
+
+type date struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func dateOf(t time.Time) date {
+	y, m, d := t.Date()
+	return date{y, m, d}
+}
+
+// add returns the date n days later.
+func (d date) add(n int) date {
+	return dateOf(time.Date(d.year, d.month, d.day+n, 12, 0, 0, 0, time.UTC))
+}
+
+func (d date) weekday() time.Weekday {
+	return time.Date(d.year, d.month, d.day, 12, 0, 0, 0, time.UTC).Weekday()
+}
+
+type session struct {
+	days  [7]bool
+	open  int // seconds after midnight
+	close int // after open, beyond a day for overnight sessions
+}
+
+func (s session) overnight() bool {
+	return s.close > day
+}
+
+type window struct {
+	start, end time.Time
+}
+
+// Calendar is the trading calendar of a venue. It is immutable and safe for
+// concurrent use.
+type Calendar struct {
+	loc      *time.Location
+	dayStart int
+	reset    int
+	hasReset bool
+	sessions []session
+	holidays map[date]bool
+	halfDays map[date]int
+}
+
+// Load reads the calendar file at path.
+func Load(path string) (*Calendar, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading calendar: %w", err)
+	}
+	var f file
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("error parsing calendar %s: %w", path, err)
+	}
+	c, err := parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing calendar %s: %w", path, err)
+	}
+	return c, nil
+}
+
+func parse(f file) (*Calendar, error) {
+	c := &Calendar{
+		loc:      time.UTC,
+		holidays: make(map[date]bool),
+		halfDays: make(map[date]int),
+	}
+	var err error
+	if f.TimeZone != "" {
+		if c.loc, err = time.LoadLocation(f.TimeZone); err != nil {
+			return nil, fmt.Errorf("time-zone: %w", err)
+		}
+	}
+	if f.DayStart != "" {
+		if c.dayStart, err = parseClock(f.DayStart); err != nil || c.dayStart >= day {
+			return nil, fmt.Errorf("day-start %q: not a time of day", f.DayStart)
+		}
+	}
+	if f.SequenceReset != "" {
+		if c.reset, err = parseClock(f.SequenceReset); err != nil || c.reset >= day {
+			return nil, fmt.Errorf("sequence-reset %q: not a time of day", f.SequenceReset)
+		}
+		c.hasReset = true
+	}
+
+	for i, fs := range f.Sessions {
+		var s session
+		for _, name := range fs.Days {
+			if err := addDays(&s.days, name); err != nil {
+				return nil, fmt.Errorf("session %d: %w", i+1, err)
+			}
+		}
+		if s.open, err = parseClock(fs.Open); err != nil || s.open >= day {
+			return nil, fmt.Errorf("session %d: open %q: not a time of day", i+1, fs.Open)
+		}
+		if s.close, err = parseClock(fs.Close); err != nil {
+			return nil, fmt.Errorf("session %d: close: %w", i+1, err)
+		}
+		if s.close <= s.open {
+			s.close += day
+		}
+		c.sessions = append(c.sessions, s)
+	}
+
+	for _, h := range f.Holidays {
+		d, err := parseDate(h)
+		if err != nil {
+			return nil, fmt.Errorf("holiday: %w", err)
+		}
+		c.holidays[d] = true
+	}
+	for _, h := range f.HalfDays {
+		d, err := parseDate(h.Date)
+		if err != nil {
+			return nil, fmt.Errorf("half day: %w", err)
+		}
+		if c.halfDays[d], err = parseClock(h.Close); err != nil {
+			return nil, fmt.Errorf("half day %s: close: %w", h.Date, err)
+		}
+	}
+	return c, nil
+}
+
+// parseClock parses HH:MM or HH:MM:SS, up to 24:00, into seconds after
+// midnight.
+func parseClock(s string) (int, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("%q is not HH:MM or HH:MM:SS", s)
+	}
+	var hms [3]int
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 || (i == 0 && n > 24) || (i > 0 && n > 59) {
+			return 0, fmt.Errorf("%q is not HH:MM or HH:MM:SS", s)
+		}
+		hms[i] = n
+	}
+	secs := hms[0]*3600 + hms[1]*60 + hms[2]
+	if secs > day {
+		return 0, fmt.Errorf("%q is after 24:00", s)
+	}
+	return secs, nil
+}
+
+func parseDate(s string) (date, error) {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return date{}, fmt.Errorf("%q is not YYYY-MM-DD", s)
+	}
+	return dateOf(t), nil
+}
+
+var dayNames = map[string][]time.Weekday{
+	"weekdays": {time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	"weekends": {time.Saturday, time.Sunday},
+	"daily":    {time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday},
+}
+
+func init() {
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		name := strings.ToLower(wd.String())
+		dayNames[name] = []time.Weekday{wd}
+		dayNames[name[:3]] = []time.Weekday{wd}
+	}
+}
+
+func addDays(days *[7]bool, name string) error {
+	wds, ok := dayNames[strings.ToLower(name)]
+	if !ok {
+		return errors.New("unknown day " + strconv.Quote(name))
+	}
+	for _, wd := range wds {
+		days[wd] = true
+	}
+	return nil
+}
+
+// at returns the time secs after the midnight of d, secs possibly beyond a
+// day. It is a wall clock time, DST changes shortening or lengthening days.
+func (c *Calendar) at(d date, secs int) time.Time {
+	return time.Date(d.year, d.month, d.day, 0, 0, secs, 0, c.loc)
+}
+
+// windows returns the sessions opening on d.
+func (c *Calendar) windows(d date) []window {
+	if c.holidays[d] {
+		return nil
+	}
+	if len(c.sessions) == 0 {
+		end := day
+		if hc, ok := c.halfDays[d]; ok {
+			end = hc
+		}
+		return []window{{c.at(d, 0), c.at(d, end)}}
+	}
+	var out []window
+	for _, s := range c.sessions {
+		if !s.days[d.weekday()] {
+			continue
+		}
+		end := s.close
+		if hc, ok := c.halfDays[d]; ok {
+			if s.overnight() && hc <= s.open {
+				hc += day
+			}
+			if hc <= s.open {
+				continue
+			}
+			end = min(end, hc)
+		}
+		out = append(out, window{c.at(d, s.open), c.at(d, end)})
+	}
+	return out
+}
+
+// Location returns the time zone of the venue.
+func (c *Calendar) Location() *time.Location {
+	return c.loc
+}
+
+// Open reports whether a session of the venue is open at t.
+func (c *Calendar) Open(t time.Time) bool {
+	d := dateOf(t.In(c.loc))
+	// Overnight sessions of the day before may still be open.
+	for _, dd := range []date{d.add(-1), d} {
+		for _, w := range c.windows(dd) {
+			if !t.Before(w.start) && t.Before(w.end) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NextChange returns when the venue next opens if it is closed at t, or
+// closes if it is open, the zero time if it does not within a year.
+func (c *Calendar) NextChange(t time.Time) time.Time {
+	open := c.Open(t)
+	d := dateOf(t.In(c.loc))
+	var bounds []time.Time
+	for i := -1; i <= horizon; i++ {
+		for _, w := range c.windows(d.add(i)) {
+			bounds = append(bounds, w.start, w.end)
+		}
+	}
+	sort.Slice(bounds, func(i, j int) bool { return bounds[i].Before(bounds[j]) })
+	for _, b := range bounds {
+		// Sessions ending when the next one starts do not close the venue.
+		if b.After(t) && c.Open(b) != open {
+			return b
+		}
+	}
+	return time.Time{}
+}
+
+// DayStart returns the start of the trading day of t, which is aligned to
+// day-start. It implements bar.Calendar.
+func (c *Calendar) DayStart(t time.Time) time.Time {
+	d := dateOf(t.In(c.loc))
+	start := c.at(d, c.dayStart)
+	if t.Before(start) {
+		start = c.at(d.add(-1), c.dayStart)
+	}
+	return start
+}
+
+// NextSequenceReset returns the first sequence reset after t, false without
+// sequence-reset.
+func (c *Calendar) NextSequenceReset(t time.Time) (time.Time, bool) {
+	if !c.hasReset {
+		return time.Time{}, false
+	}
+	d := dateOf(t.In(c.loc))
+	reset := c.at(d, c.reset)
+	if !reset.After(t) {
+		reset = c.at(d.add(1), c.reset)
+	}
+	return reset, true
+}
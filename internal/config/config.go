@@ -3,6 +3,8 @@ package config
 import (
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/phimaker/waanx-fix-simpler/internal/logger"
@@ -11,15 +13,223 @@ import (
 
 type (
 	Config struct {
-		Fix   *Fix `mapstructure:"fix"`
-		Db    *Db
-		Redis *Redis
+		Fix        *Fix        `mapstructure:"fix"`
+		Log        *Log        `mapstructure:"log"`
+		MarketData *MarketData `mapstructure:"market-data"`
+		Publisher  *Publisher  `mapstructure:"publisher"`
+		Throttle   *Throttle   `mapstructure:"throttle"`
+		Orders     *Orders     `mapstructure:"orders"`
+		IDs        *IDs        `mapstructure:"ids"`
+		Calendar   *Calendar   `mapstructure:"calendar"`
+		Risk       *Risk       `mapstructure:"risk"`
+		Positions  *Positions  `mapstructure:"positions"`
+		Admin      *Admin      `mapstructure:"admin"`
+		Db         *Db
+		Redis      *Redis
 	}
 
 	Fix struct {
 		ConfigPath string `mapstructure:"config-path"`
 		Username   string
 		Password   string
+		// DataDictionary is the FIX spec used to decode and validate
+		// messages, empty uses the embedded waanx FIX 4.4 one.
+		DataDictionary string `mapstructure:"data-dictionary"`
+		// Validation is off, report (log and count violations) or enforce
+		// (reject them). Defaults to report, which only validates one in
+		// ValidationSample market data messages, 100 by default, 1 being all
+		// of them.
+		Validation       string
+		ValidationSample int `mapstructure:"validation-sample"`
+		// RecordPath enables capturing every message to this file for replay.
+		RecordPath string `mapstructure:"record-path"`
+		// MaskTags are masked in logs in addition to Password, RawData and NewPassword.
+		MaskTags []int `mapstructure:"mask-tags"`
+	}
+
+	Log struct {
+		Level string
+		// Components overrides the level of named loggers, e.g. fix: debug.
+		Components map[string]string
+		// FixFormat is how FIX messages are logged: raw, pretty or json.
+		FixFormat string `mapstructure:"fix-format"`
+	}
+
+	Admin struct {
+		// Addr is the listen address of the HTTP API, empty disables it.
+		Addr string
+	}
+
+	MarketData struct {
+		Symbols []string
+		// Depth is the MarketDepth requested, 0 for the full book.
+		Depth int
+		// TapeSize is the number of trades kept per symbol.
+		TapeSize  int `mapstructure:"tape-size"`
+		Bars      Bars
+		Analytics Analytics
+		Quality   Quality
+	}
+
+	// Quality configures the market data quality checks.
+	Quality struct {
+		// StaleAfter is how long a symbol may go without updates while it is
+		// not halted and TradingSessionID, if set, is open.
+		StaleAfter       time.Duration `mapstructure:"stale-after"`
+		TradingSessionID string        `mapstructure:"trading-session-id"`
+		// JumpTicks and JumpPercent flag mid and trade price moves larger
+		// than either, zero disables the check.
+		JumpTicks   int64   `mapstructure:"jump-ticks"`
+		JumpPercent float64 `mapstructure:"jump-percent"`
+	}
+
+	Analytics struct {
+		// Levels is the number of levels per side of the book imbalance.
+		Levels int
+		// VolWindow is the rolling window of the realized volatility, sampled
+		// every VolSample.
+		VolWindow time.Duration `mapstructure:"vol-window"`
+		VolSample time.Duration `mapstructure:"vol-sample"`
+	}
+
+	Bars struct {
+		// Intervals defaults to 1s, 1m, 5m, 1h and 1d.
+		Intervals []string
+		// Grace is how long a bar waits for late trades after its end.
+		Grace time.Duration
+		// TimeZone of the venue trading day, to which bars are aligned,
+		// ignored with a calendar.
+		TimeZone string `mapstructure:"time-zone"`
+		// StorePath appends closed bars to this file as JSON lines.
+		StorePath string `mapstructure:"store-path"`
+	}
+
+	Publisher struct {
+		Targets []PublisherTarget
+	}
+
+	PublisherTarget struct {
+		Name    string
+		Type    string
+		Address string
+		Topics  []string
+		// Conflate delivers only the latest quote and book of each symbol
+		// every interval instead of every change, zero disables it.
+		Conflate time.Duration
+		// Depth limits the levels of the books delivered, zero is all.
+		Depth int
+		// QueueSize bounds the events waiting for a slow output, further
+		// events are dropped.
+		QueueSize int `mapstructure:"queue-size"`
+	}
+
+	// Orders configures what happens to the open orders when the session
+	// drops or the adapter stops. Their status is always requested after
+	// logon.
+	Orders struct {
+		// CancelOnReconnect cancels every open order after logon.
+		CancelOnReconnect bool `mapstructure:"cancel-on-reconnect"`
+		// CancelOnShutdown cancels the open orders on SIGINT or SIGTERM and
+		// waits up to CancelTimeout for the venue to confirm before logging
+		// out.
+		CancelOnShutdown bool          `mapstructure:"cancel-on-shutdown"`
+		CancelTimeout    time.Duration `mapstructure:"cancel-timeout"`
+		// Store records the orders so they survive restarts: "journal"
+		// appends them to JournalPath, orders.journal by default, "sql"
+		// writes them to the database of the db section. They are only kept
+		// in memory by default. It is only read at startup.
+		Store       string `mapstructure:"store"`
+		JournalPath string `mapstructure:"journal-path"`
+	}
+
+	// IDs configures the identifiers of the orders and requests sent. They
+	// are unique per day across restarts, the sequence of the day being kept
+	// in StatePath, and across the processes sharing a session as long as
+	// each has its own Instance.
+	IDs struct {
+		Instance string
+		// StatePath holds the sequence of the day, ids.json by default.
+		StatePath string `mapstructure:"state-path"`
+		// Format renders an ID from {instance}, {kind} (ORD, CXL, MDR...),
+		// {date} (YYYYMMDD, UTC) and {seq}, or {seq36} in base 36. Formats
+		// overrides it by kind, case insensitive.
+		Format  string
+		Formats map[string]string
+		// MaxLength is the longest ID the venue accepts, zero for no limit.
+		MaxLength int `mapstructure:"max-length"`
+	}
+
+	// Calendar is the trading calendar of the venue. When Path is set, the
+	// session is only connected during its sessions, trading days and bars
+	// start at its day start and staleness is only checked while it is open.
+	Calendar struct {
+		// Path is a YAML file of the time zone, sessions, holidays and half
+		// days of the venue, see the calendar package.
+		Path string
+	}
+
+	// Risk configures the pre-trade checks of outgoing orders. Zero limits
+	// are not checked.
+	Risk struct {
+		// KillSwitch rejects every new order.
+		KillSwitch    bool `mapstructure:"kill-switch"`
+		MaxOpenOrders int  `mapstructure:"max-open-orders"`
+		// MaxDailyLoss rejects new orders once the P&L of the day is below
+		// minus this.
+		MaxDailyLoss float64 `mapstructure:"max-daily-loss"`
+		// CollarPercent rejects limit orders priced more than this away from
+		// the last trade, or the mid without one, on the aggressive side.
+		CollarPercent float64 `mapstructure:"collar-percent"`
+		// TradingSessionID, if set, must be open to send orders.
+		TradingSessionID string `mapstructure:"trading-session-id"`
+		// Symbols holds the limits by symbol, "*" applying to the others.
+		// Keys are case insensitive.
+		Symbols map[string]SymbolLimits
+	}
+
+	SymbolLimits struct {
+		MaxQty      float64 `mapstructure:"max-qty"`
+		MaxNotional float64 `mapstructure:"max-notional"`
+		// MaxPosition limits the absolute position of an account including
+		// its open orders on the same side.
+		MaxPosition float64 `mapstructure:"max-position"`
+	}
+
+	// Positions configures the start of day positions and their
+	// reconciliation with the venue.
+	Positions struct {
+		// SODPath is a JSON file of the start of day positions.
+		SODPath string `mapstructure:"sod-path"`
+		// SODTable is the database table of the start of day positions, read
+		// when SODPath is empty.
+		SODTable string `mapstructure:"sod-table"`
+		// Reconcile requests the positions of Accounts after logon.
+		Reconcile bool
+		Accounts  []string
+	}
+
+	// Throttle limits the rate of the application messages sent, for the
+	// session and by MsgType. MsgTypes keys are case insensitive, none of
+	// the messages sent differing by case only.
+	Throttle struct {
+		Session  Limit            `mapstructure:"session"`
+		MsgTypes map[string]Limit `mapstructure:"msg-types"`
+		// Policy is what happens to a message over a limit: "queue", the
+		// default, delays it up to MaxWait and rejects it beyond, "reject"
+		// rejects it at once.
+		Policy  string
+		MaxWait time.Duration `mapstructure:"max-wait"`
+		// Backoff pauses a MsgType after the venue rejected one of them for
+		// exceeding its own limits.
+		Backoff time.Duration
+	}
+
+	// Limit allows Rate messages every Per, a second by default, in bursts
+	// of up to Burst, Rate by default. A zero Rate is no limit.
+	Limit struct {
+		Rate  int
+		Per   time.Duration
+		Burst int
 	}
 
 	Db struct {
@@ -42,9 +252,11 @@ type (
 
 var (
 	once           sync.Once
-	configInstance *Config
+	configInstance atomic.Pointer[Config]
 )
 
+// GetConfig returns the current configuration. The returned value is replaced
+// as a whole on every hot reload, so callers should not hold on to it for long.
 func GetConfig() *Config {
 
 	once.Do(func() {
@@ -63,20 +275,70 @@ func GetConfig() *Config {
 			logger.Warn("No config file found")
 		}
 
-		if err := viper.Unmarshal(&configInstance); err != nil {
+		var cfg *Config
+		if err := viper.Unmarshal(&cfg); err != nil {
 			panic(err)
 		}
-		postInit()
+		postInit(cfg)
+		configInstance.Store(cfg)
 	})
 
-	return configInstance
+	return configInstance.Load()
 }
 
-func postInit() {
+func postInit(cfg *Config) {
 	// Do some post initialization stuff here
-	if configInstance.Fix == nil {
-		configInstance.Fix = &Fix{
+	if cfg.Fix == nil {
+		cfg.Fix = &Fix{
 			ConfigPath: "config.cfg",
 		}
 	}
+	if cfg.Fix.ValidationSample <= 0 {
+		cfg.Fix.ValidationSample = 100
+	}
+	if cfg.Admin == nil {
+		cfg.Admin = &Admin{}
+	}
+	if cfg.Log == nil {
+		cfg.Log = &Log{}
+	}
+	if cfg.MarketData == nil {
+		cfg.MarketData = &MarketData{}
+	}
+	if cfg.Publisher == nil {
+		cfg.Publisher = &Publisher{}
+	}
+	if cfg.Throttle == nil {
+		cfg.Throttle = &Throttle{}
+	}
+	if cfg.Throttle.Policy == "" {
+		cfg.Throttle.Policy = "queue"
+	}
+	if cfg.Throttle.MaxWait <= 0 {
+		cfg.Throttle.MaxWait = time.Second
+	}
+	if cfg.Throttle.Backoff <= 0 {
+		cfg.Throttle.Backoff = time.Second
+	}
+	if cfg.Orders == nil {
+		cfg.Orders = &Orders{}
+	}
+	if cfg.Orders.CancelTimeout <= 0 {
+		cfg.Orders.CancelTimeout = 5 * time.Second
+	}
+	if cfg.IDs == nil {
+		cfg.IDs = &IDs{}
+	}
+	if cfg.IDs.StatePath == "" {
+		cfg.IDs.StatePath = "ids.json"
+	}
+	if cfg.Calendar == nil {
+		cfg.Calendar = &Calendar{}
+	}
+	if cfg.Risk == nil {
+		cfg.Risk = &Risk{}
+	}
+	if cfg.Positions == nil {
+		cfg.Positions = &Positions{}
+	}
 }
@@ -0,0 +1,211 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/phimaker/waanx-fix-simpler/internal/logger"
+	"github.com/spf13/viper"
+)
+
+// Section names reported in ChangeEvent.Keys.
+const (
+	SectionFix        = "fix"
+	SectionLog        = "log"
+	SectionMarketData = "market-data"
+	SectionPublisher  = "publisher"
+	SectionThrottle   = "throttle"
+	SectionOrders     = "orders"
+	SectionIDs        = "ids"
+	SectionCalendar   = "calendar"
+	SectionRisk       = "risk"
+	SectionPositions  = "positions"
+	SectionAdmin      = "admin"
+	SectionDb         = "db"
+	SectionRedis      = "redis"
+)
+
+// ChangeEvent describes a configuration reload that has been applied.
+type ChangeEvent struct {
+	Old *Config
+	New *Config
+	// Keys holds the sections that differ between Old and New.
+	Keys []string
+}
+
+// Changed reports whether the given section was part of the reload.
+func (e ChangeEvent) Changed(section string) bool {
+	for _, k := range e.Keys {
+		if k == section {
+			return true
+		}
+	}
+	return false
+}
+
+type ChangeHandler func(ChangeEvent)
+
+var (
+	watchOnce  sync.Once
+	handlersMu sync.RWMutex
+	handlers   []ChangeHandler
+)
+
+// OnChange registers fn to be called after every applied reload.
+func OnChange(fn ChangeHandler) {
+	handlersMu.Lock()
+	defer handlersMu.Unlock()
+	handlers = append(handlers, fn)
+}
+
+// WatchConfig starts watching the config file and hot-applies changes to the
+// sections that are safe to change at runtime. Changes to sections bound to
+// the FIX session or to external connections, and to the session file, are
+// logged and ignored until the next restart.
+func WatchConfig() {
+	cfg := GetConfig()
+	watchOnce.Do(func() {
+		viper.OnConfigChange(func(e fsnotify.Event) {
+			logger.Infof("Config file changed: %s", e.Name)
+			reload()
+		})
+		viper.WatchConfig()
+		watchSessionFile(cfg.Fix.ConfigPath)
+	})
+}
+
+// watchSessionFile warns when the quickfix session file changes, as it is
+// only read when the FIX client starts.
+func watchSessionFile(path string) {
+	path = filepath.Clean(path)
+	last, err := os.ReadFile(path)
+	if err != nil {
+		logger.Errorf("Error reading session file %s: %v", path, err)
+		return
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		logger.Errorf("Error watching session file %s: %v", path, err)
+		return
+	}
+	// The directory is watched, editors replacing the file rather than
+	// writing to it.
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		logger.Errorf("Error watching session file %s: %v", path, err)
+		watcher.Close()
+		return
+	}
+	// A save is read once its writes settle, a truncated file not counting as
+	// a change.
+	settle := time.NewTimer(time.Hour)
+	settle.Stop()
+	go func() {
+		for {
+			select {
+			case e, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(e.Name) == path && e.Has(fsnotify.Write|fsnotify.Create) {
+					settle.Reset(100 * time.Millisecond)
+				}
+			case <-settle.C:
+				next, err := os.ReadFile(path)
+				if err != nil || bytes.Equal(next, last) {
+					continue
+				}
+				last = next
+				logger.Warnf("Session file %s changed, ignored until the FIX client restarts", path)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logger.Errorf("Error watching session file %s: %v", path, err)
+			}
+		}
+	}()
+}
+
+func reload() {
+	var next *Config
+	if err := viper.Unmarshal(&next); err != nil {
+		logger.Errorf("Error reloading config, keeping current one: %v", err)
+		return
+	}
+	postInit(next)
+
+	prev := GetConfig()
+
+	// Host, CompIDs and credentials are only read when the session is
+	// created, so these sections always keep their startup values.
+	for _, key := range diff(prev, next, SectionFix, SectionIDs, SectionCalendar, SectionAdmin, SectionDb, SectionRedis) {
+		logger.Warnf("Config section %q changed but requires a restart, ignoring", key)
+	}
+	next.Fix = prev.Fix
+	next.IDs = prev.IDs
+	next.Calendar = prev.Calendar
+	next.Admin = prev.Admin
+	next.Db = prev.Db
+	next.Redis = prev.Redis
+
+	keys := diff(prev, next, SectionLog, SectionMarketData, SectionPublisher, SectionThrottle, SectionOrders, SectionRisk, SectionPositions)
+	if len(keys) == 0 {
+		return
+	}
+	configInstance.Store(next)
+	logger.Infof("Config reloaded, changed sections: %v", keys)
+
+	e := ChangeEvent{Old: prev, New: next, Keys: keys}
+	handlersMu.RLock()
+	defer handlersMu.RUnlock()
+	for _, fn := range handlers {
+		fn(e)
+	}
+}
+
+func diff(a, b *Config, sections ...string) []string {
+	var keys []string
+	for _, section := range sections {
+		if !reflect.DeepEqual(a.section(section), b.section(section)) {
+			keys = append(keys, section)
+		}
+	}
+	return keys
+}
+
+func (c *Config) section(name string) any {
+	switch name {
+	case SectionFix:
+		return c.Fix
+	case SectionLog:
+		return c.Log
+	case SectionMarketData:
+		return c.MarketData
+	case SectionPublisher:
+		return c.Publisher
+	case SectionThrottle:
+		return c.Throttle
+	case SectionOrders:
+		return c.Orders
+	case SectionIDs:
+		return c.IDs
+	case SectionCalendar:
+		return c.Calendar
+	case SectionRisk:
+		return c.Risk
+	case SectionPositions:
+		return c.Positions
+	case SectionAdmin:
+		return c.Admin
+	case SectionDb:
+		return c.Db
+	case SectionRedis:
+		return c.Redis
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Analytics are metrics derived from the book of Symbol. Imbalance is taken
+// over the best Levels of each side. Volatility is the realized volatility
+// of the mid over a rolling window: the square root of the sum of its
+// squared log returns.
+type Analytics struct {
+	Symbol     string          `json:"symbol"`
+	Spread     decimal.Decimal `json:"spread"`
+	Mid        decimal.Decimal `json:"mid"`
+	Microprice decimal.Decimal `json:"microprice"`
+	Imbalance  decimal.Decimal `json:"imbalance"`
+	Levels     int             `json:"levels"`
+	Volatility float64         `json:"volatility"`
+	Time       time.Time       `json:"time"`
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Bar is an OHLCV candle of the trades of Symbol in [Start, End).
+type Bar struct {
+	Symbol   string          `json:"symbol"`
+	Interval string          `json:"interval"`
+	Start    time.Time       `json:"start"`
+	End      time.Time       `json:"end"`
+	Open     decimal.Decimal `json:"open"`
+	High     decimal.Decimal `json:"high"`
+	Low      decimal.Decimal `json:"low"`
+	Close    decimal.Decimal `json:"close"`
+	Volume   decimal.Decimal `json:"volume"`
+	Notional decimal.Decimal `json:"notional"`
+	Trades   int             `json:"trades"`
+	// FirstTrade and LastTrade are the times of the trades giving Open and
+	// Close.
+	FirstTrade time.Time `json:"firstTrade"`
+	LastTrade  time.Time `json:"lastTrade"`
+}
+
+// VWAP returns the volume weighted average price, or zero without volume.
+func (b Bar) VWAP() decimal.Decimal {
+	if !b.Volume.IsPositive() {
+		return decimal.Zero
+	}
+	return b.Notional.Div(b.Volume)
+}
+
+// Add includes t in the bar. Open and Close follow trade time, so trades
+// arriving out of order still give the right values.
+func (b *Bar) Add(t Trade) {
+	if b.Trades == 0 {
+		b.Open, b.High, b.Low, b.Close = t.Price, t.Price, t.Price, t.Price
+		b.FirstTrade, b.LastTrade = t.Time, t.Time
+	} else {
+		if t.Price.GreaterThan(b.High) {
+			b.High = t.Price
+		}
+		if t.Price.LessThan(b.Low) {
+			b.Low = t.Price
+		}
+		if t.Time.Before(b.FirstTrade) {
+			b.Open, b.FirstTrade = t.Price, t.Time
+		}
+		if !t.Time.Before(b.LastTrade) {
+			b.Close, b.LastTrade = t.Price, t.Time
+		}
+	}
+	b.Volume = b.Volume.Add(t.Size)
+	b.Notional = b.Notional.Add(t.Notional())
+	b.Trades++
+}
@@ -0,0 +1,57 @@
+// Package domain holds the business types of the adapter. They use decimals
+// for prices and quantities and carry no quickfix types, so services and
+// outputs do not depend on the wire format.
+package domain
+
+import "github.com/shopspring/decimal"
+
+// InstrumentType is the kind of contract, from WaanxInstrumentType (20015).
+type InstrumentType string
+
+const (
+	InstrumentSpot      InstrumentType = "SPOT"
+	InstrumentPerpetual InstrumentType = "PERPETUAL"
+	InstrumentFuture    InstrumentType = "FUTURE"
+)
+
+// Instrument is a tradable security with the venue trading rules.
+type Instrument struct {
+	Symbol         string          `json:"symbol"`
+	SecurityID     string          `json:"securityId"`
+	Type           InstrumentType  `json:"type,omitempty"`
+	TickSize       decimal.Decimal `json:"tickSize"`
+	LotSize        decimal.Decimal `json:"lotSize"`
+	MinNotional    decimal.Decimal `json:"minNotional"`
+	PricePrecision int             `json:"pricePrecision"`
+	QtyPrecision   int             `json:"qtyPrecision"`
+	// TradingStatus is the last SecurityTradingStatus name received.
+	TradingStatus string `json:"tradingStatus,omitempty"`
+	Halted        bool   `json:"halted"`
+}
+
+// RoundPrice rounds px down to a multiple of the tick size.
+func (i Instrument) RoundPrice(px decimal.Decimal) decimal.Decimal {
+	return roundDown(px, i.TickSize)
+}
+
+// RoundQty rounds qty down to a multiple of the lot size.
+func (i Instrument) RoundQty(qty decimal.Decimal) decimal.Decimal {
+	return roundDown(qty, i.LotSize)
+}
+
+// ValidPrice reports whether px is positive and on the tick size.
+func (i Instrument) ValidPrice(px decimal.Decimal) bool {
+	return px.IsPositive() && i.RoundPrice(px).Equal(px)
+}
+
+// ValidQty reports whether qty is positive and a multiple of the lot size.
+func (i Instrument) ValidQty(qty decimal.Decimal) bool {
+	return qty.IsPositive() && i.RoundQty(qty).Equal(qty)
+}
+
+func roundDown(v, step decimal.Decimal) decimal.Decimal {
+	if !step.IsPositive() {
+		return v
+	}
+	return v.Div(step).Floor().Mul(step)
+}
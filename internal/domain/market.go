@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Side is the side of an order, a book level or the aggressor of a trade.
+type Side string
+
+const (
+	SideBuy  Side = "BUY"
+	SideSell Side = "SELL"
+)
+
+// Opposite returns the other side.
+func (s Side) Opposite() Side {
+	if s == SideBuy {
+		return SideSell
+	}
+	return SideBuy
+}
+
+// Signed returns qty, negative for sells.
+func (s Side) Signed(qty decimal.Decimal) decimal.Decimal {
+	if s == SideSell {
+		return qty.Neg()
+	}
+	return qty
+}
+
+// BookLevel is a price level of the order book. Position starts at 1 for the
+// best price.
+type BookLevel struct {
+	Side      Side            `json:"side"`
+	Price     decimal.Decimal `json:"price"`
+	Size      decimal.Decimal `json:"size"`
+	Position  int             `json:"position,omitempty"`
+	NumOrders int             `json:"numOrders,omitempty"`
+}
+
+// UpdateAction is what an incremental book update does to its level.
+type UpdateAction string
+
+const (
+	UpdateNew    UpdateAction = "NEW"
+	UpdateChange UpdateAction = "CHANGE"
+	UpdateDelete UpdateAction = "DELETE"
+)
+
+// BookUpdate is an incremental change to the book of Symbol. Seq is the
+// venue sequence number of the update for the symbol, zero if not sent.
+type BookUpdate struct {
+	Symbol string       `json:"symbol"`
+	Action UpdateAction `json:"action"`
+	Level  BookLevel    `json:"level"`
+	Seq    int          `json:"seq,omitempty"`
+	Time   time.Time    `json:"time"`
+}
+
+// Book is a full snapshot of the order book, best levels first. Seq is the
+// sequence number of the last update included.
+type Book struct {
+	Symbol string      `json:"symbol"`
+	Bids   []BookLevel `json:"bids"`
+	Asks   []BookLevel `json:"asks"`
+	Seq    int         `json:"seq,omitempty"`
+	Time   time.Time   `json:"time"`
+}
+
+// Quote returns the top of the book.
+func (b Book) Quote() Quote {
+	q := Quote{Symbol: b.Symbol, Time: b.Time}
+	if len(b.Bids) > 0 {
+		q.BidPx, q.BidSize = b.Bids[0].Price, b.Bids[0].Size
+	}
+	if len(b.Asks) > 0 {
+		q.AskPx, q.AskSize = b.Asks[0].Price, b.Asks[0].Size
+	}
+	return q
+}
+
+// Quote is the best bid and offer of an instrument.
+type Quote struct {
+	Symbol  string          `json:"symbol"`
+	BidPx   decimal.Decimal `json:"bidPx"`
+	BidSize decimal.Decimal `json:"bidSize"`
+	AskPx   decimal.Decimal `json:"askPx"`
+	AskSize decimal.Decimal `json:"askSize"`
+	Time    time.Time       `json:"time"`
+}
+
+// TwoSided reports whether the quote has both a bid and an offer.
+func (q Quote) TwoSided() bool {
+	return q.BidPx.IsPositive() && q.AskPx.IsPositive()
+}
+
+// Mid returns the mid price, or zero if the quote is not two sided.
+func (q Quote) Mid() decimal.Decimal {
+	if !q.TwoSided() {
+		return decimal.Zero
+	}
+	return q.BidPx.Add(q.AskPx).Div(decimal.NewFromInt(2))
+}
+
+// Spread returns the difference between offer and bid, or zero if the quote
+// is not two sided.
+func (q Quote) Spread() decimal.Decimal {
+	if !q.TwoSided() {
+		return decimal.Zero
+	}
+	return q.AskPx.Sub(q.BidPx)
+}
+
+// Microprice returns the mid weighted by the size on the other side, which
+// leans towards the side more likely to be hit next, or zero if the quote is
+// not two sided.
+func (q Quote) Microprice() decimal.Decimal {
+	total := q.BidSize.Add(q.AskSize)
+	if !q.TwoSided() || !total.IsPositive() {
+		return decimal.Zero
+	}
+	return q.BidPx.Mul(q.AskSize).Add(q.AskPx.Mul(q.BidSize)).Div(total)
+}
+
+// Imbalance returns (bid size - ask size) / (bid size + ask size) over the
+// best levels of each side, between -1 (all offers) and 1 (all bids). Zero
+// levels uses the whole book.
+func (b Book) Imbalance(levels int) decimal.Decimal {
+	bids, asks := sumSize(b.Bids, levels), sumSize(b.Asks, levels)
+	total := bids.Add(asks)
+	if !total.IsPositive() {
+		return decimal.Zero
+	}
+	return bids.Sub(asks).Div(total)
+}
+
+func sumSize(levels []BookLevel, n int) decimal.Decimal {
+	sum := decimal.Zero
+	for i, l := range levels {
+		if n > 0 && i == n {
+			break
+		}
+		sum = sum.Add(l.Size)
+	}
+	return sum
+}
+
+// Trade is a print on the venue. Side is the aggressor side when known.
+type Trade struct {
+	Symbol  string          `json:"symbol"`
+	TradeID string          `json:"tradeId,omitempty"`
+	Price   decimal.Decimal `json:"price"`
+	Size    decimal.Decimal `json:"size"`
+	Side    Side            `json:"side,omitempty"`
+	Seq     int             `json:"seq,omitempty"`
+	Time    time.Time       `json:"time"`
+}
+
+// Notional returns price times size.
+func (t Trade) Notional() decimal.Decimal {
+	return t.Price.Mul(t.Size)
+}
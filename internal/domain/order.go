@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type OrderType string
+
+const (
+	OrderTypeMarket OrderType = "MARKET"
+	OrderTypeLimit  OrderType = "LIMIT"
+)
+
+type TimeInForce string
+
+const (
+	TimeInForceGTC TimeInForce = "GTC"
+	TimeInForceIOC TimeInForce = "IOC"
+	TimeInForceFOK TimeInForce = "FOK"
+	TimeInForceDay TimeInForce = "DAY"
+)
+
+type OrderStatus string
+
+const (
+	OrderStatusPendingNew      OrderStatus = "PENDING_NEW"
+	OrderStatusNew             OrderStatus = "NEW"
+	OrderStatusPartiallyFilled OrderStatus = "PARTIALLY_FILLED"
+	OrderStatusFilled          OrderStatus = "FILLED"
+	OrderStatusPendingCancel   OrderStatus = "PENDING_CANCEL"
+	OrderStatusCanceled        OrderStatus = "CANCELED"
+	OrderStatusPendingReplace  OrderStatus = "PENDING_REPLACE"
+	OrderStatusReplaced        OrderStatus = "REPLACED"
+	OrderStatusRejected        OrderStatus = "REJECTED"
+	OrderStatusExpired         OrderStatus = "EXPIRED"
+	// OrderStatusUnknown is the status of open orders while the session is
+	// down, until the venue reports them again.
+	OrderStatusUnknown OrderStatus = "UNKNOWN"
+)
+
+// Terminal reports whether no more executions are expected for the order.
+func (s OrderStatus) Terminal() bool {
+	switch s {
+	case OrderStatusFilled, OrderStatusCanceled, OrderStatusRejected, OrderStatusExpired:
+		return true
+	}
+	return false
+}
+
+// Order is an order sent to the venue and its current state.
+type Order struct {
+	ClOrdID     string          `json:"clOrdId"`
+	OrigClOrdID string          `json:"origClOrdId,omitempty"`
+	OrderID     string          `json:"orderId,omitempty"`
+	Account     string          `json:"account,omitempty"`
+	Symbol      string          `json:"symbol"`
+	Side        Side            `json:"side"`
+	Type        OrderType       `json:"type"`
+	TimeInForce TimeInForce     `json:"timeInForce,omitempty"`
+	Price       decimal.Decimal `json:"price"`
+	Qty         decimal.Decimal `json:"qty"`
+	CumQty      decimal.Decimal `json:"cumQty"`
+	LeavesQty   decimal.Decimal `json:"leavesQty"`
+	AvgPx       decimal.Decimal `json:"avgPx"`
+	Status      OrderStatus     `json:"status"`
+	Text        string          `json:"text,omitempty"`
+	Created     time.Time       `json:"created"`
+	Updated     time.Time       `json:"updated"`
+}
+
+// Open reports whether the order can still trade.
+func (o Order) Open() bool {
+	return !o.Status.Terminal()
+}
+
+// Notional returns price times quantity, zero for market orders.
+func (o Order) Notional() decimal.Decimal {
+	return o.Price.Mul(o.Qty)
+}
+
+// OrderScope selects the orders of a mass request. Empty fields match any
+// order.
+type OrderScope struct {
+	Account string `json:"account,omitempty"`
+	Symbol  string `json:"symbol,omitempty"`
+	Side    Side   `json:"side,omitempty"`
+}
+
+// Match reports whether o is in the scope.
+func (s OrderScope) Match(o Order) bool {
+	return (s.Account == "" || s.Account == o.Account) &&
+		(s.Symbol == "" || s.Symbol == o.Symbol) &&
+		(s.Side == "" || s.Side == o.Side)
+}
+
+// Apply updates the order with an execution report.
+func (o *Order) Apply(e Execution) {
+	if e.OrderID != "" {
+		o.OrderID = e.OrderID
+	}
+	o.Status = e.OrdStatus
+	o.CumQty = e.CumQty
+	o.LeavesQty = e.LeavesQty
+	o.AvgPx = e.AvgPx
+	if e.Text != "" {
+		o.Text = e.Text
+	}
+	o.Updated = e.Time
+}
+
+type ExecType string
+
+const (
+	ExecTypeNew           ExecType = "NEW"
+	ExecTypeTrade         ExecType = "TRADE"
+	ExecTypeCanceled      ExecType = "CANCELED"
+	ExecTypeReplaced      ExecType = "REPLACED"
+	ExecTypePendingCancel ExecType = "PENDING_CANCEL"
+	ExecTypeRejected      ExecType = "REJECTED"
+	ExecTypeExpired       ExecType = "EXPIRED"
+	ExecTypePendingNew    ExecType = "PENDING_NEW"
+	ExecTypeOrderStatus   ExecType = "ORDER_STATUS"
+	ExecTypeOther         ExecType = "OTHER"
+)
+
+// Execution is an execution report of an order.
+type Execution struct {
+	ExecID      string          `json:"execId"`
+	ClOrdID     string          `json:"clOrdId"`
+	OrigClOrdID string          `json:"origClOrdId,omitempty"`
+	OrderID     string          `json:"orderId"`
+	Account     string          `json:"account,omitempty"`
+	Symbol      string          `json:"symbol"`
+	Side        Side            `json:"side"`
+	ExecType    ExecType        `json:"execType"`
+	OrdStatus   OrderStatus     `json:"ordStatus"`
+	Price       decimal.Decimal `json:"price"`
+	Qty         decimal.Decimal `json:"qty"`
+	LastPx      decimal.Decimal `json:"lastPx"`
+	LastQty     decimal.Decimal `json:"lastQty"`
+	CumQty      decimal.Decimal `json:"cumQty"`
+	LeavesQty   decimal.Decimal `json:"leavesQty"`
+	AvgPx       decimal.Decimal `json:"avgPx"`
+	Text        string          `json:"text,omitempty"`
+	Time        time.Time       `json:"time"`
+}
+
+// Fill reports whether the execution traded quantity.
+func (e Execution) Fill() bool {
+	return e.ExecType == ExecTypeTrade && e.LastQty.IsPositive()
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Position is the net position of an account in a symbol and its P&L of the
+// day. Qty is negative when short. Realized P&L is booked at the average
+// price when the position is reduced, unrealized P&L is the rest of the
+// position marked at Mark.
+type Position struct {
+	Account    string          `json:"account,omitempty"`
+	Symbol     string          `json:"symbol"`
+	Qty        decimal.Decimal `json:"qty"`
+	AvgPx      decimal.Decimal `json:"avgPx"`
+	Realized   decimal.Decimal `json:"realized"`
+	Unrealized decimal.Decimal `json:"unrealized"`
+	Mark       decimal.Decimal `json:"mark"`
+	Updated    time.Time       `json:"updated"`
+}
+
+// Fill adds a fill of qty at px on side to the position.
+func (p *Position) Fill(side Side, qty, px decimal.Decimal, at time.Time) {
+	signed := side.Signed(qty)
+	switch {
+	case p.Qty.IsZero() || p.Qty.Sign() == signed.Sign():
+		total := p.Qty.Abs().Add(qty)
+		p.AvgPx = p.Qty.Abs().Mul(p.AvgPx).Add(qty.Mul(px)).Div(total)
+		p.Qty = p.Qty.Add(signed)
+	default:
+		closed := decimal.Min(qty, p.Qty.Abs())
+		pnl := closed.Mul(px.Sub(p.AvgPx))
+		if p.Qty.IsNegative() {
+			pnl = pnl.Neg()
+		}
+		p.Realized = p.Realized.Add(pnl)
+		p.Qty = p.Qty.Add(signed)
+		switch {
+		case p.Qty.IsZero():
+			p.AvgPx = decimal.Zero
+		case p.Qty.Sign() == signed.Sign():
+			// Flipped, the rest opens a position at px.
+			p.AvgPx = px
+		}
+	}
+	p.Updated = at
+	p.MarkTo(p.Mark)
+}
+
+// MarkTo marks the position at mark, zero leaving it unmarked.
+func (p *Position) MarkTo(mark decimal.Decimal) {
+	p.Mark = mark
+	if !mark.IsPositive() || p.Qty.IsZero() {
+		p.Unrealized = decimal.Zero
+		return
+	}
+	p.Unrealized = mark.Sub(p.AvgPx).Mul(p.Qty)
+}
+
+// PnL returns the realized and unrealized P&L.
+func (p Position) PnL() decimal.Decimal {
+	return p.Realized.Add(p.Unrealized)
+}
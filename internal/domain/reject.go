@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Kinds of Reject.
+const (
+	RejectSession    = "SESSION"     // Reject (3)
+	RejectBusiness   = "BUSINESS"    // BusinessMessageReject (j)
+	RejectMarketData = "MARKET_DATA" // MarketDataRequestReject (Y)
+)
+
+// RejectReasonThrottled is the reason of a message over the rate limits of
+// the venue.
+const RejectReasonThrottled = "throttled"
+
+// Reject is a message of ours the venue rejected, at the session level or
+// by the application. RefID is the ID of the rejected request, e.g. its
+// ClOrdID or MDReqID. It is the error of the request.
+type Reject struct {
+	Kind       string    `json:"kind"`
+	RefMsgType string    `json:"refMsgType,omitempty"`
+	RefSeqNum  int       `json:"refSeqNum,omitempty"`
+	RefID      string    `json:"refId,omitempty"`
+	RefTagID   int       `json:"refTagId,omitempty"`
+	Reason     string    `json:"reason"`
+	Text       string    `json:"text,omitempty"`
+	Time       time.Time `json:"time"`
+}
+
+func (r *Reject) Error() string {
+	msg := fmt.Sprintf("%s rejected by the venue (%s)", r.RefMsgType, r.Reason)
+	if r.RefID != "" {
+		msg = fmt.Sprintf("%s %s rejected by the venue (%s)", r.RefMsgType, r.RefID, r.Reason)
+	}
+	if r.Text != "" {
+		msg += ": " + r.Text
+	}
+	return msg
+}
@@ -0,0 +1,34 @@
+package dq
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/api"
+)
+
+// Handler serves the data quality report.
+//
+//	GET               the report of every symbol
+//	GET ?active=true  only the symbols with failing conditions
+func Handler(m *Monitor) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			api.WriteError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+
+		reports := m.Report()
+		if r.URL.Query().Get("active") == "true" {
+			active := reports[:0]
+			for _, rep := range reports {
+				if len(rep.Active) > 0 {
+					active = append(active, rep)
+				}
+			}
+			reports = active
+		}
+		api.WriteJSON(w, http.StatusOK, reports)
+	})
+}
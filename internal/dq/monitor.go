@@ -0,0 +1,384 @@
+// Package dq monitors the quality of the market data: books without updates,
+// crossed and locked books, price jumps and invalid sizes.
+package dq
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+	"github.com/phimaker/waanx-fix-simpler/internal/logger"
+	"github.com/phimaker/waanx-fix-simpler/internal/metrics"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	log = logger.Named(logger.ComponentMarketData)
+
+	alertCounter = metrics.NewCounterVec("dq_alerts")
+)
+
+// Checks, used as alert and counter labels.
+const (
+	CheckStale     = "stale"
+	CheckCrossed   = "crossed"
+	CheckLocked    = "locked"
+	CheckPriceJump = "price_jump"
+	CheckBadSize   = "bad_size"
+)
+
+// DefaultStaleAfter is how long a symbol may go without updates.
+const DefaultStaleAfter = 30 * time.Second
+
+type symbolState struct {
+	updated   time.Time // venue time of the last book or trade
+	received  time.Time // local time the last book or trade was received
+	mid       decimal.Decimal
+	lastPrice decimal.Decimal
+	active    map[string]event.QualityAlert // by check
+	counts    map[string]int
+}
+
+// Monitor runs the data quality checks on the books, updates and trades of
+// the market data service and keeps a report per symbol. Failed checks are
+// returned as alerts for the caller to publish, except the stale check which
+// is run and published by Run.
+type Monitor struct {
+	mu          sync.Mutex
+	staleAfter  time.Duration
+	jumpTicks   int64
+	jumpPercent decimal.Decimal
+	tickSize    func(symbol string) decimal.Decimal
+	trading     func(symbol string) bool
+	bus         *event.Bus
+	now         func() time.Time
+	started     time.Time
+	symbols     map[string]*symbolState
+}
+
+type MonitorOpt func(*Monitor)
+
+// WithStaleAfter sets how long a symbol may go without updates while it is
+// trading, DefaultStaleAfter by default.
+func WithStaleAfter(d time.Duration) MonitorOpt {
+	return func(m *Monitor) {
+		m.staleAfter = d
+	}
+}
+
+// WithPriceJump flags mid and trade price moves of more than ticks ticks or
+// percent percent, zero disables either. Ticks need the tick size of the
+// symbol.
+func WithPriceJump(ticks int64, percent float64, tickSize func(symbol string) decimal.Decimal) MonitorOpt {
+	return func(m *Monitor) {
+		m.jumpTicks = ticks
+		m.jumpPercent = decimal.NewFromFloat(percent)
+		m.tickSize = tickSize
+	}
+}
+
+// WithTradingHours limits the stale check to the symbols for which trading
+// returns true, e.g. not halted and in an open session. All symbols are
+// checked by default.
+func WithTradingHours(trading func(symbol string) bool) MonitorOpt {
+	return func(m *Monitor) {
+		m.trading = trading
+	}
+}
+
+// WithClock sets the clock giving the receipt time of the updates, which
+// staleness is measured from, time.Now by default. It must agree with the
+// times passed to CheckStale.
+func WithClock(now func() time.Time) MonitorOpt {
+	return func(m *Monitor) {
+		m.now = now
+	}
+}
+
+// WithSymbols checks symbols for staleness before their first update.
+func WithSymbols(symbols ...string) MonitorOpt {
+	return func(m *Monitor) {
+		for _, symbol := range symbols {
+			m.symbol(symbol)
+		}
+	}
+}
+
+func NewMonitor(bus *event.Bus, opts ...MonitorOpt) *Monitor {
+	m := &Monitor{
+		staleAfter: DefaultStaleAfter,
+		trading:    func(string) bool { return true },
+		bus:        bus,
+		now:        time.Now,
+		symbols:    make(map[string]*symbolState),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.started = m.now()
+	return m
+}
+
+// CheckUpdate flags book updates adding or changing a level to a size that
+// is not positive.
+func (m *Monitor) CheckUpdate(u domain.BookUpdate) []event.QualityAlert {
+	if u.Action == domain.UpdateDelete || u.Level.Size.IsPositive() {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.raise(m.symbol(u.Symbol), u.Symbol, CheckBadSize, received(u.Time),
+		fmt.Sprintf("%s %s level %s with size %s", u.Action, u.Level.Side, u.Level.Price, u.Level.Size))
+}
+
+// CheckBook flags crossed and locked books and jumps of the mid, and clears
+// the stale alert of the symbol. Alerts take the venue time of the book,
+// staleness the time it was received.
+func (m *Monitor) CheckBook(b domain.Book) []event.QualityAlert {
+	now := received(b.Time)
+	q := b.Quote()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := m.symbol(b.Symbol)
+	s.updated, s.received = now, m.now()
+
+	var alerts []event.QualityAlert
+	alerts = append(alerts, m.clear(s, b.Symbol, CheckStale, now)...)
+
+	crossed := q.TwoSided() && q.BidPx.GreaterThan(q.AskPx)
+	locked := q.TwoSided() && q.BidPx.Equal(q.AskPx)
+	detail := fmt.Sprintf("bid %s ask %s", q.BidPx, q.AskPx)
+	alerts = append(alerts, m.set(s, b.Symbol, CheckCrossed, crossed, now, detail)...)
+	alerts = append(alerts, m.set(s, b.Symbol, CheckLocked, locked, now, detail)...)
+
+	if mid := q.Mid(); mid.IsPositive() {
+		if jump, ok := m.jump(b.Symbol, s.mid, mid); ok {
+			alerts = append(alerts, m.raise(s, b.Symbol, CheckPriceJump, now, "mid "+jump)...)
+		}
+		s.mid = mid
+	}
+	return alerts
+}
+
+// CheckTrade flags trades with a size that is not positive and jumps of the
+// trade price, and clears the stale alert of the symbol.
+func (m *Monitor) CheckTrade(t domain.Trade) []event.QualityAlert {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := m.symbol(t.Symbol)
+	at := received(t.Time)
+	s.updated, s.received = at, m.now()
+
+	var alerts []event.QualityAlert
+	alerts = append(alerts, m.clear(s, t.Symbol, CheckStale, at)...)
+	if !t.Size.IsPositive() {
+		alerts = append(alerts, m.raise(s, t.Symbol, CheckBadSize, at,
+			fmt.Sprintf("trade %s at %s with size %s", t.TradeID, t.Price, t.Size))...)
+	}
+	if t.Price.IsPositive() {
+		if jump, ok := m.jump(t.Symbol, s.lastPrice, t.Price); ok {
+			alerts = append(alerts, m.raise(s, t.Symbol, CheckPriceJump, at, "trade "+jump)...)
+		}
+		s.lastPrice = t.Price
+	}
+	return alerts
+}
+
+// CheckStale flags the trading symbols without updates for longer than the
+// stale interval, counted from the start of the monitor for the ones without
+// any yet, and clears the alert of the ones no longer trading.
+func (m *Monitor) CheckStale(now time.Time) []event.QualityAlert {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var alerts []event.QualityAlert
+	for symbol, s := range m.symbols {
+		if !m.trading(symbol) {
+			alerts = append(alerts, m.clear(s, symbol, CheckStale, now)...)
+			continue
+		}
+		last := s.received
+		if last.Before(m.started) {
+			last = m.started
+		}
+		if idle := now.Sub(last); idle > m.staleAfter {
+			alerts = append(alerts, m.set(s, symbol, CheckStale, true, now,
+				fmt.Sprintf("no update for %s", idle.Truncate(time.Second)))...)
+		}
+	}
+	return alerts
+}
+
+// Watch checks symbols for staleness from now on, like WithSymbols, e.g.
+// after subscribing to them.
+func (m *Monitor) Watch(symbols ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	for _, symbol := range symbols {
+		if _, ok := m.symbols[symbol]; !ok {
+			m.symbol(symbol).received = now
+		}
+	}
+}
+
+// Forget stops checking symbols, e.g. after unsubscribing from them, and
+// clears their alerts.
+func (m *Monitor) Forget(symbols ...string) []event.QualityAlert {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	var alerts []event.QualityAlert
+	for _, symbol := range symbols {
+		s, ok := m.symbols[symbol]
+		if !ok {
+			continue
+		}
+		checks := make([]string, 0, len(s.active))
+		for check := range s.active {
+			checks = append(checks, check)
+		}
+		sort.Strings(checks)
+		for _, check := range checks {
+			alerts = append(alerts, m.clear(s, symbol, check, now)...)
+		}
+		delete(m.symbols, symbol)
+	}
+	return alerts
+}
+
+// Run checks for stale symbols every second until ctx is done.
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			m.Publish(m.CheckStale(now))
+		}
+	}
+}
+
+// Publish publishes alerts on the bus.
+func (m *Monitor) Publish(alerts []event.QualityAlert) {
+	for _, a := range alerts {
+		m.bus.Publish(a)
+	}
+}
+
+func received(t time.Time) time.Time {
+	if t.IsZero() {
+		return time.Now().UTC()
+	}
+	return t
+}
+
+// jump reports whether the move from prev to next exceeds the thresholds.
+// m.mu must be held.
+func (m *Monitor) jump(symbol string, prev, next decimal.Decimal) (string, bool) {
+	if !prev.IsPositive() {
+		return "", false
+	}
+	move := next.Sub(prev).Abs()
+	if m.jumpTicks > 0 && m.tickSize != nil {
+		if tick := m.tickSize(symbol); tick.IsPositive() && move.Div(tick).GreaterThan(decimal.NewFromInt(m.jumpTicks)) {
+			return fmt.Sprintf("moved %s to %s, %s ticks", prev, next, move.Div(tick).Round(0)), true
+		}
+	}
+	if m.jumpPercent.IsPositive() {
+		if pct := move.Div(prev).Mul(decimal.NewFromInt(100)); pct.GreaterThan(m.jumpPercent) {
+			return fmt.Sprintf("moved %s to %s, %s%%", prev, next, pct.Round(2)), true
+		}
+	}
+	return "", false
+}
+
+// set raises or clears a lasting condition, returning the alert when it
+// changes. m.mu must be held.
+func (m *Monitor) set(s *symbolState, symbol, check string, failed bool, at time.Time, detail string) []event.QualityAlert {
+	if !failed {
+		return m.clear(s, symbol, check, at)
+	}
+	if _, ok := s.active[check]; ok {
+		return nil
+	}
+	alerts := m.raise(s, symbol, check, at, detail)
+	s.active[check] = alerts[0]
+	return alerts
+}
+
+// clear ends a lasting condition. m.mu must be held.
+func (m *Monitor) clear(s *symbolState, symbol, check string, at time.Time) []event.QualityAlert {
+	if _, ok := s.active[check]; !ok {
+		return nil
+	}
+	delete(s.active, check)
+	log.Infof("[DQ] %s %s cleared", symbol, check)
+	return []event.QualityAlert{{Symbol: symbol, Check: check, Detail: "cleared", Time: at}}
+}
+
+// raise counts and logs a failed check. m.mu must be held.
+func (m *Monitor) raise(s *symbolState, symbol, check string, at time.Time, detail string) []event.QualityAlert {
+	s.counts[check]++
+	alertCounter.Inc(symbol, check)
+	log.Warnf("[DQ] %s %s: %s", symbol, check, detail)
+	return []event.QualityAlert{{Symbol: symbol, Check: check, Active: true, Detail: detail, Time: at}}
+}
+
+// symbol returns the state of symbol, creating it. m.mu must be held.
+func (m *Monitor) symbol(symbol string) *symbolState {
+	s, ok := m.symbols[symbol]
+	if !ok {
+		s = &symbolState{
+			active: make(map[string]event.QualityAlert),
+			counts: make(map[string]int),
+		}
+		m.symbols[symbol] = s
+	}
+	return s
+}
+
+// SymbolReport is the data quality of a symbol: when it was last updated,
+// the conditions currently failing and the alerts raised per check.
+type SymbolReport struct {
+	Symbol  string               `json:"symbol"`
+	Updated time.Time            `json:"updated"`
+	Active  []event.QualityAlert `json:"active"`
+	Alerts  map[string]int       `json:"alerts"`
+}
+
+// Report returns the report of every symbol, by symbol.
+func (m *Monitor) Report() []SymbolReport {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]SymbolReport, 0, len(m.symbols))
+	for symbol, s := range m.symbols {
+		r := SymbolReport{
+			Symbol:  symbol,
+			Updated: s.updated,
+			Active:  make([]event.QualityAlert, 0, len(s.active)),
+			Alerts:  make(map[string]int, len(s.counts)),
+		}
+		for _, a := range s.active {
+			r.Active = append(r.Active, a)
+		}
+		sort.Slice(r.Active, func(i, j int) bool { return r.Active[i].Check < r.Active[j].Check })
+		for check, n := range s.counts {
+			r.Alerts[check] = n
+		}
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Symbol < out[j].Symbol })
+	return out
+}
+
+// Snapshot implements service.Snapshotter.
+func (m *Monitor) Snapshot() any {
+	return m.Report()
+}
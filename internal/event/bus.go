@@ -0,0 +1,86 @@
+package event
+
+import (
+	"strings"
+	"sync"
+)
+
+// TopicAll subscribes to every topic.
+const TopicAll = "*"
+
+// Event is anything published on the bus.
+type Event interface {
+	Topic() string
+}
+
+// State is implemented by events carrying the latest state of something, e.g.
+// the top of the book of a symbol. A consumer only interested in the current
+// state may drop all but the last event of each StateKey.
+type State interface {
+	Event
+	StateKey() string
+}
+
+// Handler is called synchronously by Publish. It must not block.
+type Handler func(Event)
+
+type subscription struct {
+	id    uint64
+	topic string
+	fn    Handler
+}
+
+// Bus delivers events to the handlers subscribed to their topic.
+type Bus struct {
+	mu     sync.RWMutex
+	nextID uint64
+	subs   []subscription
+}
+
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+// Subscribe calls fn for every event published on topic. A topic ending in
+// ".*" matches all topics with that prefix, TopicAll matches everything. The
+// returned function removes the subscription.
+func (b *Bus) Subscribe(topic string, fn Handler) (unsubscribe func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.nextID++
+	id := b.nextID
+	b.subs = append(b.subs, subscription{id: id, topic: topic, fn: fn})
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		for i, s := range b.subs {
+			if s.id == id {
+				b.subs = append(b.subs[:i:i], b.subs[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Publish delivers e to the matching handlers in subscription order.
+func (b *Bus) Publish(e Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, s := range b.subs {
+		if Match(s.topic, e.Topic()) {
+			s.fn(e)
+		}
+	}
+}
+
+// Match reports whether pattern, as accepted by Subscribe, matches topic.
+func Match(pattern, topic string) bool {
+	switch {
+	case pattern == TopicAll || pattern == topic:
+		return true
+	case strings.HasSuffix(pattern, ".*"):
+		return strings.HasPrefix(topic, pattern[:len(pattern)-1])
+	}
+	return false
+}
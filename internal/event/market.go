@@ -0,0 +1,59 @@
+package event
+
+import "github.com/phimaker/waanx-fix-simpler/internal/domain"
+
+const (
+	TopicTrade = "market.trade"
+	TopicQuote = "market.quote"
+	TopicBook  = "market.book"
+	// TopicAnalytics carries the metrics derived from the books.
+	TopicAnalytics = "market.analytics"
+	// TopicBar is followed by the interval, e.g. market.bar.1m.
+	TopicBar = "market.bar"
+)
+
+// Trade is published for every trade print received.
+type Trade struct {
+	domain.Trade
+}
+
+func (Trade) Topic() string { return TopicTrade }
+
+// Quote is published when the top of the book of a symbol changes.
+type Quote struct {
+	domain.Quote
+}
+
+func (Quote) Topic() string { return TopicQuote }
+
+func (e Quote) StateKey() string { return e.Symbol }
+
+// Book is published with the subscribed levels of a book after it changes.
+type Book struct {
+	domain.Book
+}
+
+func (Book) Topic() string { return TopicBook }
+
+func (e Book) StateKey() string { return e.Symbol }
+
+// Analytics is published when the metrics derived from a book change.
+type Analytics struct {
+	domain.Analytics
+}
+
+func (Analytics) Topic() string { return TopicAnalytics }
+
+func (e Analytics) StateKey() string { return e.Symbol }
+
+// Bar is published when a bar is closed.
+type Bar struct {
+	domain.Bar
+	VWAP string `json:"vwap"`
+}
+
+func NewBar(b domain.Bar) Bar {
+	return Bar{Bar: b, VWAP: b.VWAP().String()}
+}
+
+func (e Bar) Topic() string { return TopicBar + "." + e.Interval }
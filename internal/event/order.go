@@ -0,0 +1,25 @@
+package event
+
+import "github.com/phimaker/waanx-fix-simpler/internal/domain"
+
+const (
+	TopicOrder     = "order.update"
+	TopicExecution = "order.execution"
+)
+
+// Order is published with the state of an order after every change.
+type Order struct {
+	domain.Order
+}
+
+func (Order) Topic() string { return TopicOrder }
+
+func (e Order) StateKey() string { return e.ClOrdID }
+
+// Execution is published for every execution report received, and for the
+// synthetic rejects of orders failing the risk checks.
+type Execution struct {
+	domain.Execution
+}
+
+func (Execution) Topic() string { return TopicExecution }
@@ -0,0 +1,35 @@
+package event
+
+import (
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	TopicPosition      = "position.update"
+	TopicPositionBreak = "alert.position"
+)
+
+// Position is published with the position of an account in a symbol after
+// every fill.
+type Position struct {
+	domain.Position
+}
+
+func (Position) Topic() string { return TopicPosition }
+
+func (e Position) StateKey() string { return e.Account + "|" + e.Symbol }
+
+// PositionBreak is published when the position kept from the fills differs
+// from the one reported by the venue.
+type PositionBreak struct {
+	Account string          `json:"account,omitempty"`
+	Symbol  string          `json:"symbol"`
+	Local   decimal.Decimal `json:"local"`
+	Venue   decimal.Decimal `json:"venue"`
+	Time    time.Time       `json:"time"`
+}
+
+func (PositionBreak) Topic() string { return TopicPositionBreak }
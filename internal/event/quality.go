@@ -0,0 +1,18 @@
+package event
+
+import "time"
+
+const TopicQualityAlert = "alert.dq"
+
+// QualityAlert is published when a data quality check fails for a symbol.
+// Conditions lasting a while (a stale or crossed book) are published again
+// with Active false once they clear.
+type QualityAlert struct {
+	Symbol string    `json:"symbol"`
+	Check  string    `json:"check"`
+	Active bool      `json:"active"`
+	Detail string    `json:"detail"`
+	Time   time.Time `json:"time"`
+}
+
+func (QualityAlert) Topic() string { return TopicQualityAlert }
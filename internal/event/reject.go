@@ -0,0 +1,13 @@
+package event
+
+import "github.com/phimaker/waanx-fix-simpler/internal/domain"
+
+const TopicReject = "alert.reject"
+
+// Reject is published for every Reject and BusinessMessageReject of the
+// venue, with the request it refers to when known.
+type Reject struct {
+	domain.Reject
+}
+
+func (Reject) Topic() string { return TopicReject }
@@ -0,0 +1,58 @@
+package event
+
+import "time"
+
+const (
+	TopicSecurityStatus = "status.security"
+	TopicSessionStatus  = "status.session"
+	// TopicMarketDataStatus reports sequence gaps and recoveries of the
+	// market data of a symbol.
+	TopicMarketDataStatus = "status.marketdata"
+)
+
+// SecurityStatus is published when the trading status of an instrument
+// changes. Halted is set while orders for the instrument must not be sent.
+type SecurityStatus struct {
+	Symbol     string    `json:"symbol"`
+	SecurityID string    `json:"securityId,omitempty"`
+	Status     string    `json:"status"`
+	Halted     bool      `json:"halted"`
+	HaltReason string    `json:"haltReason,omitempty"`
+	Time       time.Time `json:"time"`
+}
+
+func (SecurityStatus) Topic() string { return TopicSecurityStatus }
+
+// SessionStatus is published when a trading session changes phase.
+type SessionStatus struct {
+	TradingSessionID string    `json:"tradingSessionId"`
+	Status           string    `json:"status"`
+	Open             bool      `json:"open"`
+	Time             time.Time `json:"time"`
+}
+
+func (SessionStatus) Topic() string { return TopicSessionStatus }
+
+// Market data statuses.
+const (
+	MarketDataStale     = "STALE"
+	MarketDataRecovered = "RECOVERED"
+	MarketDataFailed    = "FAILED"
+)
+
+// MarketDataStatus is published when a gap in the RptSeq of a symbol makes
+// its book stale, with the expected and received sequence numbers, when the
+// book is recovered from a snapshot, with the number of buffered
+// incrementals replayed on top of it, and when the venue rejects the
+// subscription or the snapshot request of the symbol, with the reason.
+type MarketDataStatus struct {
+	Symbol   string    `json:"symbol"`
+	Status   string    `json:"status"`
+	Expected int       `json:"expected,omitempty"`
+	Received int       `json:"received,omitempty"`
+	Replayed int       `json:"replayed,omitempty"`
+	Text     string    `json:"text,omitempty"`
+	Time     time.Time `json:"time"`
+}
+
+func (MarketDataStatus) Topic() string { return TopicMarketDataStatus }
@@ -0,0 +1,24 @@
+package event
+
+import "time"
+
+const TopicThrottle = "alert.throttle"
+
+// Actions of a Throttle event.
+const (
+	ThrottleQueued   = "queued"   // delayed until the limit allowed it
+	ThrottleRejected = "rejected" // not sent
+	ThrottleBackoff  = "backoff"  // the venue rejected a message for its rate
+)
+
+// Throttle is published when a message sent is delayed or rejected by the
+// rate limits, or rejected by the venue for its own limits.
+type Throttle struct {
+	MsgType string        `json:"msgType"`
+	Action  string        `json:"action"`
+	Wait    time.Duration `json:"wait,omitempty"`
+	Text    string        `json:"text,omitempty"`
+	Time    time.Time     `json:"time"`
+}
+
+func (Throttle) Topic() string { return TopicThrottle }
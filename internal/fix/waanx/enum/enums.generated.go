@@ -0,0 +1,760 @@
+package enum
+
+// ApplQueueResolution field enumeration values.
+type ApplQueueResolution string
+
+const (
+	ApplQueueResolution_NOACTIONTAKEN ApplQueueResolution = "0"
+	ApplQueueResolution_QUEUEFLUSHED  ApplQueueResolution = "1"
+	ApplQueueResolution_OVERLAYLAST   ApplQueueResolution = "2"
+	ApplQueueResolution_ENDSESSION    ApplQueueResolution = "3"
+)
+
+// BenchmarkCurveName field enumeration values.
+type BenchmarkCurveName string
+
+const (
+	BenchmarkCurveName_EONIA       BenchmarkCurveName = "EONIA"
+	BenchmarkCurveName_EUREPO      BenchmarkCurveName = "EUREPO"
+	BenchmarkCurveName_EURIBOR     BenchmarkCurveName = "Euribor"
+	BenchmarkCurveName_FUTURESWAP  BenchmarkCurveName = "FutureSWAP"
+	BenchmarkCurveName_LIBID       BenchmarkCurveName = "LIBID"
+	BenchmarkCurveName_LIBOR       BenchmarkCurveName = "LIBOR"
+	BenchmarkCurveName_MUNIAAA     BenchmarkCurveName = "MuniAAA"
+	BenchmarkCurveName_OTHER       BenchmarkCurveName = "OTHER"
+	BenchmarkCurveName_PFANDBRIEFE BenchmarkCurveName = "Pfandbriefe"
+	BenchmarkCurveName_SONIA       BenchmarkCurveName = "SONIA"
+	BenchmarkCurveName_SWAP        BenchmarkCurveName = "SWAP"
+	BenchmarkCurveName_TREASURY    BenchmarkCurveName = "Treasury"
+)
+
+// CPProgram field enumeration values.
+type CPProgram string
+
+const (
+	CPProgram_3A3   CPProgram = "1"
+	CPProgram_42    CPProgram = "2"
+	CPProgram_OTHER CPProgram = "99"
+)
+
+// CorporateAction field enumeration values.
+type CorporateAction string
+
+const (
+	CorporateAction_EXDIVIDEND CorporateAction = "A"
+	CorporateAction_EXDIST     CorporateAction = "B"
+	CorporateAction_EXRIGHTS   CorporateAction = "C"
+	CorporateAction_NEW        CorporateAction = "D"
+	CorporateAction_EXINTEREST CorporateAction = "E"
+)
+
+// DeleteReason field enumeration values.
+type DeleteReason string
+
+const (
+	DeleteReason_CANCELTRADEBUST DeleteReason = "0"
+	DeleteReason_ERROR           DeleteReason = "1"
+)
+
+// DeliveryForm field enumeration values.
+type DeliveryForm string
+
+const (
+	DeliveryForm_BOOKENTRY DeliveryForm = "1"
+	DeliveryForm_BEARER    DeliveryForm = "2"
+)
+
+// DeliveryType field enumeration values.
+type DeliveryType string
+
+const (
+	DeliveryType_VERSUSPAYMENT DeliveryType = "0"
+	DeliveryType_FREE          DeliveryType = "1"
+	DeliveryType_TRIPARTY      DeliveryType = "2"
+	DeliveryType_HOLDINCUSTODY DeliveryType = "3"
+)
+
+// EncryptMethod field enumeration values.
+type EncryptMethod string
+
+const (
+	EncryptMethod_NONEOTHER                                        EncryptMethod = "0"
+	EncryptMethod_PKCSPROPRIETARY                                  EncryptMethod = "1"
+	EncryptMethod_DESECBMODE                                       EncryptMethod = "2"
+	EncryptMethod_PKCSDESPROPRIETARY                               EncryptMethod = "3"
+	EncryptMethod_PGPDESDEFUNCT                                    EncryptMethod = "4"
+	EncryptMethod_PGPDESMD5SEEAPPNOTEONFIXWEBSITE                  EncryptMethod = "5"
+	EncryptMethod_PEMDESMD5SEEAPPNOTEONFIXWEBSITENAFORFIXMLNOTUSED EncryptMethod = "6"
+)
+
+// EventType field enumeration values.
+type EventType string
+
+const (
+	EventType_PUT             EventType = "1"
+	EventType_CALL            EventType = "2"
+	EventType_TENDER          EventType = "3"
+	EventType_SINKINGFUNDCALL EventType = "4"
+	EventType_OTHER           EventType = "99"
+)
+
+// ExecInst field enumeration values.
+type ExecInst string
+
+const (
+	ExecInst_STAYOFFER            ExecInst = "0"
+	ExecInst_NOTHELD              ExecInst = "1"
+	ExecInst_WORK                 ExecInst = "2"
+	ExecInst_GOALONG              ExecInst = "3"
+	ExecInst_OVERDAY              ExecInst = "4"
+	ExecInst_HELD                 ExecInst = "5"
+	ExecInst_PARTNOTINIT          ExecInst = "6"
+	ExecInst_STRICTSCALE          ExecInst = "7"
+	ExecInst_TRYTOSCALE           ExecInst = "8"
+	ExecInst_STAYBID              ExecInst = "9"
+	ExecInst_NOCROSS              ExecInst = "A"
+	ExecInst_OKCROSS              ExecInst = "B"
+	ExecInst_CALLFIRST            ExecInst = "C"
+	ExecInst_PERCVOL              ExecInst = "D"
+	ExecInst_DNI                  ExecInst = "E"
+	ExecInst_DNR                  ExecInst = "F"
+	ExecInst_AON                  ExecInst = "G"
+	ExecInst_RESTATEONSYSFAIL     ExecInst = "H"
+	ExecInst_INSTITONLY           ExecInst = "I"
+	ExecInst_RESTATEONTRADINGHALT ExecInst = "J"
+	ExecInst_CANCELONTRADINGHALT  ExecInst = "K"
+	ExecInst_LASTPEG              ExecInst = "L"
+	ExecInst_MIDPRCPEG            ExecInst = "M"
+	ExecInst_NONNEGO              ExecInst = "N"
+	ExecInst_OPENPEG              ExecInst = "O"
+	ExecInst_MARKPEG              ExecInst = "P"
+	ExecInst_CANCELONSYSFAIL      ExecInst = "Q"
+	ExecInst_PRIMPEG              ExecInst = "R"
+	ExecInst_SUSPEND              ExecInst = "S"
+	ExecInst_CUSTDISPINST         ExecInst = "U"
+	ExecInst_NETTING              ExecInst = "V"
+	ExecInst_PEGVWAP              ExecInst = "W"
+	ExecInst_TRADEALONG           ExecInst = "X"
+	ExecInst_TRYTOSTOP            ExecInst = "Y"
+	ExecInst_CXLIFNOTBEST         ExecInst = "Z"
+	ExecInst_TRAILSTOPPEG         ExecInst = "a"
+	ExecInst_STRICTLIMIT          ExecInst = "b"
+	ExecInst_IGNOREPRICECHK       ExecInst = "c"
+	ExecInst_PEGTOLIMIT           ExecInst = "d"
+	ExecInst_WORKTOSTRATEGY       ExecInst = "e"
+)
+
+// ExpirationCycle field enumeration values.
+type ExpirationCycle string
+
+const (
+	ExpirationCycle_EXPIREONTRADINGSESSIONCLOSE ExpirationCycle = "0"
+	ExpirationCycle_EXPIREONTRADINGSESSIONOPEN  ExpirationCycle = "1"
+)
+
+// FinancialStatus field enumeration values.
+type FinancialStatus string
+
+const (
+	FinancialStatus_BANKRUPT         FinancialStatus = "1"
+	FinancialStatus_PENDINGDELISTING FinancialStatus = "2"
+)
+
+// InstrAttribType field enumeration values.
+type InstrAttribType string
+
+const (
+	InstrAttribType_FLAT                                                InstrAttribType = "1"
+	InstrAttribType_ORIGINALISSUEDISCOUNT                               InstrAttribType = "10"
+	InstrAttribType_CALLABLEPUTTABLE                                    InstrAttribType = "11"
+	InstrAttribType_ESCROWEDTOMATURITY                                  InstrAttribType = "12"
+	InstrAttribType_ESCROWEDTOREDEMPTIONDATE                            InstrAttribType = "13"
+	InstrAttribType_PREREFUNDED                                         InstrAttribType = "14"
+	InstrAttribType_INDEFAULT                                           InstrAttribType = "15"
+	InstrAttribType_UNRATED                                             InstrAttribType = "16"
+	InstrAttribType_TAXABLE                                             InstrAttribType = "17"
+	InstrAttribType_INDEXED                                             InstrAttribType = "18"
+	InstrAttribType_SUBJECTTOALTERNATIVEMINIMUMTAX                      InstrAttribType = "19"
+	InstrAttribType_ZEROCOUPON                                          InstrAttribType = "2"
+	InstrAttribType_ORIGINALISSUEDISCOUNTPRICE                          InstrAttribType = "20"
+	InstrAttribType_CALLABLEBELOWMATURITYVALUE                          InstrAttribType = "21"
+	InstrAttribType_CALLABLEWITHOUTNOTICEBYMAILTOHOLDERUNLESSREGISTERED InstrAttribType = "22"
+	InstrAttribType_INTERESTBEARING                                     InstrAttribType = "3"
+	InstrAttribType_NOPERIODICPAYMENTS                                  InstrAttribType = "4"
+	InstrAttribType_VARIABLERATE                                        InstrAttribType = "5"
+	InstrAttribType_LESSFEEFORPUT                                       InstrAttribType = "6"
+	InstrAttribType_STEPPEDCOUPON                                       InstrAttribType = "7"
+	InstrAttribType_COUPONPERIOD                                        InstrAttribType = "8"
+	InstrAttribType_WHENISSUED                                          InstrAttribType = "9"
+	InstrAttribType_TEXT                                                InstrAttribType = "99"
+)
+
+// InstrRegistry field enumeration values.
+type InstrRegistry string
+
+const (
+	InstrRegistry_CUSTODIAN InstrRegistry = "BIC"
+	InstrRegistry_COUNTRY   InstrRegistry = "ISO"
+	InstrRegistry_PHYSICAL  InstrRegistry = "ZZ"
+)
+
+// LastFragment field enumeration values.
+type LastFragment string
+
+const (
+	LastFragment_NO  LastFragment = "N"
+	LastFragment_YES LastFragment = "Y"
+)
+
+// LegSwapType field enumeration values.
+type LegSwapType string
+
+const (
+	LegSwapType_PARFORPAR        LegSwapType = "1"
+	LegSwapType_MODIFIEDDURATION LegSwapType = "2"
+	LegSwapType_RISK             LegSwapType = "4"
+	LegSwapType_PROCEEDS         LegSwapType = "5"
+)
+
+// MDEntryType field enumeration values.
+type MDEntryType string
+
+const (
+	MDEntryType_BID          MDEntryType = "0"
+	MDEntryType_OFFER        MDEntryType = "1"
+	MDEntryType_TRADE        MDEntryType = "2"
+	MDEntryType_INDEXVALUE   MDEntryType = "3"
+	MDEntryType_OPENING      MDEntryType = "4"
+	MDEntryType_CLOSING      MDEntryType = "5"
+	MDEntryType_SETTLEMENT   MDEntryType = "6"
+	MDEntryType_TRADINGHIGH  MDEntryType = "7"
+	MDEntryType_TRADINGLOW   MDEntryType = "8"
+	MDEntryType_TRADINGVWAP  MDEntryType = "9"
+	MDEntryType_IMBALANCE    MDEntryType = "A"
+	MDEntryType_TRADEVOLUME  MDEntryType = "B"
+	MDEntryType_OPENINTEREST MDEntryType = "C"
+)
+
+// MDUpdateAction field enumeration values.
+type MDUpdateAction string
+
+const (
+	MDUpdateAction_NEW    MDUpdateAction = "0"
+	MDUpdateAction_CHANGE MDUpdateAction = "1"
+	MDUpdateAction_DELETE MDUpdateAction = "2"
+)
+
+// MsgDirection field enumeration values.
+type MsgDirection string
+
+const (
+	MsgDirection_RECEIVE MsgDirection = "R"
+	MsgDirection_SEND    MsgDirection = "S"
+)
+
+// MsgType field enumeration values.
+type MsgType string
+
+const (
+	MsgType_HEARTBEAT                               MsgType = "0"
+	MsgType_TESTREQUEST                             MsgType = "1"
+	MsgType_RESENDREQUEST                           MsgType = "2"
+	MsgType_REJECT                                  MsgType = "3"
+	MsgType_SEQUENCERESET                           MsgType = "4"
+	MsgType_LOGOUT                                  MsgType = "5"
+	MsgType_IOI                                     MsgType = "6"
+	MsgType_ADVERTISEMENT                           MsgType = "7"
+	MsgType_EXECUTIONREPORT                         MsgType = "8"
+	MsgType_ORDERCANCELREJECT                       MsgType = "9"
+	MsgType_LOGON                                   MsgType = "A"
+	MsgType_DERIVATIVESECURITYLIST                  MsgType = "AA"
+	MsgType_NEWORDERMULTILEG                        MsgType = "AB"
+	MsgType_MULTILEGORDERCANCELREPLACE              MsgType = "AC"
+	MsgType_TRADECAPTUREREPORTREQUEST               MsgType = "AD"
+	MsgType_TRADECAPTUREREPORT                      MsgType = "AE"
+	MsgType_ORDERMASSSTATUSREQUEST                  MsgType = "AF"
+	MsgType_QUOTEREQUESTREJECT                      MsgType = "AG"
+	MsgType_RFQREQUEST                              MsgType = "AH"
+	MsgType_QUOTESTATUSREPORT                       MsgType = "AI"
+	MsgType_QUOTERESPONSE                           MsgType = "AJ"
+	MsgType_CONFIRMATION                            MsgType = "AK"
+	MsgType_POSITIONMAINTENANCEREQUEST              MsgType = "AL"
+	MsgType_POSITIONMAINTENANCEREPORT               MsgType = "AM"
+	MsgType_REQUESTFORPOSITIONS                     MsgType = "AN"
+	MsgType_REQUESTFORPOSITIONSACK                  MsgType = "AO"
+	MsgType_POSITIONREPORT                          MsgType = "AP"
+	MsgType_TRADECAPTUREREPORTREQUESTACK            MsgType = "AQ"
+	MsgType_TRADECAPTUREREPORTACK                   MsgType = "AR"
+	MsgType_ALLOCATIONREPORT                        MsgType = "AS"
+	MsgType_ALLOCATIONREPORTACK                     MsgType = "AT"
+	MsgType_CONFIRMATIONACK                         MsgType = "AU"
+	MsgType_SETTLEMENTINSTRUCTIONREQUEST            MsgType = "AV"
+	MsgType_ASSIGNMENTREPORT                        MsgType = "AW"
+	MsgType_COLLATERALREQUEST                       MsgType = "AX"
+	MsgType_COLLATERALASSIGNMENT                    MsgType = "AY"
+	MsgType_COLLATERALRESPONSE                      MsgType = "AZ"
+	MsgType_NEWS                                    MsgType = "B"
+	MsgType_COLLATERALREPORT                        MsgType = "BA"
+	MsgType_COLLATERALINQUIRY                       MsgType = "BB"
+	MsgType_NETWORKCOUNTERPARTYSYSTEMSTATUSREQUEST  MsgType = "BC"
+	MsgType_NETWORKCOUNTERPARTYSYSTEMSTATUSRESPONSE MsgType = "BD"
+	MsgType_USERREQUEST                             MsgType = "BE"
+	MsgType_USERRESPONSE                            MsgType = "BF"
+	MsgType_COLLATERALINQUIRYACK                    MsgType = "BG"
+	MsgType_CONFIRMATIONREQUEST                     MsgType = "BH"
+	MsgType_EMAIL                                   MsgType = "C"
+	MsgType_NEWORDERSINGLE                          MsgType = "D"
+	MsgType_NEWORDERLIST                            MsgType = "E"
+	MsgType_ORDERCANCELREQUEST                      MsgType = "F"
+	MsgType_ORDERCANCELREPLACEREQUEST               MsgType = "G"
+	MsgType_ORDERSTATUSREQUEST                      MsgType = "H"
+	MsgType_ALLOCATIONINSTRUCTION                   MsgType = "J"
+	MsgType_LISTCANCELREQUEST                       MsgType = "K"
+	MsgType_LISTEXECUTE                             MsgType = "L"
+	MsgType_LISTSTATUSREQUEST                       MsgType = "M"
+	MsgType_LISTSTATUS                              MsgType = "N"
+	MsgType_ALLOCATIONINSTRUCTIONACK                MsgType = "P"
+	MsgType_DONTKNOWTRADEDK                         MsgType = "Q"
+	MsgType_QUOTEREQUEST                            MsgType = "R"
+	MsgType_QUOTE                                   MsgType = "S"
+	MsgType_SETTLEMENTINSTRUCTIONS                  MsgType = "T"
+	MsgType_MARKETDATAREQUEST                       MsgType = "V"
+	MsgType_MARKETDATASNAPSHOTFULLREFRESH           MsgType = "W"
+	MsgType_MARKETDATAINCREMENTALREFRESH            MsgType = "X"
+	MsgType_MARKETDATAREQUESTREJECT                 MsgType = "Y"
+	MsgType_QUOTECANCEL                             MsgType = "Z"
+	MsgType_QUOTESTATUSREQUEST                      MsgType = "a"
+	MsgType_MASSQUOTEACKNOWLEDGEMENT                MsgType = "b"
+	MsgType_SECURITYDEFINITIONREQUEST               MsgType = "c"
+	MsgType_SECURITYDEFINITION                      MsgType = "d"
+	MsgType_SECURITYSTATUSREQUEST                   MsgType = "e"
+	MsgType_SECURITYSTATUS                          MsgType = "f"
+	MsgType_TRADINGSESSIONSTATUSREQUEST             MsgType = "g"
+	MsgType_TRADINGSESSIONSTATUS                    MsgType = "h"
+	MsgType_MASSQUOTE                               MsgType = "i"
+	MsgType_BUSINESSMESSAGEREJECT                   MsgType = "j"
+	MsgType_BIDREQUEST                              MsgType = "k"
+	MsgType_BIDRESPONSE                             MsgType = "l"
+	MsgType_LISTSTRIKEPRICE                         MsgType = "m"
+	MsgType_XMLNONFIX                               MsgType = "n"
+	MsgType_REGISTRATIONINSTRUCTIONS                MsgType = "o"
+	MsgType_REGISTRATIONINSTRUCTIONSRESPONSE        MsgType = "p"
+	MsgType_ORDERMASSCANCELREQUEST                  MsgType = "q"
+	MsgType_ORDERMASSCANCELREPORT                   MsgType = "r"
+	MsgType_NEWORDERCROSS                           MsgType = "s"
+	MsgType_CROSSORDERCANCELREPLACEREQUEST          MsgType = "t"
+	MsgType_CROSSORDERCANCELREQUEST                 MsgType = "u"
+	MsgType_SECURITYTYPEREQUEST                     MsgType = "v"
+	MsgType_SECURITYTYPES                           MsgType = "w"
+	MsgType_SECURITYLISTREQUEST                     MsgType = "x"
+	MsgType_SECURITYLIST                            MsgType = "y"
+	MsgType_DERIVATIVESECURITYLISTREQUEST           MsgType = "z"
+)
+
+// OpenCloseSettlFlag field enumeration values.
+type OpenCloseSettlFlag string
+
+const (
+	OpenCloseSettlFlag_DAILYOPEN                OpenCloseSettlFlag = "0"
+	OpenCloseSettlFlag_SESSIONOPEN              OpenCloseSettlFlag = "1"
+	OpenCloseSettlFlag_DELIVERYSETTLEMENT       OpenCloseSettlFlag = "2"
+	OpenCloseSettlFlag_EXPECTEDENTRY            OpenCloseSettlFlag = "3"
+	OpenCloseSettlFlag_ENTRYFROMPREVBUSINESSDAY OpenCloseSettlFlag = "4"
+	OpenCloseSettlFlag_THEORETICALPRICE         OpenCloseSettlFlag = "5"
+)
+
+// PossDupFlag field enumeration values.
+type PossDupFlag string
+
+const (
+	PossDupFlag_NO  PossDupFlag = "N"
+	PossDupFlag_YES PossDupFlag = "Y"
+)
+
+// PossResend field enumeration values.
+type PossResend string
+
+const (
+	PossResend_NO  PossResend = "N"
+	PossResend_YES PossResend = "Y"
+)
+
+// Product field enumeration values.
+type Product string
+
+const (
+	Product_AGENCY      Product = "1"
+	Product_MORTGAGE    Product = "10"
+	Product_MUNICIPAL   Product = "11"
+	Product_OTHER       Product = "12"
+	Product_FINANCING   Product = "13"
+	Product_COMMODITY   Product = "2"
+	Product_CORPORATE   Product = "3"
+	Product_CURRENCY    Product = "4"
+	Product_EQUITY      Product = "5"
+	Product_GOVERNMENT  Product = "6"
+	Product_INDEX       Product = "7"
+	Product_LOAN        Product = "8"
+	Product_MONEYMARKET Product = "9"
+)
+
+// QuoteCondition field enumeration values.
+type QuoteCondition string
+
+const (
+	QuoteCondition_OPEN       QuoteCondition = "A"
+	QuoteCondition_CLOSED     QuoteCondition = "B"
+	QuoteCondition_EXCHBEST   QuoteCondition = "C"
+	QuoteCondition_CONSOLBEST QuoteCondition = "D"
+	QuoteCondition_LOCKED     QuoteCondition = "E"
+	QuoteCondition_CROSSED    QuoteCondition = "F"
+	QuoteCondition_DEPTH      QuoteCondition = "G"
+	QuoteCondition_FAST       QuoteCondition = "H"
+	QuoteCondition_NONFIRM    QuoteCondition = "I"
+)
+
+// ResetSeqNumFlag field enumeration values.
+type ResetSeqNumFlag string
+
+const (
+	ResetSeqNumFlag_NO  ResetSeqNumFlag = "N"
+	ResetSeqNumFlag_YES ResetSeqNumFlag = "Y"
+)
+
+// Scope field enumeration values.
+type Scope string
+
+const (
+	Scope_LOCALMARKET Scope = "1"
+	Scope_NATIONAL    Scope = "2"
+	Scope_GLOBAL      Scope = "3"
+)
+
+// SecurityIDSource field enumeration values.
+type SecurityIDSource string
+
+const (
+	SecurityIDSource_CUSIP                         SecurityIDSource = "1"
+	SecurityIDSource_SEDOL                         SecurityIDSource = "2"
+	SecurityIDSource_QUIK                          SecurityIDSource = "3"
+	SecurityIDSource_ISIN                          SecurityIDSource = "4"
+	SecurityIDSource_RIC                           SecurityIDSource = "5"
+	SecurityIDSource_ISOCURR                       SecurityIDSource = "6"
+	SecurityIDSource_ISOCOUNTRY                    SecurityIDSource = "7"
+	SecurityIDSource_EXCHSYMB                      SecurityIDSource = "8"
+	SecurityIDSource_CTA                           SecurityIDSource = "9"
+	SecurityIDSource_BLMBRG                        SecurityIDSource = "A"
+	SecurityIDSource_WERTPAPIER                    SecurityIDSource = "B"
+	SecurityIDSource_DUTCH                         SecurityIDSource = "C"
+	SecurityIDSource_VALOREN                       SecurityIDSource = "D"
+	SecurityIDSource_SICOVAM                       SecurityIDSource = "E"
+	SecurityIDSource_BELGIAN                       SecurityIDSource = "F"
+	SecurityIDSource_COMMON                        SecurityIDSource = "G"
+	SecurityIDSource_CLEARINGHOUSE                 SecurityIDSource = "H"
+	SecurityIDSource_FPML                          SecurityIDSource = "I"
+	SecurityIDSource_OPTIONPRICEREPORTINGAUTHORITY SecurityIDSource = "J"
+)
+
+// SecurityRequestResult field enumeration values.
+type SecurityRequestResult string
+
+const (
+	SecurityRequestResult_VALIDREQ              SecurityRequestResult = "0"
+	SecurityRequestResult_INVALIDREQ            SecurityRequestResult = "1"
+	SecurityRequestResult_NOINSTRUMENTSFOUND    SecurityRequestResult = "2"
+	SecurityRequestResult_NOTAUTHORIZED         SecurityRequestResult = "3"
+	SecurityRequestResult_INSTRUMENTUNAVAILABLE SecurityRequestResult = "4"
+	SecurityRequestResult_NOTSUPPORTED          SecurityRequestResult = "5"
+)
+
+// SecurityType field enumeration values.
+type SecurityType string
+
+const (
+	SecurityType_ASSETBACKEDSECURITIES                    SecurityType = "ABS"
+	SecurityType_AMENDEDRESTATED                          SecurityType = "AMENDED"
+	SecurityType_OTHERANTICIPATIONNOTESBANGANETC          SecurityType = "AN"
+	SecurityType_BANKERSACCEPTANCE                        SecurityType = "BA"
+	SecurityType_BANKNOTES                                SecurityType = "BN"
+	SecurityType_BILLOFEXCHANGES                          SecurityType = "BOX"
+	SecurityType_BRADYBOND                                SecurityType = "BRADY"
+	SecurityType_BRIDGELOAN                               SecurityType = "BRIDGE"
+	SecurityType_BUYSELLBACK                              SecurityType = "BUYSELL"
+	SecurityType_CONVERTIBLEBOND                          SecurityType = "CB"
+	SecurityType_CERTIFICATEOFDEPOSIT                     SecurityType = "CD"
+	SecurityType_CALLLOANS                                SecurityType = "CL"
+	SecurityType_CORPMORTGAGEBACKEDSECURITIES             SecurityType = "CMBS"
+	SecurityType_COLLATERALIZEDMORTGAGEOBLIGATION         SecurityType = "CMO"
+	SecurityType_CERTIFICATEOFOBLIGATION                  SecurityType = "COFO"
+	SecurityType_CERTIFICATEOFPARTICIPATION               SecurityType = "COFP"
+	SecurityType_CORPORATEBOND                            SecurityType = "CORP"
+	SecurityType_COMMERCIALPAPER                          SecurityType = "CP"
+	SecurityType_CORPORATEPRIVATEPLACEMENT                SecurityType = "CPP"
+	SecurityType_COMMONSTOCK                              SecurityType = "CS"
+	SecurityType_DEFAULTED                                SecurityType = "DEFLTED"
+	SecurityType_DEBTORINPOSSESSION                       SecurityType = "DINP"
+	SecurityType_DEPOSITNOTES                             SecurityType = "DN"
+	SecurityType_DUALCURRENCY                             SecurityType = "DUAL"
+	SecurityType_EUROCERTIFICATEOFDEPOSIT                 SecurityType = "EUCD"
+	SecurityType_EUROCORPORATEBOND                        SecurityType = "EUCORP"
+	SecurityType_EUROCOMMERCIALPAPER                      SecurityType = "EUCP"
+	SecurityType_EUROSOVEREIGNS                           SecurityType = "EUSOV"
+	SecurityType_EUROSUPRANATIONALCOUPONS                 SecurityType = "EUSUPRA"
+	SecurityType_FEDERALAGENCYCOUPON                      SecurityType = "FAC"
+	SecurityType_FEDERALAGENCYDISCOUNTNOTE                SecurityType = "FADN"
+	SecurityType_FOREIGNEXCHANGECONTRACT                  SecurityType = "FOR"
+	SecurityType_FORWARD                                  SecurityType = "FORWARD"
+	SecurityType_FUTURE                                   SecurityType = "FUT"
+	SecurityType_GENERALOBLIGATIONBONDS                   SecurityType = "GO"
+	SecurityType_IOETTEMORTGAGE                           SecurityType = "IET"
+	SecurityType_LETTEROFCREDIT                           SecurityType = "LOFC"
+	SecurityType_LIQUIDITYNOTE                            SecurityType = "LQN"
+	SecurityType_MATURED                                  SecurityType = "MATURED"
+	SecurityType_MORTGAGEBACKEDSECURITIES                 SecurityType = "MBS"
+	SecurityType_MUTUALFUND                               SecurityType = "MF"
+	SecurityType_MORTGAGEINTERESTONLY                     SecurityType = "MIO"
+	SecurityType_MULTILEGINSTRUMENT                       SecurityType = "MLEG"
+	SecurityType_MORTGAGEPRINCIPALONLY                    SecurityType = "MPO"
+	SecurityType_MORTGAGEPRIVATEPLACEMENT                 SecurityType = "MPP"
+	SecurityType_MISCELLANEOUSPASSTHROUGH                 SecurityType = "MPT"
+	SecurityType_MANDATORYTENDER                          SecurityType = "MT"
+	SecurityType_MEDIUMTERMNOTES                          SecurityType = "MTN"
+	SecurityType_NOSECURITYTYPE                           SecurityType = "NONE"
+	SecurityType_OVERNIGHT                                SecurityType = "ONITE"
+	SecurityType_OPTION                                   SecurityType = "OPT"
+	SecurityType_PRIVATEEXPORTFUNDING                     SecurityType = "PEF"
+	SecurityType_PFANDBRIEFE                              SecurityType = "PFAND"
+	SecurityType_PROMISSORYNOTE                           SecurityType = "PN"
+	SecurityType_PREFERREDSTOCK                           SecurityType = "PS"
+	SecurityType_PLAZOSFIJOS                              SecurityType = "PZFJ"
+	SecurityType_REVENUEANTICIPATIONNOTE                  SecurityType = "RAN"
+	SecurityType_REPLACED                                 SecurityType = "REPLACD"
+	SecurityType_REPURCHASE                               SecurityType = "REPO"
+	SecurityType_RETIRED                                  SecurityType = "RETIRED"
+	SecurityType_REVENUEBONDS                             SecurityType = "REV"
+	SecurityType_REVOLVERLOAN                             SecurityType = "RVLV"
+	SecurityType_REVOLVERTERMLOAN                         SecurityType = "RVLVTRM"
+	SecurityType_SECURITIESLOAN                           SecurityType = "SECLOAN"
+	SecurityType_SECURITIESPLEDGE                         SecurityType = "SECPLEDGE"
+	SecurityType_SPECIALASSESSMENT                        SecurityType = "SPCLA"
+	SecurityType_SPECIALOBLIGATION                        SecurityType = "SPCLO"
+	SecurityType_SPECIALTAX                               SecurityType = "SPCLT"
+	SecurityType_SHORTTERMLOANNOTE                        SecurityType = "STN"
+	SecurityType_STRUCTUREDNOTES                          SecurityType = "STRUCT"
+	SecurityType_USDSUPRANATIONALCOUPONS                  SecurityType = "SUPRA"
+	SecurityType_SWINGLINEFACILITY                        SecurityType = "SWING"
+	SecurityType_TAXANTICIPATIONNOTE                      SecurityType = "TAN"
+	SecurityType_TAXALLOCATION                            SecurityType = "TAXA"
+	SecurityType_TOBEANNOUNCED                            SecurityType = "TBA"
+	SecurityType_USTREASURYBILL                           SecurityType = "TBILL"
+	SecurityType_USTREASURYBOND                           SecurityType = "TBOND"
+	SecurityType_PRINCIPALSTRIPOFACALLABLEBONDORNOTE      SecurityType = "TCAL"
+	SecurityType_TIMEDEPOSIT                              SecurityType = "TD"
+	SecurityType_TAXEXEMPTCOMMERCIALPAPER                 SecurityType = "TECP"
+	SecurityType_TERMLOAN                                 SecurityType = "TERM"
+	SecurityType_INTERESTSTRIPFROMANYBONDORNOTE           SecurityType = "TINT"
+	SecurityType_TREASURYINFLATIONPROTECTEDSECURITIES     SecurityType = "TIPS"
+	SecurityType_USTREASURYNOTE                           SecurityType = "TNOTE"
+	SecurityType_PRINCIPALSTRIPFROMANONCALLABLEBONDORNOTE SecurityType = "TPRN"
+	SecurityType_TAXREVENUEANTICIPATIONNOTE               SecurityType = "TRAN"
+	SecurityType_USTREASURYNOTEDEPRECATEDVALUEUSETNOTE    SecurityType = "UST"
+	SecurityType_USTREASURYBILLDEPRECATEDVALUEUSETBILL    SecurityType = "USTB"
+	SecurityType_VARIABLERATEDEMANDNOTE                   SecurityType = "VRDN"
+	SecurityType_WARRANT                                  SecurityType = "WAR"
+	SecurityType_WITHDRAWN                                SecurityType = "WITHDRN"
+	SecurityType_WILDCARDENTRY                            SecurityType = "WLD"
+	SecurityType_EXTENDEDCOMMNOTE                         SecurityType = "XCN"
+	SecurityType_INDEXEDLINKED                            SecurityType = "XLINKD"
+	SecurityType_YANKEECORPORATEBOND                      SecurityType = "YANK"
+	SecurityType_YANKEECERTIFICATEOFDEPOSIT               SecurityType = "YCD"
+)
+
+// StipulationType field enumeration values.
+type StipulationType string
+
+const (
+	StipulationType_ABSOLUTEPREPAYMENTSPEED                                        StipulationType = "ABS"
+	StipulationType_AMT                                                            StipulationType = "AMT"
+	StipulationType_AUTOREINVESTMENTATRATEORBETTER                                 StipulationType = "AUTOREINV"
+	StipulationType_BANKQUALIFIED                                                  StipulationType = "BANKQUAL"
+	StipulationType_BARGAINCONDITIONS                                              StipulationType = "BGNCON"
+	StipulationType_COUPONRANGE                                                    StipulationType = "COUPON"
+	StipulationType_CONSTANTPREPAYMENTPENALTY                                      StipulationType = "CPP"
+	StipulationType_CONSTANTPREPAYMENTRATE                                         StipulationType = "CPR"
+	StipulationType_CONSTANTPREPAYMENTYIELD                                        StipulationType = "CPY"
+	StipulationType_ISOCURRENCYCODE                                                StipulationType = "CURRENCY"
+	StipulationType_CUSTOMSTARTENDDATE                                             StipulationType = "CUSTOMDATE"
+	StipulationType_GEOGRAPHICSANDRANGE                                            StipulationType = "GEOG"
+	StipulationType_VALUATIONDISCOUNT                                              StipulationType = "HAIRCUT"
+	StipulationType_FINALCPROFHOMEEQUITYPREPAYMENTCURVE                            StipulationType = "HEP"
+	StipulationType_INSURED                                                        StipulationType = "INSURED"
+	StipulationType_YEARORYEARMONTHOFISSUE                                         StipulationType = "ISSUE"
+	StipulationType_ISSUERSTICKER                                                  StipulationType = "ISSUER"
+	StipulationType_ISSUESIZERANGE                                                 StipulationType = "ISSUESIZE"
+	StipulationType_LOOKBACKDAYS                                                   StipulationType = "LOOKBACK"
+	StipulationType_EXPLICITLOTIDENTIFIER                                          StipulationType = "LOT"
+	StipulationType_LOTVARIANCEVALUEINPERCENTMAXIMUMOVERORUNDERALLOCATIONALLOWED   StipulationType = "LOTVAR"
+	StipulationType_MATURITYYEARANDMONTH                                           StipulationType = "MAT"
+	StipulationType_MATURITYRANGE                                                  StipulationType = "MATURITY"
+	StipulationType_MAXIMUMDENOMINATION                                            StipulationType = "MAXDNOM"
+	StipulationType_MAXIMUMSUBSTITUTIONSREPO                                       StipulationType = "MAXSUBS"
+	StipulationType_PERCENTOFMANUFACTUREDHOUSINGPREPAYMENTCURVE                    StipulationType = "MHP"
+	StipulationType_MINIMUMDENOMINATION                                            StipulationType = "MINDNOM"
+	StipulationType_MINIMUMINCREMENT                                               StipulationType = "MININCR"
+	StipulationType_MINIMUMQUANTITY                                                StipulationType = "MINQTY"
+	StipulationType_MONTHLYPREPAYMENTRATE                                          StipulationType = "MPR"
+	StipulationType_PAYMENTFREQUENCYCALENDAR                                       StipulationType = "PAYFREQ"
+	StipulationType_NUMBEROFPIECES                                                 StipulationType = "PIECES"
+	StipulationType_POOLSMAXIMUM                                                   StipulationType = "PMAX"
+	StipulationType_POOLSMINIMUM                                                   StipulationType = "PMIN"
+	StipulationType_PERCENTOFPROSPECTUSPREPAYMENTCURVE                             StipulationType = "PPC"
+	StipulationType_POOLSPERLOT                                                    StipulationType = "PPL"
+	StipulationType_POOLSPERMILLION                                                StipulationType = "PPM"
+	StipulationType_POOLSPERTRADE                                                  StipulationType = "PPT"
+	StipulationType_PRICERANGE                                                     StipulationType = "PRICE"
+	StipulationType_PRICINGFREQUENCY                                               StipulationType = "PRICEFREQ"
+	StipulationType_PRODUCTIONYEAR                                                 StipulationType = "PROD"
+	StipulationType_CALLPROTECTION                                                 StipulationType = "PROTECT"
+	StipulationType_PERCENTOFBMAPREPAYMENTCURVE                                    StipulationType = "PSA"
+	StipulationType_PURPOSE                                                        StipulationType = "PURPOSE"
+	StipulationType_BENCHMARKPRICESOURCE                                           StipulationType = "PXSOURCE"
+	StipulationType_RATINGSOURCEANDRANGE                                           StipulationType = "RATING"
+	StipulationType_TYPEOFREDEMPTIONVALUESARE                                      StipulationType = "REDEMPTION"
+	StipulationType_RESTRICTED                                                     StipulationType = "RESTRICTED"
+	StipulationType_MARKETSECTOR                                                   StipulationType = "SECTOR"
+	StipulationType_SECURITYTYPEINCLUDEDOREXCLUDED                                 StipulationType = "SECTYPE"
+	StipulationType_SINGLEMONTHLYMORTALITY                                         StipulationType = "SMM"
+	StipulationType_STRUCTURE                                                      StipulationType = "STRUCT"
+	StipulationType_SUBSTITUTIONSFREQUENCYREPO                                     StipulationType = "SUBSFREQ"
+	StipulationType_SUBSTITUTIONSLEFTREPO                                          StipulationType = "SUBSLEFT"
+	StipulationType_FREEFORMTEXT                                                   StipulationType = "TEXT"
+	StipulationType_TRADEVARIANCEVALUEINPERCENTMAXIMUMOVERORUNDERALLOCATIONALLOWED StipulationType = "TRDVAR"
+	StipulationType_WEIGHTEDAVERAGECOUPON                                          StipulationType = "WAC"
+	StipulationType_WEIGHTEDAVERAGELIFECOUPON                                      StipulationType = "WAL"
+	StipulationType_WEIGHTEDAVERAGELOANAGE                                         StipulationType = "WALA"
+	StipulationType_WEIGHTEDAVERAGEMATURITY                                        StipulationType = "WAM"
+	StipulationType_WHOLEPOOL                                                      StipulationType = "WHOLE"
+	StipulationType_YIELDRANGE                                                     StipulationType = "YIELD"
+)
+
+// SymbolSfx field enumeration values.
+type SymbolSfx string
+
+const (
+	SymbolSfx_EUCPLUMPSUMINTEREST SymbolSfx = "CD"
+	SymbolSfx_WHENISSUED          SymbolSfx = "WI"
+)
+
+// TerminationType field enumeration values.
+type TerminationType string
+
+const (
+	TerminationType_OVERNIGHT TerminationType = "1"
+	TerminationType_TERM      TerminationType = "2"
+	TerminationType_FLEXIBLE  TerminationType = "3"
+	TerminationType_OPEN      TerminationType = "4"
+)
+
+// TestMessageIndicator field enumeration values.
+type TestMessageIndicator string
+
+const (
+	TestMessageIndicator_NO  TestMessageIndicator = "N"
+	TestMessageIndicator_YES TestMessageIndicator = "Y"
+)
+
+// TickDirection field enumeration values.
+type TickDirection string
+
+const (
+	TickDirection_PLUS      TickDirection = "0"
+	TickDirection_ZEROPLUS  TickDirection = "1"
+	TickDirection_MINUS     TickDirection = "2"
+	TickDirection_ZEROMINUS TickDirection = "3"
+)
+
+// TimeInForce field enumeration values.
+type TimeInForce string
+
+const (
+	TimeInForce_DAY               TimeInForce = "0"
+	TimeInForce_GOODTILLCANCEL    TimeInForce = "1"
+	TimeInForce_ATTHEOPENING      TimeInForce = "2"
+	TimeInForce_IMMEDIATEORCANCEL TimeInForce = "3"
+	TimeInForce_FILLORKILL        TimeInForce = "4"
+	TimeInForce_GOODTILLCROSSING  TimeInForce = "5"
+	TimeInForce_GOODTILLDATE      TimeInForce = "6"
+	TimeInForce_ATTHECLOSE        TimeInForce = "7"
+)
+
+// TradeCondition field enumeration values.
+type TradeCondition string
+
+const (
+	TradeCondition_CASHMKT              TradeCondition = "A"
+	TradeCondition_AVGPX                TradeCondition = "B"
+	TradeCondition_CASHTRADE            TradeCondition = "C"
+	TradeCondition_NEXTDAY_D            TradeCondition = "D"
+	TradeCondition_OPENING              TradeCondition = "E"
+	TradeCondition_INTRADAY             TradeCondition = "F"
+	TradeCondition_RULE127              TradeCondition = "G"
+	TradeCondition_RULE155              TradeCondition = "H"
+	TradeCondition_SOLDLAST             TradeCondition = "I"
+	TradeCondition_NEXTDAY_J            TradeCondition = "J"
+	TradeCondition_OPENED               TradeCondition = "K"
+	TradeCondition_SELLER               TradeCondition = "L"
+	TradeCondition_SOLD                 TradeCondition = "M"
+	TradeCondition_STOPPED              TradeCondition = "N"
+	TradeCondition_IMBALANCEMOREBUYERS  TradeCondition = "P"
+	TradeCondition_IMBALANCEMORESELLERS TradeCondition = "Q"
+	TradeCondition_OPENINGPRICE         TradeCondition = "R"
+)
+
+// WaanxInstrumentType field enumeration values.
+type WaanxInstrumentType string
+
+const (
+	WaanxInstrumentType_FUTURE    WaanxInstrumentType = "F"
+	WaanxInstrumentType_PERPETUAL WaanxInstrumentType = "P"
+	WaanxInstrumentType_SPOT      WaanxInstrumentType = "S"
+)
+
+// YieldType field enumeration values.
+type YieldType string
+
+const (
+	YieldType_AFTERTAXYIELD                 YieldType = "AFTERTAX"
+	YieldType_ANNUALYIELD                   YieldType = "ANNUAL"
+	YieldType_YIELDATISSUE                  YieldType = "ATISSUE"
+	YieldType_YIELDTOAVGMATURITY            YieldType = "AVGMATURITY"
+	YieldType_BOOKYIELD                     YieldType = "BOOK"
+	YieldType_YIELDTONEXTCALL               YieldType = "CALL"
+	YieldType_YIELDCHANGESINCECLOSE         YieldType = "CHANGE"
+	YieldType_CLOSINGYIELD                  YieldType = "CLOSE"
+	YieldType_COMPOUNDYIELD                 YieldType = "COMPOUND"
+	YieldType_CURRENTYIELD                  YieldType = "CURRENT"
+	YieldType_GVNTEQUIVALENTYIELD           YieldType = "GOVTEQUIV"
+	YieldType_TRUEGROSSYIELD                YieldType = "GROSS"
+	YieldType_YIELDINFLATIONASSUMPTION      YieldType = "INFLATION"
+	YieldType_INVFLOATERBONDYIELD           YieldType = "INVERSEFLOATER"
+	YieldType_MOSTRECENTCLOSINGYIELD        YieldType = "LASTCLOSE"
+	YieldType_CLOSINGYIELDMOSTRECENTMONTH   YieldType = "LASTMONTH"
+	YieldType_CLOSINGYIELDMOSTRECENTQUARTER YieldType = "LASTQUARTER"
+	YieldType_CLOSINGYIELDMOSTRECENTYEAR    YieldType = "LASTYEAR"
+	YieldType_YIELDTOLONGESTAVERAGELIFE     YieldType = "LONGAVGLIFE"
+	YieldType_MARKTOMARKETYIELD             YieldType = "MARK"
+	YieldType_YIELDTOMATURITY               YieldType = "MATURITY"
+	YieldType_YIELDTONEXTREFUNDSINKING      YieldType = "NEXTREFUND"
+	YieldType_OPENAVERAGEYIELD              YieldType = "OPENAVG"
+	YieldType_PREVIOUSCLOSEYIELD            YieldType = "PREVCLOSE"
+	YieldType_PROCEEDSYIELD                 YieldType = "PROCEEDS"
+	YieldType_YIELDTONEXTPUT                YieldType = "PUT"
+	YieldType_SEMI                          YieldType = "SEMIANNUAL"
+	YieldType_YIELDTOSHORTESTAVERAGELIFE    YieldType = "SHORTAVGLIFE"
+	YieldType_SIMPLEYIELD                   YieldType = "SIMPLE"
+	YieldType_TAXEQUIVALENTYIELD            YieldType = "TAXEQUIV"
+	YieldType_YIELDTOTENDERDATE             YieldType = "TENDER"
+	YieldType_TRUEYIELD                     YieldType = "TRUE"
+	YieldType_YIELDVALUEOF132               YieldType = "VALUE1_32"
+	YieldType_YIELDTOWORSTCONVENTION        YieldType = "WORST"
+)
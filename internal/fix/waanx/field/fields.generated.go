@@ -0,0 +1,3852 @@
+package field
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/fix/waanx/enum"
+	"github.com/phimaker/waanx-fix-simpler/internal/fix/waanx/tag"
+	"github.com/quickfixgo/quickfix"
+)
+
+// AgreementCurrencyField is a CURRENCY field.
+type AgreementCurrencyField struct{ quickfix.FIXString }
+
+// Tag returns tag.AgreementCurrency (918).
+func (f AgreementCurrencyField) Tag() quickfix.Tag { return tag.AgreementCurrency }
+
+// NewAgreementCurrency returns a new AgreementCurrencyField initialized with val.
+func NewAgreementCurrency(val string) AgreementCurrencyField {
+	return AgreementCurrencyField{quickfix.FIXString(val)}
+}
+
+func (f AgreementCurrencyField) Value() string { return f.String() }
+
+// AgreementDateField is a LOCALMKTDATE field.
+type AgreementDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.AgreementDate (915).
+func (f AgreementDateField) Tag() quickfix.Tag { return tag.AgreementDate }
+
+// NewAgreementDate returns a new AgreementDateField initialized with val.
+func NewAgreementDate(val string) AgreementDateField {
+	return AgreementDateField{quickfix.FIXString(val)}
+}
+
+func (f AgreementDateField) Value() string { return f.String() }
+
+// AgreementDescField is a STRING field.
+type AgreementDescField struct{ quickfix.FIXString }
+
+// Tag returns tag.AgreementDesc (913).
+func (f AgreementDescField) Tag() quickfix.Tag { return tag.AgreementDesc }
+
+// NewAgreementDesc returns a new AgreementDescField initialized with val.
+func NewAgreementDesc(val string) AgreementDescField {
+	return AgreementDescField{quickfix.FIXString(val)}
+}
+
+func (f AgreementDescField) Value() string { return f.String() }
+
+// AgreementIDField is a STRING field.
+type AgreementIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.AgreementID (914).
+func (f AgreementIDField) Tag() quickfix.Tag { return tag.AgreementID }
+
+// NewAgreementID returns a new AgreementIDField initialized with val.
+func NewAgreementID(val string) AgreementIDField {
+	return AgreementIDField{quickfix.FIXString(val)}
+}
+
+func (f AgreementIDField) Value() string { return f.String() }
+
+// ApplQueueDepthField is a INT field.
+type ApplQueueDepthField struct{ quickfix.FIXInt }
+
+// Tag returns tag.ApplQueueDepth (813).
+func (f ApplQueueDepthField) Tag() quickfix.Tag { return tag.ApplQueueDepth }
+
+// NewApplQueueDepth returns a new ApplQueueDepthField initialized with val.
+func NewApplQueueDepth(val int) ApplQueueDepthField {
+	return ApplQueueDepthField{quickfix.FIXInt(val)}
+}
+
+func (f ApplQueueDepthField) Value() int { return f.Int() }
+
+// ApplQueueResolutionField is a enum.ApplQueueResolution field.
+type ApplQueueResolutionField struct{ quickfix.FIXString }
+
+// Tag returns tag.ApplQueueResolution (814).
+func (f ApplQueueResolutionField) Tag() quickfix.Tag { return tag.ApplQueueResolution }
+
+func NewApplQueueResolution(val enum.ApplQueueResolution) ApplQueueResolutionField {
+	return ApplQueueResolutionField{quickfix.FIXString(val)}
+}
+
+func (f ApplQueueResolutionField) Value() enum.ApplQueueResolution {
+	return enum.ApplQueueResolution(f.String())
+}
+
+// BeginStringField is a STRING field.
+type BeginStringField struct{ quickfix.FIXString }
+
+// Tag returns tag.BeginString (8).
+func (f BeginStringField) Tag() quickfix.Tag { return tag.BeginString }
+
+// NewBeginString returns a new BeginStringField initialized with val.
+func NewBeginString(val string) BeginStringField {
+	return BeginStringField{quickfix.FIXString(val)}
+}
+
+func (f BeginStringField) Value() string { return f.String() }
+
+// BenchmarkCurveCurrencyField is a CURRENCY field.
+type BenchmarkCurveCurrencyField struct{ quickfix.FIXString }
+
+// Tag returns tag.BenchmarkCurveCurrency (220).
+func (f BenchmarkCurveCurrencyField) Tag() quickfix.Tag { return tag.BenchmarkCurveCurrency }
+
+// NewBenchmarkCurveCurrency returns a new BenchmarkCurveCurrencyField initialized with val.
+func NewBenchmarkCurveCurrency(val string) BenchmarkCurveCurrencyField {
+	return BenchmarkCurveCurrencyField{quickfix.FIXString(val)}
+}
+
+func (f BenchmarkCurveCurrencyField) Value() string { return f.String() }
+
+// BenchmarkCurveNameField is a enum.BenchmarkCurveName field.
+type BenchmarkCurveNameField struct{ quickfix.FIXString }
+
+// Tag returns tag.BenchmarkCurveName (221).
+func (f BenchmarkCurveNameField) Tag() quickfix.Tag { return tag.BenchmarkCurveName }
+
+func NewBenchmarkCurveName(val enum.BenchmarkCurveName) BenchmarkCurveNameField {
+	return BenchmarkCurveNameField{quickfix.FIXString(val)}
+}
+
+func (f BenchmarkCurveNameField) Value() enum.BenchmarkCurveName {
+	return enum.BenchmarkCurveName(f.String())
+}
+
+// BenchmarkCurvePointField is a STRING field.
+type BenchmarkCurvePointField struct{ quickfix.FIXString }
+
+// Tag returns tag.BenchmarkCurvePoint (222).
+func (f BenchmarkCurvePointField) Tag() quickfix.Tag { return tag.BenchmarkCurvePoint }
+
+// NewBenchmarkCurvePoint returns a new BenchmarkCurvePointField initialized with val.
+func NewBenchmarkCurvePoint(val string) BenchmarkCurvePointField {
+	return BenchmarkCurvePointField{quickfix.FIXString(val)}
+}
+
+func (f BenchmarkCurvePointField) Value() string { return f.String() }
+
+// BenchmarkPriceField is a PRICE field.
+type BenchmarkPriceField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.BenchmarkPrice (662).
+func (f BenchmarkPriceField) Tag() quickfix.Tag { return tag.BenchmarkPrice }
+
+// NewBenchmarkPrice returns a new BenchmarkPriceField initialized with val and scale.
+func NewBenchmarkPrice(val decimal.Decimal, scale int32) BenchmarkPriceField {
+	return BenchmarkPriceField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f BenchmarkPriceField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// BenchmarkPriceTypeField is a INT field.
+type BenchmarkPriceTypeField struct{ quickfix.FIXInt }
+
+// Tag returns tag.BenchmarkPriceType (663).
+func (f BenchmarkPriceTypeField) Tag() quickfix.Tag { return tag.BenchmarkPriceType }
+
+// NewBenchmarkPriceType returns a new BenchmarkPriceTypeField initialized with val.
+func NewBenchmarkPriceType(val int) BenchmarkPriceTypeField {
+	return BenchmarkPriceTypeField{quickfix.FIXInt(val)}
+}
+
+func (f BenchmarkPriceTypeField) Value() int { return f.Int() }
+
+// BenchmarkSecurityIDField is a STRING field.
+type BenchmarkSecurityIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.BenchmarkSecurityID (699).
+func (f BenchmarkSecurityIDField) Tag() quickfix.Tag { return tag.BenchmarkSecurityID }
+
+// NewBenchmarkSecurityID returns a new BenchmarkSecurityIDField initialized with val.
+func NewBenchmarkSecurityID(val string) BenchmarkSecurityIDField {
+	return BenchmarkSecurityIDField{quickfix.FIXString(val)}
+}
+
+func (f BenchmarkSecurityIDField) Value() string { return f.String() }
+
+// BenchmarkSecurityIDSourceField is a STRING field.
+type BenchmarkSecurityIDSourceField struct{ quickfix.FIXString }
+
+// Tag returns tag.BenchmarkSecurityIDSource (761).
+func (f BenchmarkSecurityIDSourceField) Tag() quickfix.Tag { return tag.BenchmarkSecurityIDSource }
+
+// NewBenchmarkSecurityIDSource returns a new BenchmarkSecurityIDSourceField initialized with val.
+func NewBenchmarkSecurityIDSource(val string) BenchmarkSecurityIDSourceField {
+	return BenchmarkSecurityIDSourceField{quickfix.FIXString(val)}
+}
+
+func (f BenchmarkSecurityIDSourceField) Value() string { return f.String() }
+
+// BodyLengthField is a LENGTH field.
+type BodyLengthField struct{ quickfix.FIXInt }
+
+// Tag returns tag.BodyLength (9).
+func (f BodyLengthField) Tag() quickfix.Tag { return tag.BodyLength }
+
+// NewBodyLength returns a new BodyLengthField initialized with val.
+func NewBodyLength(val int) BodyLengthField {
+	return BodyLengthField{quickfix.FIXInt(val)}
+}
+
+func (f BodyLengthField) Value() int { return f.Int() }
+
+// CFICodeField is a STRING field.
+type CFICodeField struct{ quickfix.FIXString }
+
+// Tag returns tag.CFICode (461).
+func (f CFICodeField) Tag() quickfix.Tag { return tag.CFICode }
+
+// NewCFICode returns a new CFICodeField initialized with val.
+func NewCFICode(val string) CFICodeField {
+	return CFICodeField{quickfix.FIXString(val)}
+}
+
+func (f CFICodeField) Value() string { return f.String() }
+
+// CPProgramField is a enum.CPProgram field.
+type CPProgramField struct{ quickfix.FIXString }
+
+// Tag returns tag.CPProgram (875).
+func (f CPProgramField) Tag() quickfix.Tag { return tag.CPProgram }
+
+func NewCPProgram(val enum.CPProgram) CPProgramField {
+	return CPProgramField{quickfix.FIXString(val)}
+}
+
+func (f CPProgramField) Value() enum.CPProgram { return enum.CPProgram(f.String()) }
+
+// CPRegTypeField is a STRING field.
+type CPRegTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.CPRegType (876).
+func (f CPRegTypeField) Tag() quickfix.Tag { return tag.CPRegType }
+
+// NewCPRegType returns a new CPRegTypeField initialized with val.
+func NewCPRegType(val string) CPRegTypeField {
+	return CPRegTypeField{quickfix.FIXString(val)}
+}
+
+func (f CPRegTypeField) Value() string { return f.String() }
+
+// CheckSumField is a STRING field.
+type CheckSumField struct{ quickfix.FIXString }
+
+// Tag returns tag.CheckSum (10).
+func (f CheckSumField) Tag() quickfix.Tag { return tag.CheckSum }
+
+// NewCheckSum returns a new CheckSumField initialized with val.
+func NewCheckSum(val string) CheckSumField {
+	return CheckSumField{quickfix.FIXString(val)}
+}
+
+func (f CheckSumField) Value() string { return f.String() }
+
+// ContractMultiplierField is a FLOAT field.
+type ContractMultiplierField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.ContractMultiplier (231).
+func (f ContractMultiplierField) Tag() quickfix.Tag { return tag.ContractMultiplier }
+
+// NewContractMultiplier returns a new ContractMultiplierField initialized with val and scale.
+func NewContractMultiplier(val decimal.Decimal, scale int32) ContractMultiplierField {
+	return ContractMultiplierField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f ContractMultiplierField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// ContractSettlMonthField is a MONTHYEAR field.
+type ContractSettlMonthField struct{ quickfix.FIXString }
+
+// Tag returns tag.ContractSettlMonth (667).
+func (f ContractSettlMonthField) Tag() quickfix.Tag { return tag.ContractSettlMonth }
+
+// NewContractSettlMonth returns a new ContractSettlMonthField initialized with val.
+func NewContractSettlMonth(val string) ContractSettlMonthField {
+	return ContractSettlMonthField{quickfix.FIXString(val)}
+}
+
+func (f ContractSettlMonthField) Value() string { return f.String() }
+
+// CorporateActionField is a enum.CorporateAction field.
+type CorporateActionField struct{ quickfix.FIXString }
+
+// Tag returns tag.CorporateAction (292).
+func (f CorporateActionField) Tag() quickfix.Tag { return tag.CorporateAction }
+
+func NewCorporateAction(val enum.CorporateAction) CorporateActionField {
+	return CorporateActionField{quickfix.FIXString(val)}
+}
+
+func (f CorporateActionField) Value() enum.CorporateAction { return enum.CorporateAction(f.String()) }
+
+// CountryOfIssueField is a COUNTRY field.
+type CountryOfIssueField struct{ quickfix.FIXString }
+
+// Tag returns tag.CountryOfIssue (470).
+func (f CountryOfIssueField) Tag() quickfix.Tag { return tag.CountryOfIssue }
+
+// NewCountryOfIssue returns a new CountryOfIssueField initialized with val.
+func NewCountryOfIssue(val string) CountryOfIssueField {
+	return CountryOfIssueField{quickfix.FIXString(val)}
+}
+
+func (f CountryOfIssueField) Value() string { return f.String() }
+
+// CouponPaymentDateField is a LOCALMKTDATE field.
+type CouponPaymentDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.CouponPaymentDate (224).
+func (f CouponPaymentDateField) Tag() quickfix.Tag { return tag.CouponPaymentDate }
+
+// NewCouponPaymentDate returns a new CouponPaymentDateField initialized with val.
+func NewCouponPaymentDate(val string) CouponPaymentDateField {
+	return CouponPaymentDateField{quickfix.FIXString(val)}
+}
+
+func (f CouponPaymentDateField) Value() string { return f.String() }
+
+// CouponRateField is a PERCENTAGE field.
+type CouponRateField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.CouponRate (223).
+func (f CouponRateField) Tag() quickfix.Tag { return tag.CouponRate }
+
+// NewCouponRate returns a new CouponRateField initialized with val and scale.
+func NewCouponRate(val decimal.Decimal, scale int32) CouponRateField {
+	return CouponRateField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f CouponRateField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// CreditRatingField is a STRING field.
+type CreditRatingField struct{ quickfix.FIXString }
+
+// Tag returns tag.CreditRating (255).
+func (f CreditRatingField) Tag() quickfix.Tag { return tag.CreditRating }
+
+// NewCreditRating returns a new CreditRatingField initialized with val.
+func NewCreditRating(val string) CreditRatingField {
+	return CreditRatingField{quickfix.FIXString(val)}
+}
+
+func (f CreditRatingField) Value() string { return f.String() }
+
+// CurrencyField is a CURRENCY field.
+type CurrencyField struct{ quickfix.FIXString }
+
+// Tag returns tag.Currency (15).
+func (f CurrencyField) Tag() quickfix.Tag { return tag.Currency }
+
+// NewCurrency returns a new CurrencyField initialized with val.
+func NewCurrency(val string) CurrencyField {
+	return CurrencyField{quickfix.FIXString(val)}
+}
+
+func (f CurrencyField) Value() string { return f.String() }
+
+// DatedDateField is a LOCALMKTDATE field.
+type DatedDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.DatedDate (873).
+func (f DatedDateField) Tag() quickfix.Tag { return tag.DatedDate }
+
+// NewDatedDate returns a new DatedDateField initialized with val.
+func NewDatedDate(val string) DatedDateField {
+	return DatedDateField{quickfix.FIXString(val)}
+}
+
+func (f DatedDateField) Value() string { return f.String() }
+
+// DeleteReasonField is a enum.DeleteReason field.
+type DeleteReasonField struct{ quickfix.FIXString }
+
+// Tag returns tag.DeleteReason (285).
+func (f DeleteReasonField) Tag() quickfix.Tag { return tag.DeleteReason }
+
+func NewDeleteReason(val enum.DeleteReason) DeleteReasonField {
+	return DeleteReasonField{quickfix.FIXString(val)}
+}
+
+func (f DeleteReasonField) Value() enum.DeleteReason { return enum.DeleteReason(f.String()) }
+
+// DeliverToCompIDField is a STRING field.
+type DeliverToCompIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.DeliverToCompID (128).
+func (f DeliverToCompIDField) Tag() quickfix.Tag { return tag.DeliverToCompID }
+
+// NewDeliverToCompID returns a new DeliverToCompIDField initialized with val.
+func NewDeliverToCompID(val string) DeliverToCompIDField {
+	return DeliverToCompIDField{quickfix.FIXString(val)}
+}
+
+func (f DeliverToCompIDField) Value() string { return f.String() }
+
+// DeliverToLocationIDField is a STRING field.
+type DeliverToLocationIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.DeliverToLocationID (145).
+func (f DeliverToLocationIDField) Tag() quickfix.Tag { return tag.DeliverToLocationID }
+
+// NewDeliverToLocationID returns a new DeliverToLocationIDField initialized with val.
+func NewDeliverToLocationID(val string) DeliverToLocationIDField {
+	return DeliverToLocationIDField{quickfix.FIXString(val)}
+}
+
+func (f DeliverToLocationIDField) Value() string { return f.String() }
+
+// DeliverToSubIDField is a STRING field.
+type DeliverToSubIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.DeliverToSubID (129).
+func (f DeliverToSubIDField) Tag() quickfix.Tag { return tag.DeliverToSubID }
+
+// NewDeliverToSubID returns a new DeliverToSubIDField initialized with val.
+func NewDeliverToSubID(val string) DeliverToSubIDField {
+	return DeliverToSubIDField{quickfix.FIXString(val)}
+}
+
+func (f DeliverToSubIDField) Value() string { return f.String() }
+
+// DeliveryFormField is a enum.DeliveryForm field.
+type DeliveryFormField struct{ quickfix.FIXString }
+
+// Tag returns tag.DeliveryForm (668).
+func (f DeliveryFormField) Tag() quickfix.Tag { return tag.DeliveryForm }
+
+func NewDeliveryForm(val enum.DeliveryForm) DeliveryFormField {
+	return DeliveryFormField{quickfix.FIXString(val)}
+}
+
+func (f DeliveryFormField) Value() enum.DeliveryForm { return enum.DeliveryForm(f.String()) }
+
+// DeliveryTypeField is a enum.DeliveryType field.
+type DeliveryTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.DeliveryType (919).
+func (f DeliveryTypeField) Tag() quickfix.Tag { return tag.DeliveryType }
+
+func NewDeliveryType(val enum.DeliveryType) DeliveryTypeField {
+	return DeliveryTypeField{quickfix.FIXString(val)}
+}
+
+func (f DeliveryTypeField) Value() enum.DeliveryType { return enum.DeliveryType(f.String()) }
+
+// DeskIDField is a STRING field.
+type DeskIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.DeskID (284).
+func (f DeskIDField) Tag() quickfix.Tag { return tag.DeskID }
+
+// NewDeskID returns a new DeskIDField initialized with val.
+func NewDeskID(val string) DeskIDField {
+	return DeskIDField{quickfix.FIXString(val)}
+}
+
+func (f DeskIDField) Value() string { return f.String() }
+
+// EncodedIssuerField is a DATA field.
+type EncodedIssuerField struct{ quickfix.FIXString }
+
+// Tag returns tag.EncodedIssuer (349).
+func (f EncodedIssuerField) Tag() quickfix.Tag { return tag.EncodedIssuer }
+
+// NewEncodedIssuer returns a new EncodedIssuerField initialized with val.
+func NewEncodedIssuer(val string) EncodedIssuerField {
+	return EncodedIssuerField{quickfix.FIXString(val)}
+}
+
+func (f EncodedIssuerField) Value() string { return f.String() }
+
+// EncodedIssuerLenField is a LENGTH field.
+type EncodedIssuerLenField struct{ quickfix.FIXInt }
+
+// Tag returns tag.EncodedIssuerLen (348).
+func (f EncodedIssuerLenField) Tag() quickfix.Tag { return tag.EncodedIssuerLen }
+
+// NewEncodedIssuerLen returns a new EncodedIssuerLenField initialized with val.
+func NewEncodedIssuerLen(val int) EncodedIssuerLenField {
+	return EncodedIssuerLenField{quickfix.FIXInt(val)}
+}
+
+func (f EncodedIssuerLenField) Value() int { return f.Int() }
+
+// EncodedLegIssuerField is a DATA field.
+type EncodedLegIssuerField struct{ quickfix.FIXString }
+
+// Tag returns tag.EncodedLegIssuer (619).
+func (f EncodedLegIssuerField) Tag() quickfix.Tag { return tag.EncodedLegIssuer }
+
+// NewEncodedLegIssuer returns a new EncodedLegIssuerField initialized with val.
+func NewEncodedLegIssuer(val string) EncodedLegIssuerField {
+	return EncodedLegIssuerField{quickfix.FIXString(val)}
+}
+
+func (f EncodedLegIssuerField) Value() string { return f.String() }
+
+// EncodedLegIssuerLenField is a LENGTH field.
+type EncodedLegIssuerLenField struct{ quickfix.FIXInt }
+
+// Tag returns tag.EncodedLegIssuerLen (618).
+func (f EncodedLegIssuerLenField) Tag() quickfix.Tag { return tag.EncodedLegIssuerLen }
+
+// NewEncodedLegIssuerLen returns a new EncodedLegIssuerLenField initialized with val.
+func NewEncodedLegIssuerLen(val int) EncodedLegIssuerLenField {
+	return EncodedLegIssuerLenField{quickfix.FIXInt(val)}
+}
+
+func (f EncodedLegIssuerLenField) Value() int { return f.Int() }
+
+// EncodedLegSecurityDescField is a DATA field.
+type EncodedLegSecurityDescField struct{ quickfix.FIXString }
+
+// Tag returns tag.EncodedLegSecurityDesc (622).
+func (f EncodedLegSecurityDescField) Tag() quickfix.Tag { return tag.EncodedLegSecurityDesc }
+
+// NewEncodedLegSecurityDesc returns a new EncodedLegSecurityDescField initialized with val.
+func NewEncodedLegSecurityDesc(val string) EncodedLegSecurityDescField {
+	return EncodedLegSecurityDescField{quickfix.FIXString(val)}
+}
+
+func (f EncodedLegSecurityDescField) Value() string { return f.String() }
+
+// EncodedLegSecurityDescLenField is a LENGTH field.
+type EncodedLegSecurityDescLenField struct{ quickfix.FIXInt }
+
+// Tag returns tag.EncodedLegSecurityDescLen (621).
+func (f EncodedLegSecurityDescLenField) Tag() quickfix.Tag { return tag.EncodedLegSecurityDescLen }
+
+// NewEncodedLegSecurityDescLen returns a new EncodedLegSecurityDescLenField initialized with val.
+func NewEncodedLegSecurityDescLen(val int) EncodedLegSecurityDescLenField {
+	return EncodedLegSecurityDescLenField{quickfix.FIXInt(val)}
+}
+
+func (f EncodedLegSecurityDescLenField) Value() int { return f.Int() }
+
+// EncodedSecurityDescField is a DATA field.
+type EncodedSecurityDescField struct{ quickfix.FIXString }
+
+// Tag returns tag.EncodedSecurityDesc (351).
+func (f EncodedSecurityDescField) Tag() quickfix.Tag { return tag.EncodedSecurityDesc }
+
+// NewEncodedSecurityDesc returns a new EncodedSecurityDescField initialized with val.
+func NewEncodedSecurityDesc(val string) EncodedSecurityDescField {
+	return EncodedSecurityDescField{quickfix.FIXString(val)}
+}
+
+func (f EncodedSecurityDescField) Value() string { return f.String() }
+
+// EncodedSecurityDescLenField is a LENGTH field.
+type EncodedSecurityDescLenField struct{ quickfix.FIXInt }
+
+// Tag returns tag.EncodedSecurityDescLen (350).
+func (f EncodedSecurityDescLenField) Tag() quickfix.Tag { return tag.EncodedSecurityDescLen }
+
+// NewEncodedSecurityDescLen returns a new EncodedSecurityDescLenField initialized with val.
+func NewEncodedSecurityDescLen(val int) EncodedSecurityDescLenField {
+	return EncodedSecurityDescLenField{quickfix.FIXInt(val)}
+}
+
+func (f EncodedSecurityDescLenField) Value() int { return f.Int() }
+
+// EncodedTextField is a DATA field.
+type EncodedTextField struct{ quickfix.FIXString }
+
+// Tag returns tag.EncodedText (355).
+func (f EncodedTextField) Tag() quickfix.Tag { return tag.EncodedText }
+
+// NewEncodedText returns a new EncodedTextField initialized with val.
+func NewEncodedText(val string) EncodedTextField {
+	return EncodedTextField{quickfix.FIXString(val)}
+}
+
+func (f EncodedTextField) Value() string { return f.String() }
+
+// EncodedTextLenField is a LENGTH field.
+type EncodedTextLenField struct{ quickfix.FIXInt }
+
+// Tag returns tag.EncodedTextLen (354).
+func (f EncodedTextLenField) Tag() quickfix.Tag { return tag.EncodedTextLen }
+
+// NewEncodedTextLen returns a new EncodedTextLenField initialized with val.
+func NewEncodedTextLen(val int) EncodedTextLenField {
+	return EncodedTextLenField{quickfix.FIXInt(val)}
+}
+
+func (f EncodedTextLenField) Value() int { return f.Int() }
+
+// EncodedUnderlyingIssuerField is a DATA field.
+type EncodedUnderlyingIssuerField struct{ quickfix.FIXString }
+
+// Tag returns tag.EncodedUnderlyingIssuer (363).
+func (f EncodedUnderlyingIssuerField) Tag() quickfix.Tag { return tag.EncodedUnderlyingIssuer }
+
+// NewEncodedUnderlyingIssuer returns a new EncodedUnderlyingIssuerField initialized with val.
+func NewEncodedUnderlyingIssuer(val string) EncodedUnderlyingIssuerField {
+	return EncodedUnderlyingIssuerField{quickfix.FIXString(val)}
+}
+
+func (f EncodedUnderlyingIssuerField) Value() string { return f.String() }
+
+// EncodedUnderlyingIssuerLenField is a LENGTH field.
+type EncodedUnderlyingIssuerLenField struct{ quickfix.FIXInt }
+
+// Tag returns tag.EncodedUnderlyingIssuerLen (362).
+func (f EncodedUnderlyingIssuerLenField) Tag() quickfix.Tag { return tag.EncodedUnderlyingIssuerLen }
+
+// NewEncodedUnderlyingIssuerLen returns a new EncodedUnderlyingIssuerLenField initialized with val.
+func NewEncodedUnderlyingIssuerLen(val int) EncodedUnderlyingIssuerLenField {
+	return EncodedUnderlyingIssuerLenField{quickfix.FIXInt(val)}
+}
+
+func (f EncodedUnderlyingIssuerLenField) Value() int { return f.Int() }
+
+// EncodedUnderlyingSecurityDescField is a DATA field.
+type EncodedUnderlyingSecurityDescField struct{ quickfix.FIXString }
+
+// Tag returns tag.EncodedUnderlyingSecurityDesc (365).
+func (f EncodedUnderlyingSecurityDescField) Tag() quickfix.Tag {
+	return tag.EncodedUnderlyingSecurityDesc
+}
+
+// NewEncodedUnderlyingSecurityDesc returns a new EncodedUnderlyingSecurityDescField initialized with val.
+func NewEncodedUnderlyingSecurityDesc(val string) EncodedUnderlyingSecurityDescField {
+	return EncodedUnderlyingSecurityDescField{quickfix.FIXString(val)}
+}
+
+func (f EncodedUnderlyingSecurityDescField) Value() string { return f.String() }
+
+// EncodedUnderlyingSecurityDescLenField is a LENGTH field.
+type EncodedUnderlyingSecurityDescLenField struct{ quickfix.FIXInt }
+
+// Tag returns tag.EncodedUnderlyingSecurityDescLen (364).
+func (f EncodedUnderlyingSecurityDescLenField) Tag() quickfix.Tag {
+	return tag.EncodedUnderlyingSecurityDescLen
+}
+
+// NewEncodedUnderlyingSecurityDescLen returns a new EncodedUnderlyingSecurityDescLenField initialized with val.
+func NewEncodedUnderlyingSecurityDescLen(val int) EncodedUnderlyingSecurityDescLenField {
+	return EncodedUnderlyingSecurityDescLenField{quickfix.FIXInt(val)}
+}
+
+func (f EncodedUnderlyingSecurityDescLenField) Value() int { return f.Int() }
+
+// EncryptMethodField is a enum.EncryptMethod field.
+type EncryptMethodField struct{ quickfix.FIXString }
+
+// Tag returns tag.EncryptMethod (98).
+func (f EncryptMethodField) Tag() quickfix.Tag { return tag.EncryptMethod }
+
+func NewEncryptMethod(val enum.EncryptMethod) EncryptMethodField {
+	return EncryptMethodField{quickfix.FIXString(val)}
+}
+
+func (f EncryptMethodField) Value() enum.EncryptMethod { return enum.EncryptMethod(f.String()) }
+
+// EndDateField is a LOCALMKTDATE field.
+type EndDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.EndDate (917).
+func (f EndDateField) Tag() quickfix.Tag { return tag.EndDate }
+
+// NewEndDate returns a new EndDateField initialized with val.
+func NewEndDate(val string) EndDateField {
+	return EndDateField{quickfix.FIXString(val)}
+}
+
+func (f EndDateField) Value() string { return f.String() }
+
+// EventDateField is a LOCALMKTDATE field.
+type EventDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.EventDate (866).
+func (f EventDateField) Tag() quickfix.Tag { return tag.EventDate }
+
+// NewEventDate returns a new EventDateField initialized with val.
+func NewEventDate(val string) EventDateField {
+	return EventDateField{quickfix.FIXString(val)}
+}
+
+func (f EventDateField) Value() string { return f.String() }
+
+// EventPxField is a PRICE field.
+type EventPxField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.EventPx (867).
+func (f EventPxField) Tag() quickfix.Tag { return tag.EventPx }
+
+// NewEventPx returns a new EventPxField initialized with val and scale.
+func NewEventPx(val decimal.Decimal, scale int32) EventPxField {
+	return EventPxField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f EventPxField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// EventTextField is a STRING field.
+type EventTextField struct{ quickfix.FIXString }
+
+// Tag returns tag.EventText (868).
+func (f EventTextField) Tag() quickfix.Tag { return tag.EventText }
+
+// NewEventText returns a new EventTextField initialized with val.
+func NewEventText(val string) EventTextField {
+	return EventTextField{quickfix.FIXString(val)}
+}
+
+func (f EventTextField) Value() string { return f.String() }
+
+// EventTypeField is a enum.EventType field.
+type EventTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.EventType (865).
+func (f EventTypeField) Tag() quickfix.Tag { return tag.EventType }
+
+func NewEventType(val enum.EventType) EventTypeField {
+	return EventTypeField{quickfix.FIXString(val)}
+}
+
+func (f EventTypeField) Value() enum.EventType { return enum.EventType(f.String()) }
+
+// ExecInstField is a enum.ExecInst field.
+type ExecInstField struct{ quickfix.FIXString }
+
+// Tag returns tag.ExecInst (18).
+func (f ExecInstField) Tag() quickfix.Tag { return tag.ExecInst }
+
+func NewExecInst(val enum.ExecInst) ExecInstField {
+	return ExecInstField{quickfix.FIXString(val)}
+}
+
+func (f ExecInstField) Value() enum.ExecInst { return enum.ExecInst(f.String()) }
+
+// ExpirationCycleField is a enum.ExpirationCycle field.
+type ExpirationCycleField struct{ quickfix.FIXString }
+
+// Tag returns tag.ExpirationCycle (827).
+func (f ExpirationCycleField) Tag() quickfix.Tag { return tag.ExpirationCycle }
+
+func NewExpirationCycle(val enum.ExpirationCycle) ExpirationCycleField {
+	return ExpirationCycleField{quickfix.FIXString(val)}
+}
+
+func (f ExpirationCycleField) Value() enum.ExpirationCycle { return enum.ExpirationCycle(f.String()) }
+
+// ExpireDateField is a LOCALMKTDATE field.
+type ExpireDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.ExpireDate (432).
+func (f ExpireDateField) Tag() quickfix.Tag { return tag.ExpireDate }
+
+// NewExpireDate returns a new ExpireDateField initialized with val.
+func NewExpireDate(val string) ExpireDateField {
+	return ExpireDateField{quickfix.FIXString(val)}
+}
+
+func (f ExpireDateField) Value() string { return f.String() }
+
+// ExpireTimeField is a UTCTIMESTAMP field.
+type ExpireTimeField struct{ quickfix.FIXUTCTimestamp }
+
+// Tag returns tag.ExpireTime (126).
+func (f ExpireTimeField) Tag() quickfix.Tag { return tag.ExpireTime }
+
+// NewExpireTime returns a new ExpireTimeField initialized with val.
+func NewExpireTime(val time.Time) ExpireTimeField {
+	return NewExpireTimeWithPrecision(val, quickfix.Millis)
+}
+
+// NewExpireTimeNoMillis returns a new ExpireTimeField initialized with val without millisecs.
+func NewExpireTimeNoMillis(val time.Time) ExpireTimeField {
+	return NewExpireTimeWithPrecision(val, quickfix.Seconds)
+}
+
+// NewExpireTimeWithPrecision returns a new ExpireTimeField initialized with val of specified precision.
+func NewExpireTimeWithPrecision(val time.Time, precision quickfix.TimestampPrecision) ExpireTimeField {
+	return ExpireTimeField{quickfix.FIXUTCTimestamp{Time: val, Precision: precision}}
+}
+
+func (f ExpireTimeField) Value() time.Time { return f.Time }
+
+// FactorField is a FLOAT field.
+type FactorField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.Factor (228).
+func (f FactorField) Tag() quickfix.Tag { return tag.Factor }
+
+// NewFactor returns a new FactorField initialized with val and scale.
+func NewFactor(val decimal.Decimal, scale int32) FactorField {
+	return FactorField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f FactorField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// FinancialStatusField is a enum.FinancialStatus field.
+type FinancialStatusField struct{ quickfix.FIXString }
+
+// Tag returns tag.FinancialStatus (291).
+func (f FinancialStatusField) Tag() quickfix.Tag { return tag.FinancialStatus }
+
+func NewFinancialStatus(val enum.FinancialStatus) FinancialStatusField {
+	return FinancialStatusField{quickfix.FIXString(val)}
+}
+
+func (f FinancialStatusField) Value() enum.FinancialStatus { return enum.FinancialStatus(f.String()) }
+
+// HeartBtIntField is a INT field.
+type HeartBtIntField struct{ quickfix.FIXInt }
+
+// Tag returns tag.HeartBtInt (108).
+func (f HeartBtIntField) Tag() quickfix.Tag { return tag.HeartBtInt }
+
+// NewHeartBtInt returns a new HeartBtIntField initialized with val.
+func NewHeartBtInt(val int) HeartBtIntField {
+	return HeartBtIntField{quickfix.FIXInt(val)}
+}
+
+func (f HeartBtIntField) Value() int { return f.Int() }
+
+// HopCompIDField is a STRING field.
+type HopCompIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.HopCompID (628).
+func (f HopCompIDField) Tag() quickfix.Tag { return tag.HopCompID }
+
+// NewHopCompID returns a new HopCompIDField initialized with val.
+func NewHopCompID(val string) HopCompIDField {
+	return HopCompIDField{quickfix.FIXString(val)}
+}
+
+func (f HopCompIDField) Value() string { return f.String() }
+
+// HopRefIDField is a SEQNUM field.
+type HopRefIDField struct{ quickfix.FIXInt }
+
+// Tag returns tag.HopRefID (630).
+func (f HopRefIDField) Tag() quickfix.Tag { return tag.HopRefID }
+
+// NewHopRefID returns a new HopRefIDField initialized with val.
+func NewHopRefID(val int) HopRefIDField {
+	return HopRefIDField{quickfix.FIXInt(val)}
+}
+
+func (f HopRefIDField) Value() int { return f.Int() }
+
+// HopSendingTimeField is a UTCTIMESTAMP field.
+type HopSendingTimeField struct{ quickfix.FIXUTCTimestamp }
+
+// Tag returns tag.HopSendingTime (629).
+func (f HopSendingTimeField) Tag() quickfix.Tag { return tag.HopSendingTime }
+
+// NewHopSendingTime returns a new HopSendingTimeField initialized with val.
+func NewHopSendingTime(val time.Time) HopSendingTimeField {
+	return NewHopSendingTimeWithPrecision(val, quickfix.Millis)
+}
+
+// NewHopSendingTimeNoMillis returns a new HopSendingTimeField initialized with val without millisecs.
+func NewHopSendingTimeNoMillis(val time.Time) HopSendingTimeField {
+	return NewHopSendingTimeWithPrecision(val, quickfix.Seconds)
+}
+
+// NewHopSendingTimeWithPrecision returns a new HopSendingTimeField initialized with val of specified precision.
+func NewHopSendingTimeWithPrecision(val time.Time, precision quickfix.TimestampPrecision) HopSendingTimeField {
+	return HopSendingTimeField{quickfix.FIXUTCTimestamp{Time: val, Precision: precision}}
+}
+
+func (f HopSendingTimeField) Value() time.Time { return f.Time }
+
+// InstrAttribTypeField is a enum.InstrAttribType field.
+type InstrAttribTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.InstrAttribType (871).
+func (f InstrAttribTypeField) Tag() quickfix.Tag { return tag.InstrAttribType }
+
+func NewInstrAttribType(val enum.InstrAttribType) InstrAttribTypeField {
+	return InstrAttribTypeField{quickfix.FIXString(val)}
+}
+
+func (f InstrAttribTypeField) Value() enum.InstrAttribType { return enum.InstrAttribType(f.String()) }
+
+// InstrAttribValueField is a STRING field.
+type InstrAttribValueField struct{ quickfix.FIXString }
+
+// Tag returns tag.InstrAttribValue (872).
+func (f InstrAttribValueField) Tag() quickfix.Tag { return tag.InstrAttribValue }
+
+// NewInstrAttribValue returns a new InstrAttribValueField initialized with val.
+func NewInstrAttribValue(val string) InstrAttribValueField {
+	return InstrAttribValueField{quickfix.FIXString(val)}
+}
+
+func (f InstrAttribValueField) Value() string { return f.String() }
+
+// InstrRegistryField is a enum.InstrRegistry field.
+type InstrRegistryField struct{ quickfix.FIXString }
+
+// Tag returns tag.InstrRegistry (543).
+func (f InstrRegistryField) Tag() quickfix.Tag { return tag.InstrRegistry }
+
+func NewInstrRegistry(val enum.InstrRegistry) InstrRegistryField {
+	return InstrRegistryField{quickfix.FIXString(val)}
+}
+
+func (f InstrRegistryField) Value() enum.InstrRegistry { return enum.InstrRegistry(f.String()) }
+
+// InterestAccrualDateField is a LOCALMKTDATE field.
+type InterestAccrualDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.InterestAccrualDate (874).
+func (f InterestAccrualDateField) Tag() quickfix.Tag { return tag.InterestAccrualDate }
+
+// NewInterestAccrualDate returns a new InterestAccrualDateField initialized with val.
+func NewInterestAccrualDate(val string) InterestAccrualDateField {
+	return InterestAccrualDateField{quickfix.FIXString(val)}
+}
+
+func (f InterestAccrualDateField) Value() string { return f.String() }
+
+// IssueDateField is a LOCALMKTDATE field.
+type IssueDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.IssueDate (225).
+func (f IssueDateField) Tag() quickfix.Tag { return tag.IssueDate }
+
+// NewIssueDate returns a new IssueDateField initialized with val.
+func NewIssueDate(val string) IssueDateField {
+	return IssueDateField{quickfix.FIXString(val)}
+}
+
+func (f IssueDateField) Value() string { return f.String() }
+
+// IssuerField is a STRING field.
+type IssuerField struct{ quickfix.FIXString }
+
+// Tag returns tag.Issuer (106).
+func (f IssuerField) Tag() quickfix.Tag { return tag.Issuer }
+
+// NewIssuer returns a new IssuerField initialized with val.
+func NewIssuer(val string) IssuerField {
+	return IssuerField{quickfix.FIXString(val)}
+}
+
+func (f IssuerField) Value() string { return f.String() }
+
+// LastFragmentField is a BOOLEAN field.
+type LastFragmentField struct{ quickfix.FIXBoolean }
+
+// Tag returns tag.LastFragment (893).
+func (f LastFragmentField) Tag() quickfix.Tag { return tag.LastFragment }
+
+// NewLastFragment returns a new LastFragmentField initialized with val.
+func NewLastFragment(val bool) LastFragmentField {
+	return LastFragmentField{quickfix.FIXBoolean(val)}
+}
+
+func (f LastFragmentField) Value() bool { return f.Bool() }
+
+// LastMsgSeqNumProcessedField is a SEQNUM field.
+type LastMsgSeqNumProcessedField struct{ quickfix.FIXInt }
+
+// Tag returns tag.LastMsgSeqNumProcessed (369).
+func (f LastMsgSeqNumProcessedField) Tag() quickfix.Tag { return tag.LastMsgSeqNumProcessed }
+
+// NewLastMsgSeqNumProcessed returns a new LastMsgSeqNumProcessedField initialized with val.
+func NewLastMsgSeqNumProcessed(val int) LastMsgSeqNumProcessedField {
+	return LastMsgSeqNumProcessedField{quickfix.FIXInt(val)}
+}
+
+func (f LastMsgSeqNumProcessedField) Value() int { return f.Int() }
+
+// LegBenchmarkCurveCurrencyField is a CURRENCY field.
+type LegBenchmarkCurveCurrencyField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegBenchmarkCurveCurrency (676).
+func (f LegBenchmarkCurveCurrencyField) Tag() quickfix.Tag { return tag.LegBenchmarkCurveCurrency }
+
+// NewLegBenchmarkCurveCurrency returns a new LegBenchmarkCurveCurrencyField initialized with val.
+func NewLegBenchmarkCurveCurrency(val string) LegBenchmarkCurveCurrencyField {
+	return LegBenchmarkCurveCurrencyField{quickfix.FIXString(val)}
+}
+
+func (f LegBenchmarkCurveCurrencyField) Value() string { return f.String() }
+
+// LegBenchmarkCurveNameField is a STRING field.
+type LegBenchmarkCurveNameField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegBenchmarkCurveName (677).
+func (f LegBenchmarkCurveNameField) Tag() quickfix.Tag { return tag.LegBenchmarkCurveName }
+
+// NewLegBenchmarkCurveName returns a new LegBenchmarkCurveNameField initialized with val.
+func NewLegBenchmarkCurveName(val string) LegBenchmarkCurveNameField {
+	return LegBenchmarkCurveNameField{quickfix.FIXString(val)}
+}
+
+func (f LegBenchmarkCurveNameField) Value() string { return f.String() }
+
+// LegBenchmarkCurvePointField is a STRING field.
+type LegBenchmarkCurvePointField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegBenchmarkCurvePoint (678).
+func (f LegBenchmarkCurvePointField) Tag() quickfix.Tag { return tag.LegBenchmarkCurvePoint }
+
+// NewLegBenchmarkCurvePoint returns a new LegBenchmarkCurvePointField initialized with val.
+func NewLegBenchmarkCurvePoint(val string) LegBenchmarkCurvePointField {
+	return LegBenchmarkCurvePointField{quickfix.FIXString(val)}
+}
+
+func (f LegBenchmarkCurvePointField) Value() string { return f.String() }
+
+// LegBenchmarkPriceField is a PRICE field.
+type LegBenchmarkPriceField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.LegBenchmarkPrice (679).
+func (f LegBenchmarkPriceField) Tag() quickfix.Tag { return tag.LegBenchmarkPrice }
+
+// NewLegBenchmarkPrice returns a new LegBenchmarkPriceField initialized with val and scale.
+func NewLegBenchmarkPrice(val decimal.Decimal, scale int32) LegBenchmarkPriceField {
+	return LegBenchmarkPriceField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f LegBenchmarkPriceField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// LegBenchmarkPriceTypeField is a INT field.
+type LegBenchmarkPriceTypeField struct{ quickfix.FIXInt }
+
+// Tag returns tag.LegBenchmarkPriceType (680).
+func (f LegBenchmarkPriceTypeField) Tag() quickfix.Tag { return tag.LegBenchmarkPriceType }
+
+// NewLegBenchmarkPriceType returns a new LegBenchmarkPriceTypeField initialized with val.
+func NewLegBenchmarkPriceType(val int) LegBenchmarkPriceTypeField {
+	return LegBenchmarkPriceTypeField{quickfix.FIXInt(val)}
+}
+
+func (f LegBenchmarkPriceTypeField) Value() int { return f.Int() }
+
+// LegCFICodeField is a STRING field.
+type LegCFICodeField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegCFICode (608).
+func (f LegCFICodeField) Tag() quickfix.Tag { return tag.LegCFICode }
+
+// NewLegCFICode returns a new LegCFICodeField initialized with val.
+func NewLegCFICode(val string) LegCFICodeField {
+	return LegCFICodeField{quickfix.FIXString(val)}
+}
+
+func (f LegCFICodeField) Value() string { return f.String() }
+
+// LegContractMultiplierField is a FLOAT field.
+type LegContractMultiplierField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.LegContractMultiplier (614).
+func (f LegContractMultiplierField) Tag() quickfix.Tag { return tag.LegContractMultiplier }
+
+// NewLegContractMultiplier returns a new LegContractMultiplierField initialized with val and scale.
+func NewLegContractMultiplier(val decimal.Decimal, scale int32) LegContractMultiplierField {
+	return LegContractMultiplierField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f LegContractMultiplierField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// LegContractSettlMonthField is a MONTHYEAR field.
+type LegContractSettlMonthField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegContractSettlMonth (955).
+func (f LegContractSettlMonthField) Tag() quickfix.Tag { return tag.LegContractSettlMonth }
+
+// NewLegContractSettlMonth returns a new LegContractSettlMonthField initialized with val.
+func NewLegContractSettlMonth(val string) LegContractSettlMonthField {
+	return LegContractSettlMonthField{quickfix.FIXString(val)}
+}
+
+func (f LegContractSettlMonthField) Value() string { return f.String() }
+
+// LegCountryOfIssueField is a COUNTRY field.
+type LegCountryOfIssueField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegCountryOfIssue (596).
+func (f LegCountryOfIssueField) Tag() quickfix.Tag { return tag.LegCountryOfIssue }
+
+// NewLegCountryOfIssue returns a new LegCountryOfIssueField initialized with val.
+func NewLegCountryOfIssue(val string) LegCountryOfIssueField {
+	return LegCountryOfIssueField{quickfix.FIXString(val)}
+}
+
+func (f LegCountryOfIssueField) Value() string { return f.String() }
+
+// LegCouponPaymentDateField is a LOCALMKTDATE field.
+type LegCouponPaymentDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegCouponPaymentDate (248).
+func (f LegCouponPaymentDateField) Tag() quickfix.Tag { return tag.LegCouponPaymentDate }
+
+// NewLegCouponPaymentDate returns a new LegCouponPaymentDateField initialized with val.
+func NewLegCouponPaymentDate(val string) LegCouponPaymentDateField {
+	return LegCouponPaymentDateField{quickfix.FIXString(val)}
+}
+
+func (f LegCouponPaymentDateField) Value() string { return f.String() }
+
+// LegCouponRateField is a PERCENTAGE field.
+type LegCouponRateField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.LegCouponRate (615).
+func (f LegCouponRateField) Tag() quickfix.Tag { return tag.LegCouponRate }
+
+// NewLegCouponRate returns a new LegCouponRateField initialized with val and scale.
+func NewLegCouponRate(val decimal.Decimal, scale int32) LegCouponRateField {
+	return LegCouponRateField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f LegCouponRateField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// LegCreditRatingField is a STRING field.
+type LegCreditRatingField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegCreditRating (257).
+func (f LegCreditRatingField) Tag() quickfix.Tag { return tag.LegCreditRating }
+
+// NewLegCreditRating returns a new LegCreditRatingField initialized with val.
+func NewLegCreditRating(val string) LegCreditRatingField {
+	return LegCreditRatingField{quickfix.FIXString(val)}
+}
+
+func (f LegCreditRatingField) Value() string { return f.String() }
+
+// LegCurrencyField is a CURRENCY field.
+type LegCurrencyField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegCurrency (556).
+func (f LegCurrencyField) Tag() quickfix.Tag { return tag.LegCurrency }
+
+// NewLegCurrency returns a new LegCurrencyField initialized with val.
+func NewLegCurrency(val string) LegCurrencyField {
+	return LegCurrencyField{quickfix.FIXString(val)}
+}
+
+func (f LegCurrencyField) Value() string { return f.String() }
+
+// LegDatedDateField is a LOCALMKTDATE field.
+type LegDatedDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegDatedDate (739).
+func (f LegDatedDateField) Tag() quickfix.Tag { return tag.LegDatedDate }
+
+// NewLegDatedDate returns a new LegDatedDateField initialized with val.
+func NewLegDatedDate(val string) LegDatedDateField {
+	return LegDatedDateField{quickfix.FIXString(val)}
+}
+
+func (f LegDatedDateField) Value() string { return f.String() }
+
+// LegFactorField is a FLOAT field.
+type LegFactorField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.LegFactor (253).
+func (f LegFactorField) Tag() quickfix.Tag { return tag.LegFactor }
+
+// NewLegFactor returns a new LegFactorField initialized with val and scale.
+func NewLegFactor(val decimal.Decimal, scale int32) LegFactorField {
+	return LegFactorField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f LegFactorField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// LegInstrRegistryField is a STRING field.
+type LegInstrRegistryField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegInstrRegistry (599).
+func (f LegInstrRegistryField) Tag() quickfix.Tag { return tag.LegInstrRegistry }
+
+// NewLegInstrRegistry returns a new LegInstrRegistryField initialized with val.
+func NewLegInstrRegistry(val string) LegInstrRegistryField {
+	return LegInstrRegistryField{quickfix.FIXString(val)}
+}
+
+func (f LegInstrRegistryField) Value() string { return f.String() }
+
+// LegInterestAccrualDateField is a LOCALMKTDATE field.
+type LegInterestAccrualDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegInterestAccrualDate (956).
+func (f LegInterestAccrualDateField) Tag() quickfix.Tag { return tag.LegInterestAccrualDate }
+
+// NewLegInterestAccrualDate returns a new LegInterestAccrualDateField initialized with val.
+func NewLegInterestAccrualDate(val string) LegInterestAccrualDateField {
+	return LegInterestAccrualDateField{quickfix.FIXString(val)}
+}
+
+func (f LegInterestAccrualDateField) Value() string { return f.String() }
+
+// LegIssueDateField is a LOCALMKTDATE field.
+type LegIssueDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegIssueDate (249).
+func (f LegIssueDateField) Tag() quickfix.Tag { return tag.LegIssueDate }
+
+// NewLegIssueDate returns a new LegIssueDateField initialized with val.
+func NewLegIssueDate(val string) LegIssueDateField {
+	return LegIssueDateField{quickfix.FIXString(val)}
+}
+
+func (f LegIssueDateField) Value() string { return f.String() }
+
+// LegIssuerField is a STRING field.
+type LegIssuerField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegIssuer (617).
+func (f LegIssuerField) Tag() quickfix.Tag { return tag.LegIssuer }
+
+// NewLegIssuer returns a new LegIssuerField initialized with val.
+func NewLegIssuer(val string) LegIssuerField {
+	return LegIssuerField{quickfix.FIXString(val)}
+}
+
+func (f LegIssuerField) Value() string { return f.String() }
+
+// LegLocaleOfIssueField is a STRING field.
+type LegLocaleOfIssueField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegLocaleOfIssue (598).
+func (f LegLocaleOfIssueField) Tag() quickfix.Tag { return tag.LegLocaleOfIssue }
+
+// NewLegLocaleOfIssue returns a new LegLocaleOfIssueField initialized with val.
+func NewLegLocaleOfIssue(val string) LegLocaleOfIssueField {
+	return LegLocaleOfIssueField{quickfix.FIXString(val)}
+}
+
+func (f LegLocaleOfIssueField) Value() string { return f.String() }
+
+// LegMaturityDateField is a LOCALMKTDATE field.
+type LegMaturityDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegMaturityDate (611).
+func (f LegMaturityDateField) Tag() quickfix.Tag { return tag.LegMaturityDate }
+
+// NewLegMaturityDate returns a new LegMaturityDateField initialized with val.
+func NewLegMaturityDate(val string) LegMaturityDateField {
+	return LegMaturityDateField{quickfix.FIXString(val)}
+}
+
+func (f LegMaturityDateField) Value() string { return f.String() }
+
+// LegMaturityMonthYearField is a MONTHYEAR field.
+type LegMaturityMonthYearField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegMaturityMonthYear (610).
+func (f LegMaturityMonthYearField) Tag() quickfix.Tag { return tag.LegMaturityMonthYear }
+
+// NewLegMaturityMonthYear returns a new LegMaturityMonthYearField initialized with val.
+func NewLegMaturityMonthYear(val string) LegMaturityMonthYearField {
+	return LegMaturityMonthYearField{quickfix.FIXString(val)}
+}
+
+func (f LegMaturityMonthYearField) Value() string { return f.String() }
+
+// LegOptAttributeField is a CHAR field.
+type LegOptAttributeField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegOptAttribute (613).
+func (f LegOptAttributeField) Tag() quickfix.Tag { return tag.LegOptAttribute }
+
+// NewLegOptAttribute returns a new LegOptAttributeField initialized with val.
+func NewLegOptAttribute(val string) LegOptAttributeField {
+	return LegOptAttributeField{quickfix.FIXString(val)}
+}
+
+func (f LegOptAttributeField) Value() string { return f.String() }
+
+// LegPoolField is a STRING field.
+type LegPoolField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegPool (740).
+func (f LegPoolField) Tag() quickfix.Tag { return tag.LegPool }
+
+// NewLegPool returns a new LegPoolField initialized with val.
+func NewLegPool(val string) LegPoolField {
+	return LegPoolField{quickfix.FIXString(val)}
+}
+
+func (f LegPoolField) Value() string { return f.String() }
+
+// LegProductField is a INT field.
+type LegProductField struct{ quickfix.FIXInt }
+
+// Tag returns tag.LegProduct (607).
+func (f LegProductField) Tag() quickfix.Tag { return tag.LegProduct }
+
+// NewLegProduct returns a new LegProductField initialized with val.
+func NewLegProduct(val int) LegProductField {
+	return LegProductField{quickfix.FIXInt(val)}
+}
+
+func (f LegProductField) Value() int { return f.Int() }
+
+// LegRatioQtyField is a FLOAT field.
+type LegRatioQtyField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.LegRatioQty (623).
+func (f LegRatioQtyField) Tag() quickfix.Tag { return tag.LegRatioQty }
+
+// NewLegRatioQty returns a new LegRatioQtyField initialized with val and scale.
+func NewLegRatioQty(val decimal.Decimal, scale int32) LegRatioQtyField {
+	return LegRatioQtyField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f LegRatioQtyField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// LegRedemptionDateField is a LOCALMKTDATE field.
+type LegRedemptionDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegRedemptionDate (254).
+func (f LegRedemptionDateField) Tag() quickfix.Tag { return tag.LegRedemptionDate }
+
+// NewLegRedemptionDate returns a new LegRedemptionDateField initialized with val.
+func NewLegRedemptionDate(val string) LegRedemptionDateField {
+	return LegRedemptionDateField{quickfix.FIXString(val)}
+}
+
+func (f LegRedemptionDateField) Value() string { return f.String() }
+
+// LegRepoCollateralSecurityTypeField is a INT field.
+type LegRepoCollateralSecurityTypeField struct{ quickfix.FIXInt }
+
+// Tag returns tag.LegRepoCollateralSecurityType (250).
+func (f LegRepoCollateralSecurityTypeField) Tag() quickfix.Tag {
+	return tag.LegRepoCollateralSecurityType
+}
+
+// NewLegRepoCollateralSecurityType returns a new LegRepoCollateralSecurityTypeField initialized with val.
+func NewLegRepoCollateralSecurityType(val int) LegRepoCollateralSecurityTypeField {
+	return LegRepoCollateralSecurityTypeField{quickfix.FIXInt(val)}
+}
+
+func (f LegRepoCollateralSecurityTypeField) Value() int { return f.Int() }
+
+// LegRepurchaseRateField is a PERCENTAGE field.
+type LegRepurchaseRateField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.LegRepurchaseRate (252).
+func (f LegRepurchaseRateField) Tag() quickfix.Tag { return tag.LegRepurchaseRate }
+
+// NewLegRepurchaseRate returns a new LegRepurchaseRateField initialized with val and scale.
+func NewLegRepurchaseRate(val decimal.Decimal, scale int32) LegRepurchaseRateField {
+	return LegRepurchaseRateField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f LegRepurchaseRateField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// LegRepurchaseTermField is a INT field.
+type LegRepurchaseTermField struct{ quickfix.FIXInt }
+
+// Tag returns tag.LegRepurchaseTerm (251).
+func (f LegRepurchaseTermField) Tag() quickfix.Tag { return tag.LegRepurchaseTerm }
+
+// NewLegRepurchaseTerm returns a new LegRepurchaseTermField initialized with val.
+func NewLegRepurchaseTerm(val int) LegRepurchaseTermField {
+	return LegRepurchaseTermField{quickfix.FIXInt(val)}
+}
+
+func (f LegRepurchaseTermField) Value() int { return f.Int() }
+
+// LegSecurityAltIDField is a STRING field.
+type LegSecurityAltIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegSecurityAltID (605).
+func (f LegSecurityAltIDField) Tag() quickfix.Tag { return tag.LegSecurityAltID }
+
+// NewLegSecurityAltID returns a new LegSecurityAltIDField initialized with val.
+func NewLegSecurityAltID(val string) LegSecurityAltIDField {
+	return LegSecurityAltIDField{quickfix.FIXString(val)}
+}
+
+func (f LegSecurityAltIDField) Value() string { return f.String() }
+
+// LegSecurityAltIDSourceField is a STRING field.
+type LegSecurityAltIDSourceField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegSecurityAltIDSource (606).
+func (f LegSecurityAltIDSourceField) Tag() quickfix.Tag { return tag.LegSecurityAltIDSource }
+
+// NewLegSecurityAltIDSource returns a new LegSecurityAltIDSourceField initialized with val.
+func NewLegSecurityAltIDSource(val string) LegSecurityAltIDSourceField {
+	return LegSecurityAltIDSourceField{quickfix.FIXString(val)}
+}
+
+func (f LegSecurityAltIDSourceField) Value() string { return f.String() }
+
+// LegSecurityDescField is a STRING field.
+type LegSecurityDescField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegSecurityDesc (620).
+func (f LegSecurityDescField) Tag() quickfix.Tag { return tag.LegSecurityDesc }
+
+// NewLegSecurityDesc returns a new LegSecurityDescField initialized with val.
+func NewLegSecurityDesc(val string) LegSecurityDescField {
+	return LegSecurityDescField{quickfix.FIXString(val)}
+}
+
+func (f LegSecurityDescField) Value() string { return f.String() }
+
+// LegSecurityExchangeField is a EXCHANGE field.
+type LegSecurityExchangeField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegSecurityExchange (616).
+func (f LegSecurityExchangeField) Tag() quickfix.Tag { return tag.LegSecurityExchange }
+
+// NewLegSecurityExchange returns a new LegSecurityExchangeField initialized with val.
+func NewLegSecurityExchange(val string) LegSecurityExchangeField {
+	return LegSecurityExchangeField{quickfix.FIXString(val)}
+}
+
+func (f LegSecurityExchangeField) Value() string { return f.String() }
+
+// LegSecurityIDField is a STRING field.
+type LegSecurityIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegSecurityID (602).
+func (f LegSecurityIDField) Tag() quickfix.Tag { return tag.LegSecurityID }
+
+// NewLegSecurityID returns a new LegSecurityIDField initialized with val.
+func NewLegSecurityID(val string) LegSecurityIDField {
+	return LegSecurityIDField{quickfix.FIXString(val)}
+}
+
+func (f LegSecurityIDField) Value() string { return f.String() }
+
+// LegSecurityIDSourceField is a STRING field.
+type LegSecurityIDSourceField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegSecurityIDSource (603).
+func (f LegSecurityIDSourceField) Tag() quickfix.Tag { return tag.LegSecurityIDSource }
+
+// NewLegSecurityIDSource returns a new LegSecurityIDSourceField initialized with val.
+func NewLegSecurityIDSource(val string) LegSecurityIDSourceField {
+	return LegSecurityIDSourceField{quickfix.FIXString(val)}
+}
+
+func (f LegSecurityIDSourceField) Value() string { return f.String() }
+
+// LegSecuritySubTypeField is a STRING field.
+type LegSecuritySubTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegSecuritySubType (764).
+func (f LegSecuritySubTypeField) Tag() quickfix.Tag { return tag.LegSecuritySubType }
+
+// NewLegSecuritySubType returns a new LegSecuritySubTypeField initialized with val.
+func NewLegSecuritySubType(val string) LegSecuritySubTypeField {
+	return LegSecuritySubTypeField{quickfix.FIXString(val)}
+}
+
+func (f LegSecuritySubTypeField) Value() string { return f.String() }
+
+// LegSecurityTypeField is a STRING field.
+type LegSecurityTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegSecurityType (609).
+func (f LegSecurityTypeField) Tag() quickfix.Tag { return tag.LegSecurityType }
+
+// NewLegSecurityType returns a new LegSecurityTypeField initialized with val.
+func NewLegSecurityType(val string) LegSecurityTypeField {
+	return LegSecurityTypeField{quickfix.FIXString(val)}
+}
+
+func (f LegSecurityTypeField) Value() string { return f.String() }
+
+// LegSettlTypeField is a CHAR field.
+type LegSettlTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegSettlType (587).
+func (f LegSettlTypeField) Tag() quickfix.Tag { return tag.LegSettlType }
+
+// NewLegSettlType returns a new LegSettlTypeField initialized with val.
+func NewLegSettlType(val string) LegSettlTypeField {
+	return LegSettlTypeField{quickfix.FIXString(val)}
+}
+
+func (f LegSettlTypeField) Value() string { return f.String() }
+
+// LegSideField is a CHAR field.
+type LegSideField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegSide (624).
+func (f LegSideField) Tag() quickfix.Tag { return tag.LegSide }
+
+// NewLegSide returns a new LegSideField initialized with val.
+func NewLegSide(val string) LegSideField {
+	return LegSideField{quickfix.FIXString(val)}
+}
+
+func (f LegSideField) Value() string { return f.String() }
+
+// LegStateOrProvinceOfIssueField is a STRING field.
+type LegStateOrProvinceOfIssueField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegStateOrProvinceOfIssue (597).
+func (f LegStateOrProvinceOfIssueField) Tag() quickfix.Tag { return tag.LegStateOrProvinceOfIssue }
+
+// NewLegStateOrProvinceOfIssue returns a new LegStateOrProvinceOfIssueField initialized with val.
+func NewLegStateOrProvinceOfIssue(val string) LegStateOrProvinceOfIssueField {
+	return LegStateOrProvinceOfIssueField{quickfix.FIXString(val)}
+}
+
+func (f LegStateOrProvinceOfIssueField) Value() string { return f.String() }
+
+// LegStipulationTypeField is a STRING field.
+type LegStipulationTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegStipulationType (688).
+func (f LegStipulationTypeField) Tag() quickfix.Tag { return tag.LegStipulationType }
+
+// NewLegStipulationType returns a new LegStipulationTypeField initialized with val.
+func NewLegStipulationType(val string) LegStipulationTypeField {
+	return LegStipulationTypeField{quickfix.FIXString(val)}
+}
+
+func (f LegStipulationTypeField) Value() string { return f.String() }
+
+// LegStipulationValueField is a STRING field.
+type LegStipulationValueField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegStipulationValue (689).
+func (f LegStipulationValueField) Tag() quickfix.Tag { return tag.LegStipulationValue }
+
+// NewLegStipulationValue returns a new LegStipulationValueField initialized with val.
+func NewLegStipulationValue(val string) LegStipulationValueField {
+	return LegStipulationValueField{quickfix.FIXString(val)}
+}
+
+func (f LegStipulationValueField) Value() string { return f.String() }
+
+// LegStrikeCurrencyField is a CURRENCY field.
+type LegStrikeCurrencyField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegStrikeCurrency (942).
+func (f LegStrikeCurrencyField) Tag() quickfix.Tag { return tag.LegStrikeCurrency }
+
+// NewLegStrikeCurrency returns a new LegStrikeCurrencyField initialized with val.
+func NewLegStrikeCurrency(val string) LegStrikeCurrencyField {
+	return LegStrikeCurrencyField{quickfix.FIXString(val)}
+}
+
+func (f LegStrikeCurrencyField) Value() string { return f.String() }
+
+// LegStrikePriceField is a PRICE field.
+type LegStrikePriceField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.LegStrikePrice (612).
+func (f LegStrikePriceField) Tag() quickfix.Tag { return tag.LegStrikePrice }
+
+// NewLegStrikePrice returns a new LegStrikePriceField initialized with val and scale.
+func NewLegStrikePrice(val decimal.Decimal, scale int32) LegStrikePriceField {
+	return LegStrikePriceField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f LegStrikePriceField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// LegSwapTypeField is a enum.LegSwapType field.
+type LegSwapTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegSwapType (690).
+func (f LegSwapTypeField) Tag() quickfix.Tag { return tag.LegSwapType }
+
+func NewLegSwapType(val enum.LegSwapType) LegSwapTypeField {
+	return LegSwapTypeField{quickfix.FIXString(val)}
+}
+
+func (f LegSwapTypeField) Value() enum.LegSwapType { return enum.LegSwapType(f.String()) }
+
+// LegSymbolField is a STRING field.
+type LegSymbolField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegSymbol (600).
+func (f LegSymbolField) Tag() quickfix.Tag { return tag.LegSymbol }
+
+// NewLegSymbol returns a new LegSymbolField initialized with val.
+func NewLegSymbol(val string) LegSymbolField {
+	return LegSymbolField{quickfix.FIXString(val)}
+}
+
+func (f LegSymbolField) Value() string { return f.String() }
+
+// LegSymbolSfxField is a STRING field.
+type LegSymbolSfxField struct{ quickfix.FIXString }
+
+// Tag returns tag.LegSymbolSfx (601).
+func (f LegSymbolSfxField) Tag() quickfix.Tag { return tag.LegSymbolSfx }
+
+// NewLegSymbolSfx returns a new LegSymbolSfxField initialized with val.
+func NewLegSymbolSfx(val string) LegSymbolSfxField {
+	return LegSymbolSfxField{quickfix.FIXString(val)}
+}
+
+func (f LegSymbolSfxField) Value() string { return f.String() }
+
+// LocaleOfIssueField is a STRING field.
+type LocaleOfIssueField struct{ quickfix.FIXString }
+
+// Tag returns tag.LocaleOfIssue (472).
+func (f LocaleOfIssueField) Tag() quickfix.Tag { return tag.LocaleOfIssue }
+
+// NewLocaleOfIssue returns a new LocaleOfIssueField initialized with val.
+func NewLocaleOfIssue(val string) LocaleOfIssueField {
+	return LocaleOfIssueField{quickfix.FIXString(val)}
+}
+
+func (f LocaleOfIssueField) Value() string { return f.String() }
+
+// LocationIDField is a STRING field.
+type LocationIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.LocationID (283).
+func (f LocationIDField) Tag() quickfix.Tag { return tag.LocationID }
+
+// NewLocationID returns a new LocationIDField initialized with val.
+func NewLocationID(val string) LocationIDField {
+	return LocationIDField{quickfix.FIXString(val)}
+}
+
+func (f LocationIDField) Value() string { return f.String() }
+
+// MDEntryBuyerField is a STRING field.
+type MDEntryBuyerField struct{ quickfix.FIXString }
+
+// Tag returns tag.MDEntryBuyer (288).
+func (f MDEntryBuyerField) Tag() quickfix.Tag { return tag.MDEntryBuyer }
+
+// NewMDEntryBuyer returns a new MDEntryBuyerField initialized with val.
+func NewMDEntryBuyer(val string) MDEntryBuyerField {
+	return MDEntryBuyerField{quickfix.FIXString(val)}
+}
+
+func (f MDEntryBuyerField) Value() string { return f.String() }
+
+// MDEntryDateField is a UTCDATEONLY field.
+type MDEntryDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.MDEntryDate (272).
+func (f MDEntryDateField) Tag() quickfix.Tag { return tag.MDEntryDate }
+
+// NewMDEntryDate returns a new MDEntryDateField initialized with val.
+func NewMDEntryDate(val string) MDEntryDateField {
+	return MDEntryDateField{quickfix.FIXString(val)}
+}
+
+func (f MDEntryDateField) Value() string { return f.String() }
+
+// MDEntryIDField is a STRING field.
+type MDEntryIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.MDEntryID (278).
+func (f MDEntryIDField) Tag() quickfix.Tag { return tag.MDEntryID }
+
+// NewMDEntryID returns a new MDEntryIDField initialized with val.
+func NewMDEntryID(val string) MDEntryIDField {
+	return MDEntryIDField{quickfix.FIXString(val)}
+}
+
+func (f MDEntryIDField) Value() string { return f.String() }
+
+// MDEntryOriginatorField is a STRING field.
+type MDEntryOriginatorField struct{ quickfix.FIXString }
+
+// Tag returns tag.MDEntryOriginator (282).
+func (f MDEntryOriginatorField) Tag() quickfix.Tag { return tag.MDEntryOriginator }
+
+// NewMDEntryOriginator returns a new MDEntryOriginatorField initialized with val.
+func NewMDEntryOriginator(val string) MDEntryOriginatorField {
+	return MDEntryOriginatorField{quickfix.FIXString(val)}
+}
+
+func (f MDEntryOriginatorField) Value() string { return f.String() }
+
+// MDEntryPositionNoField is a INT field.
+type MDEntryPositionNoField struct{ quickfix.FIXInt }
+
+// Tag returns tag.MDEntryPositionNo (290).
+func (f MDEntryPositionNoField) Tag() quickfix.Tag { return tag.MDEntryPositionNo }
+
+// NewMDEntryPositionNo returns a new MDEntryPositionNoField initialized with val.
+func NewMDEntryPositionNo(val int) MDEntryPositionNoField {
+	return MDEntryPositionNoField{quickfix.FIXInt(val)}
+}
+
+func (f MDEntryPositionNoField) Value() int { return f.Int() }
+
+// MDEntryPxField is a PRICE field.
+type MDEntryPxField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.MDEntryPx (270).
+func (f MDEntryPxField) Tag() quickfix.Tag { return tag.MDEntryPx }
+
+// NewMDEntryPx returns a new MDEntryPxField initialized with val and scale.
+func NewMDEntryPx(val decimal.Decimal, scale int32) MDEntryPxField {
+	return MDEntryPxField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f MDEntryPxField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// MDEntryRefIDField is a STRING field.
+type MDEntryRefIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.MDEntryRefID (280).
+func (f MDEntryRefIDField) Tag() quickfix.Tag { return tag.MDEntryRefID }
+
+// NewMDEntryRefID returns a new MDEntryRefIDField initialized with val.
+func NewMDEntryRefID(val string) MDEntryRefIDField {
+	return MDEntryRefIDField{quickfix.FIXString(val)}
+}
+
+func (f MDEntryRefIDField) Value() string { return f.String() }
+
+// MDEntrySellerField is a STRING field.
+type MDEntrySellerField struct{ quickfix.FIXString }
+
+// Tag returns tag.MDEntrySeller (289).
+func (f MDEntrySellerField) Tag() quickfix.Tag { return tag.MDEntrySeller }
+
+// NewMDEntrySeller returns a new MDEntrySellerField initialized with val.
+func NewMDEntrySeller(val string) MDEntrySellerField {
+	return MDEntrySellerField{quickfix.FIXString(val)}
+}
+
+func (f MDEntrySellerField) Value() string { return f.String() }
+
+// MDEntrySizeField is a QTY field.
+type MDEntrySizeField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.MDEntrySize (271).
+func (f MDEntrySizeField) Tag() quickfix.Tag { return tag.MDEntrySize }
+
+// NewMDEntrySize returns a new MDEntrySizeField initialized with val and scale.
+func NewMDEntrySize(val decimal.Decimal, scale int32) MDEntrySizeField {
+	return MDEntrySizeField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f MDEntrySizeField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// MDEntryTimeField is a UTCTIMEONLY field.
+type MDEntryTimeField struct{ quickfix.FIXString }
+
+// Tag returns tag.MDEntryTime (273).
+func (f MDEntryTimeField) Tag() quickfix.Tag { return tag.MDEntryTime }
+
+// NewMDEntryTime returns a new MDEntryTimeField initialized with val.
+func NewMDEntryTime(val string) MDEntryTimeField {
+	return MDEntryTimeField{quickfix.FIXString(val)}
+}
+
+func (f MDEntryTimeField) Value() string { return f.String() }
+
+// MDEntryTypeField is a enum.MDEntryType field.
+type MDEntryTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.MDEntryType (269).
+func (f MDEntryTypeField) Tag() quickfix.Tag { return tag.MDEntryType }
+
+func NewMDEntryType(val enum.MDEntryType) MDEntryTypeField {
+	return MDEntryTypeField{quickfix.FIXString(val)}
+}
+
+func (f MDEntryTypeField) Value() enum.MDEntryType { return enum.MDEntryType(f.String()) }
+
+// MDMktField is a EXCHANGE field.
+type MDMktField struct{ quickfix.FIXString }
+
+// Tag returns tag.MDMkt (275).
+func (f MDMktField) Tag() quickfix.Tag { return tag.MDMkt }
+
+// NewMDMkt returns a new MDMktField initialized with val.
+func NewMDMkt(val string) MDMktField {
+	return MDMktField{quickfix.FIXString(val)}
+}
+
+func (f MDMktField) Value() string { return f.String() }
+
+// MDReqIDField is a STRING field.
+type MDReqIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.MDReqID (262).
+func (f MDReqIDField) Tag() quickfix.Tag { return tag.MDReqID }
+
+// NewMDReqID returns a new MDReqIDField initialized with val.
+func NewMDReqID(val string) MDReqIDField {
+	return MDReqIDField{quickfix.FIXString(val)}
+}
+
+func (f MDReqIDField) Value() string { return f.String() }
+
+// MDUpdateActionField is a enum.MDUpdateAction field.
+type MDUpdateActionField struct{ quickfix.FIXString }
+
+// Tag returns tag.MDUpdateAction (279).
+func (f MDUpdateActionField) Tag() quickfix.Tag { return tag.MDUpdateAction }
+
+func NewMDUpdateAction(val enum.MDUpdateAction) MDUpdateActionField {
+	return MDUpdateActionField{quickfix.FIXString(val)}
+}
+
+func (f MDUpdateActionField) Value() enum.MDUpdateAction { return enum.MDUpdateAction(f.String()) }
+
+// MarginRatioField is a PERCENTAGE field.
+type MarginRatioField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.MarginRatio (898).
+func (f MarginRatioField) Tag() quickfix.Tag { return tag.MarginRatio }
+
+// NewMarginRatio returns a new MarginRatioField initialized with val and scale.
+func NewMarginRatio(val decimal.Decimal, scale int32) MarginRatioField {
+	return MarginRatioField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f MarginRatioField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// MaturityDateField is a LOCALMKTDATE field.
+type MaturityDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.MaturityDate (541).
+func (f MaturityDateField) Tag() quickfix.Tag { return tag.MaturityDate }
+
+// NewMaturityDate returns a new MaturityDateField initialized with val.
+func NewMaturityDate(val string) MaturityDateField {
+	return MaturityDateField{quickfix.FIXString(val)}
+}
+
+func (f MaturityDateField) Value() string { return f.String() }
+
+// MaturityMonthYearField is a MONTHYEAR field.
+type MaturityMonthYearField struct{ quickfix.FIXString }
+
+// Tag returns tag.MaturityMonthYear (200).
+func (f MaturityMonthYearField) Tag() quickfix.Tag { return tag.MaturityMonthYear }
+
+// NewMaturityMonthYear returns a new MaturityMonthYearField initialized with val.
+func NewMaturityMonthYear(val string) MaturityMonthYearField {
+	return MaturityMonthYearField{quickfix.FIXString(val)}
+}
+
+func (f MaturityMonthYearField) Value() string { return f.String() }
+
+// MaxMessageSizeField is a LENGTH field.
+type MaxMessageSizeField struct{ quickfix.FIXInt }
+
+// Tag returns tag.MaxMessageSize (383).
+func (f MaxMessageSizeField) Tag() quickfix.Tag { return tag.MaxMessageSize }
+
+// NewMaxMessageSize returns a new MaxMessageSizeField initialized with val.
+func NewMaxMessageSize(val int) MaxMessageSizeField {
+	return MaxMessageSizeField{quickfix.FIXInt(val)}
+}
+
+func (f MaxMessageSizeField) Value() int { return f.Int() }
+
+// MessageEncodingField is a STRING field.
+type MessageEncodingField struct{ quickfix.FIXString }
+
+// Tag returns tag.MessageEncoding (347).
+func (f MessageEncodingField) Tag() quickfix.Tag { return tag.MessageEncoding }
+
+// NewMessageEncoding returns a new MessageEncodingField initialized with val.
+func NewMessageEncoding(val string) MessageEncodingField {
+	return MessageEncodingField{quickfix.FIXString(val)}
+}
+
+func (f MessageEncodingField) Value() string { return f.String() }
+
+// MinQtyField is a QTY field.
+type MinQtyField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.MinQty (110).
+func (f MinQtyField) Tag() quickfix.Tag { return tag.MinQty }
+
+// NewMinQty returns a new MinQtyField initialized with val and scale.
+func NewMinQty(val decimal.Decimal, scale int32) MinQtyField {
+	return MinQtyField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f MinQtyField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// MinTradeVolField is a QTY field.
+type MinTradeVolField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.MinTradeVol (562).
+func (f MinTradeVolField) Tag() quickfix.Tag { return tag.MinTradeVol }
+
+// NewMinTradeVol returns a new MinTradeVolField initialized with val and scale.
+func NewMinTradeVol(val decimal.Decimal, scale int32) MinTradeVolField {
+	return MinTradeVolField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f MinTradeVolField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// MsgDirectionField is a enum.MsgDirection field.
+type MsgDirectionField struct{ quickfix.FIXString }
+
+// Tag returns tag.MsgDirection (385).
+func (f MsgDirectionField) Tag() quickfix.Tag { return tag.MsgDirection }
+
+func NewMsgDirection(val enum.MsgDirection) MsgDirectionField {
+	return MsgDirectionField{quickfix.FIXString(val)}
+}
+
+func (f MsgDirectionField) Value() enum.MsgDirection { return enum.MsgDirection(f.String()) }
+
+// MsgSeqNumField is a SEQNUM field.
+type MsgSeqNumField struct{ quickfix.FIXInt }
+
+// Tag returns tag.MsgSeqNum (34).
+func (f MsgSeqNumField) Tag() quickfix.Tag { return tag.MsgSeqNum }
+
+// NewMsgSeqNum returns a new MsgSeqNumField initialized with val.
+func NewMsgSeqNum(val int) MsgSeqNumField {
+	return MsgSeqNumField{quickfix.FIXInt(val)}
+}
+
+func (f MsgSeqNumField) Value() int { return f.Int() }
+
+// MsgTypeField is a enum.MsgType field.
+type MsgTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.MsgType (35).
+func (f MsgTypeField) Tag() quickfix.Tag { return tag.MsgType }
+
+func NewMsgType(val enum.MsgType) MsgTypeField {
+	return MsgTypeField{quickfix.FIXString(val)}
+}
+
+func (f MsgTypeField) Value() enum.MsgType { return enum.MsgType(f.String()) }
+
+// NetChgPrevDayField is a PRICEOFFSET field.
+type NetChgPrevDayField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.NetChgPrevDay (451).
+func (f NetChgPrevDayField) Tag() quickfix.Tag { return tag.NetChgPrevDay }
+
+// NewNetChgPrevDay returns a new NetChgPrevDayField initialized with val and scale.
+func NewNetChgPrevDay(val decimal.Decimal, scale int32) NetChgPrevDayField {
+	return NetChgPrevDayField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f NetChgPrevDayField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// NextExpectedMsgSeqNumField is a SEQNUM field.
+type NextExpectedMsgSeqNumField struct{ quickfix.FIXInt }
+
+// Tag returns tag.NextExpectedMsgSeqNum (789).
+func (f NextExpectedMsgSeqNumField) Tag() quickfix.Tag { return tag.NextExpectedMsgSeqNum }
+
+// NewNextExpectedMsgSeqNum returns a new NextExpectedMsgSeqNumField initialized with val.
+func NewNextExpectedMsgSeqNum(val int) NextExpectedMsgSeqNumField {
+	return NextExpectedMsgSeqNumField{quickfix.FIXInt(val)}
+}
+
+func (f NextExpectedMsgSeqNumField) Value() int { return f.Int() }
+
+// NoEventsField is a NUMINGROUP field.
+type NoEventsField struct{ quickfix.FIXInt }
+
+// Tag returns tag.NoEvents (864).
+func (f NoEventsField) Tag() quickfix.Tag { return tag.NoEvents }
+
+// NewNoEvents returns a new NoEventsField initialized with val.
+func NewNoEvents(val int) NoEventsField {
+	return NoEventsField{quickfix.FIXInt(val)}
+}
+
+func (f NoEventsField) Value() int { return f.Int() }
+
+// NoHopsField is a NUMINGROUP field.
+type NoHopsField struct{ quickfix.FIXInt }
+
+// Tag returns tag.NoHops (627).
+func (f NoHopsField) Tag() quickfix.Tag { return tag.NoHops }
+
+// NewNoHops returns a new NoHopsField initialized with val.
+func NewNoHops(val int) NoHopsField {
+	return NoHopsField{quickfix.FIXInt(val)}
+}
+
+func (f NoHopsField) Value() int { return f.Int() }
+
+// NoInstrAttribField is a NUMINGROUP field.
+type NoInstrAttribField struct{ quickfix.FIXInt }
+
+// Tag returns tag.NoInstrAttrib (870).
+func (f NoInstrAttribField) Tag() quickfix.Tag { return tag.NoInstrAttrib }
+
+// NewNoInstrAttrib returns a new NoInstrAttribField initialized with val.
+func NewNoInstrAttrib(val int) NoInstrAttribField {
+	return NoInstrAttribField{quickfix.FIXInt(val)}
+}
+
+func (f NoInstrAttribField) Value() int { return f.Int() }
+
+// NoLegSecurityAltIDField is a STRING field.
+type NoLegSecurityAltIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.NoLegSecurityAltID (604).
+func (f NoLegSecurityAltIDField) Tag() quickfix.Tag { return tag.NoLegSecurityAltID }
+
+// NewNoLegSecurityAltID returns a new NoLegSecurityAltIDField initialized with val.
+func NewNoLegSecurityAltID(val string) NoLegSecurityAltIDField {
+	return NoLegSecurityAltIDField{quickfix.FIXString(val)}
+}
+
+func (f NoLegSecurityAltIDField) Value() string { return f.String() }
+
+// NoLegStipulationsField is a NUMINGROUP field.
+type NoLegStipulationsField struct{ quickfix.FIXInt }
+
+// Tag returns tag.NoLegStipulations (683).
+func (f NoLegStipulationsField) Tag() quickfix.Tag { return tag.NoLegStipulations }
+
+// NewNoLegStipulations returns a new NoLegStipulationsField initialized with val.
+func NewNoLegStipulations(val int) NoLegStipulationsField {
+	return NoLegStipulationsField{quickfix.FIXInt(val)}
+}
+
+func (f NoLegStipulationsField) Value() int { return f.Int() }
+
+// NoLegsField is a NUMINGROUP field.
+type NoLegsField struct{ quickfix.FIXInt }
+
+// Tag returns tag.NoLegs (555).
+func (f NoLegsField) Tag() quickfix.Tag { return tag.NoLegs }
+
+// NewNoLegs returns a new NoLegsField initialized with val.
+func NewNoLegs(val int) NoLegsField {
+	return NoLegsField{quickfix.FIXInt(val)}
+}
+
+func (f NoLegsField) Value() int { return f.Int() }
+
+// NoMDEntriesField is a NUMINGROUP field.
+type NoMDEntriesField struct{ quickfix.FIXInt }
+
+// Tag returns tag.NoMDEntries (268).
+func (f NoMDEntriesField) Tag() quickfix.Tag { return tag.NoMDEntries }
+
+// NewNoMDEntries returns a new NoMDEntriesField initialized with val.
+func NewNoMDEntries(val int) NoMDEntriesField {
+	return NoMDEntriesField{quickfix.FIXInt(val)}
+}
+
+func (f NoMDEntriesField) Value() int { return f.Int() }
+
+// NoMsgTypesField is a NUMINGROUP field.
+type NoMsgTypesField struct{ quickfix.FIXInt }
+
+// Tag returns tag.NoMsgTypes (384).
+func (f NoMsgTypesField) Tag() quickfix.Tag { return tag.NoMsgTypes }
+
+// NewNoMsgTypes returns a new NoMsgTypesField initialized with val.
+func NewNoMsgTypes(val int) NoMsgTypesField {
+	return NoMsgTypesField{quickfix.FIXInt(val)}
+}
+
+func (f NoMsgTypesField) Value() int { return f.Int() }
+
+// NoRelatedSymField is a NUMINGROUP field.
+type NoRelatedSymField struct{ quickfix.FIXInt }
+
+// Tag returns tag.NoRelatedSym (146).
+func (f NoRelatedSymField) Tag() quickfix.Tag { return tag.NoRelatedSym }
+
+// NewNoRelatedSym returns a new NoRelatedSymField initialized with val.
+func NewNoRelatedSym(val int) NoRelatedSymField {
+	return NoRelatedSymField{quickfix.FIXInt(val)}
+}
+
+func (f NoRelatedSymField) Value() int { return f.Int() }
+
+// NoSecurityAltIDField is a NUMINGROUP field.
+type NoSecurityAltIDField struct{ quickfix.FIXInt }
+
+// Tag returns tag.NoSecurityAltID (454).
+func (f NoSecurityAltIDField) Tag() quickfix.Tag { return tag.NoSecurityAltID }
+
+// NewNoSecurityAltID returns a new NoSecurityAltIDField initialized with val.
+func NewNoSecurityAltID(val int) NoSecurityAltIDField {
+	return NoSecurityAltIDField{quickfix.FIXInt(val)}
+}
+
+func (f NoSecurityAltIDField) Value() int { return f.Int() }
+
+// NoStipulationsField is a NUMINGROUP field.
+type NoStipulationsField struct{ quickfix.FIXInt }
+
+// Tag returns tag.NoStipulations (232).
+func (f NoStipulationsField) Tag() quickfix.Tag { return tag.NoStipulations }
+
+// NewNoStipulations returns a new NoStipulationsField initialized with val.
+func NewNoStipulations(val int) NoStipulationsField {
+	return NoStipulationsField{quickfix.FIXInt(val)}
+}
+
+func (f NoStipulationsField) Value() int { return f.Int() }
+
+// NoUnderlyingSecurityAltIDField is a NUMINGROUP field.
+type NoUnderlyingSecurityAltIDField struct{ quickfix.FIXInt }
+
+// Tag returns tag.NoUnderlyingSecurityAltID (457).
+func (f NoUnderlyingSecurityAltIDField) Tag() quickfix.Tag { return tag.NoUnderlyingSecurityAltID }
+
+// NewNoUnderlyingSecurityAltID returns a new NoUnderlyingSecurityAltIDField initialized with val.
+func NewNoUnderlyingSecurityAltID(val int) NoUnderlyingSecurityAltIDField {
+	return NoUnderlyingSecurityAltIDField{quickfix.FIXInt(val)}
+}
+
+func (f NoUnderlyingSecurityAltIDField) Value() int { return f.Int() }
+
+// NoUnderlyingStipsField is a NUMINGROUP field.
+type NoUnderlyingStipsField struct{ quickfix.FIXInt }
+
+// Tag returns tag.NoUnderlyingStips (887).
+func (f NoUnderlyingStipsField) Tag() quickfix.Tag { return tag.NoUnderlyingStips }
+
+// NewNoUnderlyingStips returns a new NoUnderlyingStipsField initialized with val.
+func NewNoUnderlyingStips(val int) NoUnderlyingStipsField {
+	return NoUnderlyingStipsField{quickfix.FIXInt(val)}
+}
+
+func (f NoUnderlyingStipsField) Value() int { return f.Int() }
+
+// NoUnderlyingsField is a NUMINGROUP field.
+type NoUnderlyingsField struct{ quickfix.FIXInt }
+
+// Tag returns tag.NoUnderlyings (711).
+func (f NoUnderlyingsField) Tag() quickfix.Tag { return tag.NoUnderlyings }
+
+// NewNoUnderlyings returns a new NoUnderlyingsField initialized with val.
+func NewNoUnderlyings(val int) NoUnderlyingsField {
+	return NoUnderlyingsField{quickfix.FIXInt(val)}
+}
+
+func (f NoUnderlyingsField) Value() int { return f.Int() }
+
+// NumberOfOrdersField is a INT field.
+type NumberOfOrdersField struct{ quickfix.FIXInt }
+
+// Tag returns tag.NumberOfOrders (346).
+func (f NumberOfOrdersField) Tag() quickfix.Tag { return tag.NumberOfOrders }
+
+// NewNumberOfOrders returns a new NumberOfOrdersField initialized with val.
+func NewNumberOfOrders(val int) NumberOfOrdersField {
+	return NumberOfOrdersField{quickfix.FIXInt(val)}
+}
+
+func (f NumberOfOrdersField) Value() int { return f.Int() }
+
+// OnBehalfOfCompIDField is a STRING field.
+type OnBehalfOfCompIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.OnBehalfOfCompID (115).
+func (f OnBehalfOfCompIDField) Tag() quickfix.Tag { return tag.OnBehalfOfCompID }
+
+// NewOnBehalfOfCompID returns a new OnBehalfOfCompIDField initialized with val.
+func NewOnBehalfOfCompID(val string) OnBehalfOfCompIDField {
+	return OnBehalfOfCompIDField{quickfix.FIXString(val)}
+}
+
+func (f OnBehalfOfCompIDField) Value() string { return f.String() }
+
+// OnBehalfOfLocationIDField is a STRING field.
+type OnBehalfOfLocationIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.OnBehalfOfLocationID (144).
+func (f OnBehalfOfLocationIDField) Tag() quickfix.Tag { return tag.OnBehalfOfLocationID }
+
+// NewOnBehalfOfLocationID returns a new OnBehalfOfLocationIDField initialized with val.
+func NewOnBehalfOfLocationID(val string) OnBehalfOfLocationIDField {
+	return OnBehalfOfLocationIDField{quickfix.FIXString(val)}
+}
+
+func (f OnBehalfOfLocationIDField) Value() string { return f.String() }
+
+// OnBehalfOfSubIDField is a STRING field.
+type OnBehalfOfSubIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.OnBehalfOfSubID (116).
+func (f OnBehalfOfSubIDField) Tag() quickfix.Tag { return tag.OnBehalfOfSubID }
+
+// NewOnBehalfOfSubID returns a new OnBehalfOfSubIDField initialized with val.
+func NewOnBehalfOfSubID(val string) OnBehalfOfSubIDField {
+	return OnBehalfOfSubIDField{quickfix.FIXString(val)}
+}
+
+func (f OnBehalfOfSubIDField) Value() string { return f.String() }
+
+// OpenCloseSettlFlagField is a enum.OpenCloseSettlFlag field.
+type OpenCloseSettlFlagField struct{ quickfix.FIXString }
+
+// Tag returns tag.OpenCloseSettlFlag (286).
+func (f OpenCloseSettlFlagField) Tag() quickfix.Tag { return tag.OpenCloseSettlFlag }
+
+func NewOpenCloseSettlFlag(val enum.OpenCloseSettlFlag) OpenCloseSettlFlagField {
+	return OpenCloseSettlFlagField{quickfix.FIXString(val)}
+}
+
+func (f OpenCloseSettlFlagField) Value() enum.OpenCloseSettlFlag {
+	return enum.OpenCloseSettlFlag(f.String())
+}
+
+// OptAttributeField is a CHAR field.
+type OptAttributeField struct{ quickfix.FIXString }
+
+// Tag returns tag.OptAttribute (206).
+func (f OptAttributeField) Tag() quickfix.Tag { return tag.OptAttribute }
+
+// NewOptAttribute returns a new OptAttributeField initialized with val.
+func NewOptAttribute(val string) OptAttributeField {
+	return OptAttributeField{quickfix.FIXString(val)}
+}
+
+func (f OptAttributeField) Value() string { return f.String() }
+
+// OrderIDField is a STRING field.
+type OrderIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.OrderID (37).
+func (f OrderIDField) Tag() quickfix.Tag { return tag.OrderID }
+
+// NewOrderID returns a new OrderIDField initialized with val.
+func NewOrderID(val string) OrderIDField {
+	return OrderIDField{quickfix.FIXString(val)}
+}
+
+func (f OrderIDField) Value() string { return f.String() }
+
+// OrigSendingTimeField is a UTCTIMESTAMP field.
+type OrigSendingTimeField struct{ quickfix.FIXUTCTimestamp }
+
+// Tag returns tag.OrigSendingTime (122).
+func (f OrigSendingTimeField) Tag() quickfix.Tag { return tag.OrigSendingTime }
+
+// NewOrigSendingTime returns a new OrigSendingTimeField initialized with val.
+func NewOrigSendingTime(val time.Time) OrigSendingTimeField {
+	return NewOrigSendingTimeWithPrecision(val, quickfix.Millis)
+}
+
+// NewOrigSendingTimeNoMillis returns a new OrigSendingTimeField initialized with val without millisecs.
+func NewOrigSendingTimeNoMillis(val time.Time) OrigSendingTimeField {
+	return NewOrigSendingTimeWithPrecision(val, quickfix.Seconds)
+}
+
+// NewOrigSendingTimeWithPrecision returns a new OrigSendingTimeField initialized with val of specified precision.
+func NewOrigSendingTimeWithPrecision(val time.Time, precision quickfix.TimestampPrecision) OrigSendingTimeField {
+	return OrigSendingTimeField{quickfix.FIXUTCTimestamp{Time: val, Precision: precision}}
+}
+
+func (f OrigSendingTimeField) Value() time.Time { return f.Time }
+
+// PasswordField is a STRING field.
+type PasswordField struct{ quickfix.FIXString }
+
+// Tag returns tag.Password (554).
+func (f PasswordField) Tag() quickfix.Tag { return tag.Password }
+
+// NewPassword returns a new PasswordField initialized with val.
+func NewPassword(val string) PasswordField {
+	return PasswordField{quickfix.FIXString(val)}
+}
+
+func (f PasswordField) Value() string { return f.String() }
+
+// PctAtRiskField is a PERCENTAGE field.
+type PctAtRiskField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.PctAtRisk (869).
+func (f PctAtRiskField) Tag() quickfix.Tag { return tag.PctAtRisk }
+
+// NewPctAtRisk returns a new PctAtRiskField initialized with val and scale.
+func NewPctAtRisk(val decimal.Decimal, scale int32) PctAtRiskField {
+	return PctAtRiskField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f PctAtRiskField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// PoolField is a STRING field.
+type PoolField struct{ quickfix.FIXString }
+
+// Tag returns tag.Pool (691).
+func (f PoolField) Tag() quickfix.Tag { return tag.Pool }
+
+// NewPool returns a new PoolField initialized with val.
+func NewPool(val string) PoolField {
+	return PoolField{quickfix.FIXString(val)}
+}
+
+func (f PoolField) Value() string { return f.String() }
+
+// PossDupFlagField is a BOOLEAN field.
+type PossDupFlagField struct{ quickfix.FIXBoolean }
+
+// Tag returns tag.PossDupFlag (43).
+func (f PossDupFlagField) Tag() quickfix.Tag { return tag.PossDupFlag }
+
+// NewPossDupFlag returns a new PossDupFlagField initialized with val.
+func NewPossDupFlag(val bool) PossDupFlagField {
+	return PossDupFlagField{quickfix.FIXBoolean(val)}
+}
+
+func (f PossDupFlagField) Value() bool { return f.Bool() }
+
+// PossResendField is a BOOLEAN field.
+type PossResendField struct{ quickfix.FIXBoolean }
+
+// Tag returns tag.PossResend (97).
+func (f PossResendField) Tag() quickfix.Tag { return tag.PossResend }
+
+// NewPossResend returns a new PossResendField initialized with val.
+func NewPossResend(val bool) PossResendField {
+	return PossResendField{quickfix.FIXBoolean(val)}
+}
+
+func (f PossResendField) Value() bool { return f.Bool() }
+
+// PriceDeltaField is a FLOAT field.
+type PriceDeltaField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.PriceDelta (811).
+func (f PriceDeltaField) Tag() quickfix.Tag { return tag.PriceDelta }
+
+// NewPriceDelta returns a new PriceDeltaField initialized with val and scale.
+func NewPriceDelta(val decimal.Decimal, scale int32) PriceDeltaField {
+	return PriceDeltaField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f PriceDeltaField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// ProductField is a enum.Product field.
+type ProductField struct{ quickfix.FIXString }
+
+// Tag returns tag.Product (460).
+func (f ProductField) Tag() quickfix.Tag { return tag.Product }
+
+func NewProduct(val enum.Product) ProductField {
+	return ProductField{quickfix.FIXString(val)}
+}
+
+func (f ProductField) Value() enum.Product { return enum.Product(f.String()) }
+
+// QuoteConditionField is a enum.QuoteCondition field.
+type QuoteConditionField struct{ quickfix.FIXString }
+
+// Tag returns tag.QuoteCondition (276).
+func (f QuoteConditionField) Tag() quickfix.Tag { return tag.QuoteCondition }
+
+func NewQuoteCondition(val enum.QuoteCondition) QuoteConditionField {
+	return QuoteConditionField{quickfix.FIXString(val)}
+}
+
+func (f QuoteConditionField) Value() enum.QuoteCondition { return enum.QuoteCondition(f.String()) }
+
+// QuoteEntryIDField is a STRING field.
+type QuoteEntryIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.QuoteEntryID (299).
+func (f QuoteEntryIDField) Tag() quickfix.Tag { return tag.QuoteEntryID }
+
+// NewQuoteEntryID returns a new QuoteEntryIDField initialized with val.
+func NewQuoteEntryID(val string) QuoteEntryIDField {
+	return QuoteEntryIDField{quickfix.FIXString(val)}
+}
+
+func (f QuoteEntryIDField) Value() string { return f.String() }
+
+// RawDataField is a DATA field.
+type RawDataField struct{ quickfix.FIXString }
+
+// Tag returns tag.RawData (96).
+func (f RawDataField) Tag() quickfix.Tag { return tag.RawData }
+
+// NewRawData returns a new RawDataField initialized with val.
+func NewRawData(val string) RawDataField {
+	return RawDataField{quickfix.FIXString(val)}
+}
+
+func (f RawDataField) Value() string { return f.String() }
+
+// RawDataLengthField is a LENGTH field.
+type RawDataLengthField struct{ quickfix.FIXInt }
+
+// Tag returns tag.RawDataLength (95).
+func (f RawDataLengthField) Tag() quickfix.Tag { return tag.RawDataLength }
+
+// NewRawDataLength returns a new RawDataLengthField initialized with val.
+func NewRawDataLength(val int) RawDataLengthField {
+	return RawDataLengthField{quickfix.FIXInt(val)}
+}
+
+func (f RawDataLengthField) Value() int { return f.Int() }
+
+// RedemptionDateField is a LOCALMKTDATE field.
+type RedemptionDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.RedemptionDate (240).
+func (f RedemptionDateField) Tag() quickfix.Tag { return tag.RedemptionDate }
+
+// NewRedemptionDate returns a new RedemptionDateField initialized with val.
+func NewRedemptionDate(val string) RedemptionDateField {
+	return RedemptionDateField{quickfix.FIXString(val)}
+}
+
+func (f RedemptionDateField) Value() string { return f.String() }
+
+// RefMsgTypeField is a STRING field.
+type RefMsgTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.RefMsgType (372).
+func (f RefMsgTypeField) Tag() quickfix.Tag { return tag.RefMsgType }
+
+// NewRefMsgType returns a new RefMsgTypeField initialized with val.
+func NewRefMsgType(val string) RefMsgTypeField {
+	return RefMsgTypeField{quickfix.FIXString(val)}
+}
+
+func (f RefMsgTypeField) Value() string { return f.String() }
+
+// RepoCollateralSecurityTypeField is a INT field.
+type RepoCollateralSecurityTypeField struct{ quickfix.FIXInt }
+
+// Tag returns tag.RepoCollateralSecurityType (239).
+func (f RepoCollateralSecurityTypeField) Tag() quickfix.Tag { return tag.RepoCollateralSecurityType }
+
+// NewRepoCollateralSecurityType returns a new RepoCollateralSecurityTypeField initialized with val.
+func NewRepoCollateralSecurityType(val int) RepoCollateralSecurityTypeField {
+	return RepoCollateralSecurityTypeField{quickfix.FIXInt(val)}
+}
+
+func (f RepoCollateralSecurityTypeField) Value() int { return f.Int() }
+
+// RepurchaseRateField is a PERCENTAGE field.
+type RepurchaseRateField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.RepurchaseRate (227).
+func (f RepurchaseRateField) Tag() quickfix.Tag { return tag.RepurchaseRate }
+
+// NewRepurchaseRate returns a new RepurchaseRateField initialized with val and scale.
+func NewRepurchaseRate(val decimal.Decimal, scale int32) RepurchaseRateField {
+	return RepurchaseRateField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f RepurchaseRateField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// RepurchaseTermField is a INT field.
+type RepurchaseTermField struct{ quickfix.FIXInt }
+
+// Tag returns tag.RepurchaseTerm (226).
+func (f RepurchaseTermField) Tag() quickfix.Tag { return tag.RepurchaseTerm }
+
+// NewRepurchaseTerm returns a new RepurchaseTermField initialized with val.
+func NewRepurchaseTerm(val int) RepurchaseTermField {
+	return RepurchaseTermField{quickfix.FIXInt(val)}
+}
+
+func (f RepurchaseTermField) Value() int { return f.Int() }
+
+// ResetSeqNumFlagField is a BOOLEAN field.
+type ResetSeqNumFlagField struct{ quickfix.FIXBoolean }
+
+// Tag returns tag.ResetSeqNumFlag (141).
+func (f ResetSeqNumFlagField) Tag() quickfix.Tag { return tag.ResetSeqNumFlag }
+
+// NewResetSeqNumFlag returns a new ResetSeqNumFlagField initialized with val.
+func NewResetSeqNumFlag(val bool) ResetSeqNumFlagField {
+	return ResetSeqNumFlagField{quickfix.FIXBoolean(val)}
+}
+
+func (f ResetSeqNumFlagField) Value() bool { return f.Bool() }
+
+// RoundLotField is a QTY field.
+type RoundLotField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.RoundLot (561).
+func (f RoundLotField) Tag() quickfix.Tag { return tag.RoundLot }
+
+// NewRoundLot returns a new RoundLotField initialized with val and scale.
+func NewRoundLot(val decimal.Decimal, scale int32) RoundLotField {
+	return RoundLotField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f RoundLotField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// RptSeqField is a INT field.
+type RptSeqField struct{ quickfix.FIXInt }
+
+// Tag returns tag.RptSeq (83).
+func (f RptSeqField) Tag() quickfix.Tag { return tag.RptSeq }
+
+// NewRptSeq returns a new RptSeqField initialized with val.
+func NewRptSeq(val int) RptSeqField {
+	return RptSeqField{quickfix.FIXInt(val)}
+}
+
+func (f RptSeqField) Value() int { return f.Int() }
+
+// ScopeField is a enum.Scope field.
+type ScopeField struct{ quickfix.FIXString }
+
+// Tag returns tag.Scope (546).
+func (f ScopeField) Tag() quickfix.Tag { return tag.Scope }
+
+func NewScope(val enum.Scope) ScopeField {
+	return ScopeField{quickfix.FIXString(val)}
+}
+
+func (f ScopeField) Value() enum.Scope { return enum.Scope(f.String()) }
+
+// SecureDataField is a DATA field.
+type SecureDataField struct{ quickfix.FIXString }
+
+// Tag returns tag.SecureData (91).
+func (f SecureDataField) Tag() quickfix.Tag { return tag.SecureData }
+
+// NewSecureData returns a new SecureDataField initialized with val.
+func NewSecureData(val string) SecureDataField {
+	return SecureDataField{quickfix.FIXString(val)}
+}
+
+func (f SecureDataField) Value() string { return f.String() }
+
+// SecureDataLenField is a LENGTH field.
+type SecureDataLenField struct{ quickfix.FIXInt }
+
+// Tag returns tag.SecureDataLen (90).
+func (f SecureDataLenField) Tag() quickfix.Tag { return tag.SecureDataLen }
+
+// NewSecureDataLen returns a new SecureDataLenField initialized with val.
+func NewSecureDataLen(val int) SecureDataLenField {
+	return SecureDataLenField{quickfix.FIXInt(val)}
+}
+
+func (f SecureDataLenField) Value() int { return f.Int() }
+
+// SecurityAltIDField is a STRING field.
+type SecurityAltIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.SecurityAltID (455).
+func (f SecurityAltIDField) Tag() quickfix.Tag { return tag.SecurityAltID }
+
+// NewSecurityAltID returns a new SecurityAltIDField initialized with val.
+func NewSecurityAltID(val string) SecurityAltIDField {
+	return SecurityAltIDField{quickfix.FIXString(val)}
+}
+
+func (f SecurityAltIDField) Value() string { return f.String() }
+
+// SecurityAltIDSourceField is a STRING field.
+type SecurityAltIDSourceField struct{ quickfix.FIXString }
+
+// Tag returns tag.SecurityAltIDSource (456).
+func (f SecurityAltIDSourceField) Tag() quickfix.Tag { return tag.SecurityAltIDSource }
+
+// NewSecurityAltIDSource returns a new SecurityAltIDSourceField initialized with val.
+func NewSecurityAltIDSource(val string) SecurityAltIDSourceField {
+	return SecurityAltIDSourceField{quickfix.FIXString(val)}
+}
+
+func (f SecurityAltIDSourceField) Value() string { return f.String() }
+
+// SecurityDescField is a STRING field.
+type SecurityDescField struct{ quickfix.FIXString }
+
+// Tag returns tag.SecurityDesc (107).
+func (f SecurityDescField) Tag() quickfix.Tag { return tag.SecurityDesc }
+
+// NewSecurityDesc returns a new SecurityDescField initialized with val.
+func NewSecurityDesc(val string) SecurityDescField {
+	return SecurityDescField{quickfix.FIXString(val)}
+}
+
+func (f SecurityDescField) Value() string { return f.String() }
+
+// SecurityExchangeField is a EXCHANGE field.
+type SecurityExchangeField struct{ quickfix.FIXString }
+
+// Tag returns tag.SecurityExchange (207).
+func (f SecurityExchangeField) Tag() quickfix.Tag { return tag.SecurityExchange }
+
+// NewSecurityExchange returns a new SecurityExchangeField initialized with val.
+func NewSecurityExchange(val string) SecurityExchangeField {
+	return SecurityExchangeField{quickfix.FIXString(val)}
+}
+
+func (f SecurityExchangeField) Value() string { return f.String() }
+
+// SecurityIDField is a STRING field.
+type SecurityIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.SecurityID (48).
+func (f SecurityIDField) Tag() quickfix.Tag { return tag.SecurityID }
+
+// NewSecurityID returns a new SecurityIDField initialized with val.
+func NewSecurityID(val string) SecurityIDField {
+	return SecurityIDField{quickfix.FIXString(val)}
+}
+
+func (f SecurityIDField) Value() string { return f.String() }
+
+// SecurityIDSourceField is a enum.SecurityIDSource field.
+type SecurityIDSourceField struct{ quickfix.FIXString }
+
+// Tag returns tag.SecurityIDSource (22).
+func (f SecurityIDSourceField) Tag() quickfix.Tag { return tag.SecurityIDSource }
+
+func NewSecurityIDSource(val enum.SecurityIDSource) SecurityIDSourceField {
+	return SecurityIDSourceField{quickfix.FIXString(val)}
+}
+
+func (f SecurityIDSourceField) Value() enum.SecurityIDSource {
+	return enum.SecurityIDSource(f.String())
+}
+
+// SecurityReqIDField is a STRING field.
+type SecurityReqIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.SecurityReqID (320).
+func (f SecurityReqIDField) Tag() quickfix.Tag { return tag.SecurityReqID }
+
+// NewSecurityReqID returns a new SecurityReqIDField initialized with val.
+func NewSecurityReqID(val string) SecurityReqIDField {
+	return SecurityReqIDField{quickfix.FIXString(val)}
+}
+
+func (f SecurityReqIDField) Value() string { return f.String() }
+
+// SecurityRequestResultField is a enum.SecurityRequestResult field.
+type SecurityRequestResultField struct{ quickfix.FIXString }
+
+// Tag returns tag.SecurityRequestResult (560).
+func (f SecurityRequestResultField) Tag() quickfix.Tag { return tag.SecurityRequestResult }
+
+func NewSecurityRequestResult(val enum.SecurityRequestResult) SecurityRequestResultField {
+	return SecurityRequestResultField{quickfix.FIXString(val)}
+}
+
+func (f SecurityRequestResultField) Value() enum.SecurityRequestResult {
+	return enum.SecurityRequestResult(f.String())
+}
+
+// SecurityResponseIDField is a STRING field.
+type SecurityResponseIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.SecurityResponseID (322).
+func (f SecurityResponseIDField) Tag() quickfix.Tag { return tag.SecurityResponseID }
+
+// NewSecurityResponseID returns a new SecurityResponseIDField initialized with val.
+func NewSecurityResponseID(val string) SecurityResponseIDField {
+	return SecurityResponseIDField{quickfix.FIXString(val)}
+}
+
+func (f SecurityResponseIDField) Value() string { return f.String() }
+
+// SecuritySubTypeField is a STRING field.
+type SecuritySubTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.SecuritySubType (762).
+func (f SecuritySubTypeField) Tag() quickfix.Tag { return tag.SecuritySubType }
+
+// NewSecuritySubType returns a new SecuritySubTypeField initialized with val.
+func NewSecuritySubType(val string) SecuritySubTypeField {
+	return SecuritySubTypeField{quickfix.FIXString(val)}
+}
+
+func (f SecuritySubTypeField) Value() string { return f.String() }
+
+// SecurityTypeField is a enum.SecurityType field.
+type SecurityTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.SecurityType (167).
+func (f SecurityTypeField) Tag() quickfix.Tag { return tag.SecurityType }
+
+func NewSecurityType(val enum.SecurityType) SecurityTypeField {
+	return SecurityTypeField{quickfix.FIXString(val)}
+}
+
+func (f SecurityTypeField) Value() enum.SecurityType { return enum.SecurityType(f.String()) }
+
+// SellerDaysField is a INT field.
+type SellerDaysField struct{ quickfix.FIXInt }
+
+// Tag returns tag.SellerDays (287).
+func (f SellerDaysField) Tag() quickfix.Tag { return tag.SellerDays }
+
+// NewSellerDays returns a new SellerDaysField initialized with val.
+func NewSellerDays(val int) SellerDaysField {
+	return SellerDaysField{quickfix.FIXInt(val)}
+}
+
+func (f SellerDaysField) Value() int { return f.Int() }
+
+// SenderCompIDField is a STRING field.
+type SenderCompIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.SenderCompID (49).
+func (f SenderCompIDField) Tag() quickfix.Tag { return tag.SenderCompID }
+
+// NewSenderCompID returns a new SenderCompIDField initialized with val.
+func NewSenderCompID(val string) SenderCompIDField {
+	return SenderCompIDField{quickfix.FIXString(val)}
+}
+
+func (f SenderCompIDField) Value() string { return f.String() }
+
+// SenderLocationIDField is a STRING field.
+type SenderLocationIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.SenderLocationID (142).
+func (f SenderLocationIDField) Tag() quickfix.Tag { return tag.SenderLocationID }
+
+// NewSenderLocationID returns a new SenderLocationIDField initialized with val.
+func NewSenderLocationID(val string) SenderLocationIDField {
+	return SenderLocationIDField{quickfix.FIXString(val)}
+}
+
+func (f SenderLocationIDField) Value() string { return f.String() }
+
+// SenderSubIDField is a STRING field.
+type SenderSubIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.SenderSubID (50).
+func (f SenderSubIDField) Tag() quickfix.Tag { return tag.SenderSubID }
+
+// NewSenderSubID returns a new SenderSubIDField initialized with val.
+func NewSenderSubID(val string) SenderSubIDField {
+	return SenderSubIDField{quickfix.FIXString(val)}
+}
+
+func (f SenderSubIDField) Value() string { return f.String() }
+
+// SendingTimeField is a UTCTIMESTAMP field.
+type SendingTimeField struct{ quickfix.FIXUTCTimestamp }
+
+// Tag returns tag.SendingTime (52).
+func (f SendingTimeField) Tag() quickfix.Tag { return tag.SendingTime }
+
+// NewSendingTime returns a new SendingTimeField initialized with val.
+func NewSendingTime(val time.Time) SendingTimeField {
+	return NewSendingTimeWithPrecision(val, quickfix.Millis)
+}
+
+// NewSendingTimeNoMillis returns a new SendingTimeField initialized with val without millisecs.
+func NewSendingTimeNoMillis(val time.Time) SendingTimeField {
+	return NewSendingTimeWithPrecision(val, quickfix.Seconds)
+}
+
+// NewSendingTimeWithPrecision returns a new SendingTimeField initialized with val of specified precision.
+func NewSendingTimeWithPrecision(val time.Time, precision quickfix.TimestampPrecision) SendingTimeField {
+	return SendingTimeField{quickfix.FIXUTCTimestamp{Time: val, Precision: precision}}
+}
+
+func (f SendingTimeField) Value() time.Time { return f.Time }
+
+// SignatureField is a DATA field.
+type SignatureField struct{ quickfix.FIXString }
+
+// Tag returns tag.Signature (89).
+func (f SignatureField) Tag() quickfix.Tag { return tag.Signature }
+
+// NewSignature returns a new SignatureField initialized with val.
+func NewSignature(val string) SignatureField {
+	return SignatureField{quickfix.FIXString(val)}
+}
+
+func (f SignatureField) Value() string { return f.String() }
+
+// SignatureLengthField is a LENGTH field.
+type SignatureLengthField struct{ quickfix.FIXInt }
+
+// Tag returns tag.SignatureLength (93).
+func (f SignatureLengthField) Tag() quickfix.Tag { return tag.SignatureLength }
+
+// NewSignatureLength returns a new SignatureLengthField initialized with val.
+func NewSignatureLength(val int) SignatureLengthField {
+	return SignatureLengthField{quickfix.FIXInt(val)}
+}
+
+func (f SignatureLengthField) Value() int { return f.Int() }
+
+// SpreadField is a PRICEOFFSET field.
+type SpreadField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.Spread (218).
+func (f SpreadField) Tag() quickfix.Tag { return tag.Spread }
+
+// NewSpread returns a new SpreadField initialized with val and scale.
+func NewSpread(val decimal.Decimal, scale int32) SpreadField {
+	return SpreadField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f SpreadField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// StartDateField is a LOCALMKTDATE field.
+type StartDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.StartDate (916).
+func (f StartDateField) Tag() quickfix.Tag { return tag.StartDate }
+
+// NewStartDate returns a new StartDateField initialized with val.
+func NewStartDate(val string) StartDateField {
+	return StartDateField{quickfix.FIXString(val)}
+}
+
+func (f StartDateField) Value() string { return f.String() }
+
+// StateOrProvinceOfIssueField is a STRING field.
+type StateOrProvinceOfIssueField struct{ quickfix.FIXString }
+
+// Tag returns tag.StateOrProvinceOfIssue (471).
+func (f StateOrProvinceOfIssueField) Tag() quickfix.Tag { return tag.StateOrProvinceOfIssue }
+
+// NewStateOrProvinceOfIssue returns a new StateOrProvinceOfIssueField initialized with val.
+func NewStateOrProvinceOfIssue(val string) StateOrProvinceOfIssueField {
+	return StateOrProvinceOfIssueField{quickfix.FIXString(val)}
+}
+
+func (f StateOrProvinceOfIssueField) Value() string { return f.String() }
+
+// StipulationTypeField is a enum.StipulationType field.
+type StipulationTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.StipulationType (233).
+func (f StipulationTypeField) Tag() quickfix.Tag { return tag.StipulationType }
+
+func NewStipulationType(val enum.StipulationType) StipulationTypeField {
+	return StipulationTypeField{quickfix.FIXString(val)}
+}
+
+func (f StipulationTypeField) Value() enum.StipulationType { return enum.StipulationType(f.String()) }
+
+// StipulationValueField is a STRING field.
+type StipulationValueField struct{ quickfix.FIXString }
+
+// Tag returns tag.StipulationValue (234).
+func (f StipulationValueField) Tag() quickfix.Tag { return tag.StipulationValue }
+
+// NewStipulationValue returns a new StipulationValueField initialized with val.
+func NewStipulationValue(val string) StipulationValueField {
+	return StipulationValueField{quickfix.FIXString(val)}
+}
+
+func (f StipulationValueField) Value() string { return f.String() }
+
+// StrikeCurrencyField is a CURRENCY field.
+type StrikeCurrencyField struct{ quickfix.FIXString }
+
+// Tag returns tag.StrikeCurrency (947).
+func (f StrikeCurrencyField) Tag() quickfix.Tag { return tag.StrikeCurrency }
+
+// NewStrikeCurrency returns a new StrikeCurrencyField initialized with val.
+func NewStrikeCurrency(val string) StrikeCurrencyField {
+	return StrikeCurrencyField{quickfix.FIXString(val)}
+}
+
+func (f StrikeCurrencyField) Value() string { return f.String() }
+
+// StrikePriceField is a PRICE field.
+type StrikePriceField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.StrikePrice (202).
+func (f StrikePriceField) Tag() quickfix.Tag { return tag.StrikePrice }
+
+// NewStrikePrice returns a new StrikePriceField initialized with val and scale.
+func NewStrikePrice(val decimal.Decimal, scale int32) StrikePriceField {
+	return StrikePriceField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f StrikePriceField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// SymbolField is a STRING field.
+type SymbolField struct{ quickfix.FIXString }
+
+// Tag returns tag.Symbol (55).
+func (f SymbolField) Tag() quickfix.Tag { return tag.Symbol }
+
+// NewSymbol returns a new SymbolField initialized with val.
+func NewSymbol(val string) SymbolField {
+	return SymbolField{quickfix.FIXString(val)}
+}
+
+func (f SymbolField) Value() string { return f.String() }
+
+// SymbolSfxField is a enum.SymbolSfx field.
+type SymbolSfxField struct{ quickfix.FIXString }
+
+// Tag returns tag.SymbolSfx (65).
+func (f SymbolSfxField) Tag() quickfix.Tag { return tag.SymbolSfx }
+
+func NewSymbolSfx(val enum.SymbolSfx) SymbolSfxField {
+	return SymbolSfxField{quickfix.FIXString(val)}
+}
+
+func (f SymbolSfxField) Value() enum.SymbolSfx { return enum.SymbolSfx(f.String()) }
+
+// TargetCompIDField is a STRING field.
+type TargetCompIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.TargetCompID (56).
+func (f TargetCompIDField) Tag() quickfix.Tag { return tag.TargetCompID }
+
+// NewTargetCompID returns a new TargetCompIDField initialized with val.
+func NewTargetCompID(val string) TargetCompIDField {
+	return TargetCompIDField{quickfix.FIXString(val)}
+}
+
+func (f TargetCompIDField) Value() string { return f.String() }
+
+// TargetLocationIDField is a STRING field.
+type TargetLocationIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.TargetLocationID (143).
+func (f TargetLocationIDField) Tag() quickfix.Tag { return tag.TargetLocationID }
+
+// NewTargetLocationID returns a new TargetLocationIDField initialized with val.
+func NewTargetLocationID(val string) TargetLocationIDField {
+	return TargetLocationIDField{quickfix.FIXString(val)}
+}
+
+func (f TargetLocationIDField) Value() string { return f.String() }
+
+// TargetSubIDField is a STRING field.
+type TargetSubIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.TargetSubID (57).
+func (f TargetSubIDField) Tag() quickfix.Tag { return tag.TargetSubID }
+
+// NewTargetSubID returns a new TargetSubIDField initialized with val.
+func NewTargetSubID(val string) TargetSubIDField {
+	return TargetSubIDField{quickfix.FIXString(val)}
+}
+
+func (f TargetSubIDField) Value() string { return f.String() }
+
+// TerminationTypeField is a enum.TerminationType field.
+type TerminationTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.TerminationType (788).
+func (f TerminationTypeField) Tag() quickfix.Tag { return tag.TerminationType }
+
+func NewTerminationType(val enum.TerminationType) TerminationTypeField {
+	return TerminationTypeField{quickfix.FIXString(val)}
+}
+
+func (f TerminationTypeField) Value() enum.TerminationType { return enum.TerminationType(f.String()) }
+
+// TestMessageIndicatorField is a BOOLEAN field.
+type TestMessageIndicatorField struct{ quickfix.FIXBoolean }
+
+// Tag returns tag.TestMessageIndicator (464).
+func (f TestMessageIndicatorField) Tag() quickfix.Tag { return tag.TestMessageIndicator }
+
+// NewTestMessageIndicator returns a new TestMessageIndicatorField initialized with val.
+func NewTestMessageIndicator(val bool) TestMessageIndicatorField {
+	return TestMessageIndicatorField{quickfix.FIXBoolean(val)}
+}
+
+func (f TestMessageIndicatorField) Value() bool { return f.Bool() }
+
+// TextField is a STRING field.
+type TextField struct{ quickfix.FIXString }
+
+// Tag returns tag.Text (58).
+func (f TextField) Tag() quickfix.Tag { return tag.Text }
+
+// NewText returns a new TextField initialized with val.
+func NewText(val string) TextField {
+	return TextField{quickfix.FIXString(val)}
+}
+
+func (f TextField) Value() string { return f.String() }
+
+// TickDirectionField is a enum.TickDirection field.
+type TickDirectionField struct{ quickfix.FIXString }
+
+// Tag returns tag.TickDirection (274).
+func (f TickDirectionField) Tag() quickfix.Tag { return tag.TickDirection }
+
+func NewTickDirection(val enum.TickDirection) TickDirectionField {
+	return TickDirectionField{quickfix.FIXString(val)}
+}
+
+func (f TickDirectionField) Value() enum.TickDirection { return enum.TickDirection(f.String()) }
+
+// TimeInForceField is a enum.TimeInForce field.
+type TimeInForceField struct{ quickfix.FIXString }
+
+// Tag returns tag.TimeInForce (59).
+func (f TimeInForceField) Tag() quickfix.Tag { return tag.TimeInForce }
+
+func NewTimeInForce(val enum.TimeInForce) TimeInForceField {
+	return TimeInForceField{quickfix.FIXString(val)}
+}
+
+func (f TimeInForceField) Value() enum.TimeInForce { return enum.TimeInForce(f.String()) }
+
+// TotNoRelatedSymField is a INT field.
+type TotNoRelatedSymField struct{ quickfix.FIXInt }
+
+// Tag returns tag.TotNoRelatedSym (393).
+func (f TotNoRelatedSymField) Tag() quickfix.Tag { return tag.TotNoRelatedSym }
+
+// NewTotNoRelatedSym returns a new TotNoRelatedSymField initialized with val.
+func NewTotNoRelatedSym(val int) TotNoRelatedSymField {
+	return TotNoRelatedSymField{quickfix.FIXInt(val)}
+}
+
+func (f TotNoRelatedSymField) Value() int { return f.Int() }
+
+// TradeConditionField is a enum.TradeCondition field.
+type TradeConditionField struct{ quickfix.FIXString }
+
+// Tag returns tag.TradeCondition (277).
+func (f TradeConditionField) Tag() quickfix.Tag { return tag.TradeCondition }
+
+func NewTradeCondition(val enum.TradeCondition) TradeConditionField {
+	return TradeConditionField{quickfix.FIXString(val)}
+}
+
+func (f TradeConditionField) Value() enum.TradeCondition { return enum.TradeCondition(f.String()) }
+
+// TradingSessionIDField is a STRING field.
+type TradingSessionIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.TradingSessionID (336).
+func (f TradingSessionIDField) Tag() quickfix.Tag { return tag.TradingSessionID }
+
+// NewTradingSessionID returns a new TradingSessionIDField initialized with val.
+func NewTradingSessionID(val string) TradingSessionIDField {
+	return TradingSessionIDField{quickfix.FIXString(val)}
+}
+
+func (f TradingSessionIDField) Value() string { return f.String() }
+
+// TradingSessionSubIDField is a STRING field.
+type TradingSessionSubIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.TradingSessionSubID (625).
+func (f TradingSessionSubIDField) Tag() quickfix.Tag { return tag.TradingSessionSubID }
+
+// NewTradingSessionSubID returns a new TradingSessionSubIDField initialized with val.
+func NewTradingSessionSubID(val string) TradingSessionSubIDField {
+	return TradingSessionSubIDField{quickfix.FIXString(val)}
+}
+
+func (f TradingSessionSubIDField) Value() string { return f.String() }
+
+// UnderlyingCFICodeField is a STRING field.
+type UnderlyingCFICodeField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingCFICode (463).
+func (f UnderlyingCFICodeField) Tag() quickfix.Tag { return tag.UnderlyingCFICode }
+
+// NewUnderlyingCFICode returns a new UnderlyingCFICodeField initialized with val.
+func NewUnderlyingCFICode(val string) UnderlyingCFICodeField {
+	return UnderlyingCFICodeField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingCFICodeField) Value() string { return f.String() }
+
+// UnderlyingCPProgramField is a STRING field.
+type UnderlyingCPProgramField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingCPProgram (877).
+func (f UnderlyingCPProgramField) Tag() quickfix.Tag { return tag.UnderlyingCPProgram }
+
+// NewUnderlyingCPProgram returns a new UnderlyingCPProgramField initialized with val.
+func NewUnderlyingCPProgram(val string) UnderlyingCPProgramField {
+	return UnderlyingCPProgramField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingCPProgramField) Value() string { return f.String() }
+
+// UnderlyingCPRegTypeField is a STRING field.
+type UnderlyingCPRegTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingCPRegType (878).
+func (f UnderlyingCPRegTypeField) Tag() quickfix.Tag { return tag.UnderlyingCPRegType }
+
+// NewUnderlyingCPRegType returns a new UnderlyingCPRegTypeField initialized with val.
+func NewUnderlyingCPRegType(val string) UnderlyingCPRegTypeField {
+	return UnderlyingCPRegTypeField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingCPRegTypeField) Value() string { return f.String() }
+
+// UnderlyingContractMultiplierField is a FLOAT field.
+type UnderlyingContractMultiplierField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.UnderlyingContractMultiplier (436).
+func (f UnderlyingContractMultiplierField) Tag() quickfix.Tag {
+	return tag.UnderlyingContractMultiplier
+}
+
+// NewUnderlyingContractMultiplier returns a new UnderlyingContractMultiplierField initialized with val and scale.
+func NewUnderlyingContractMultiplier(val decimal.Decimal, scale int32) UnderlyingContractMultiplierField {
+	return UnderlyingContractMultiplierField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f UnderlyingContractMultiplierField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// UnderlyingCountryOfIssueField is a COUNTRY field.
+type UnderlyingCountryOfIssueField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingCountryOfIssue (592).
+func (f UnderlyingCountryOfIssueField) Tag() quickfix.Tag { return tag.UnderlyingCountryOfIssue }
+
+// NewUnderlyingCountryOfIssue returns a new UnderlyingCountryOfIssueField initialized with val.
+func NewUnderlyingCountryOfIssue(val string) UnderlyingCountryOfIssueField {
+	return UnderlyingCountryOfIssueField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingCountryOfIssueField) Value() string { return f.String() }
+
+// UnderlyingCouponPaymentDateField is a LOCALMKTDATE field.
+type UnderlyingCouponPaymentDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingCouponPaymentDate (241).
+func (f UnderlyingCouponPaymentDateField) Tag() quickfix.Tag { return tag.UnderlyingCouponPaymentDate }
+
+// NewUnderlyingCouponPaymentDate returns a new UnderlyingCouponPaymentDateField initialized with val.
+func NewUnderlyingCouponPaymentDate(val string) UnderlyingCouponPaymentDateField {
+	return UnderlyingCouponPaymentDateField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingCouponPaymentDateField) Value() string { return f.String() }
+
+// UnderlyingCouponRateField is a PERCENTAGE field.
+type UnderlyingCouponRateField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.UnderlyingCouponRate (435).
+func (f UnderlyingCouponRateField) Tag() quickfix.Tag { return tag.UnderlyingCouponRate }
+
+// NewUnderlyingCouponRate returns a new UnderlyingCouponRateField initialized with val and scale.
+func NewUnderlyingCouponRate(val decimal.Decimal, scale int32) UnderlyingCouponRateField {
+	return UnderlyingCouponRateField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f UnderlyingCouponRateField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// UnderlyingCreditRatingField is a STRING field.
+type UnderlyingCreditRatingField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingCreditRating (256).
+func (f UnderlyingCreditRatingField) Tag() quickfix.Tag { return tag.UnderlyingCreditRating }
+
+// NewUnderlyingCreditRating returns a new UnderlyingCreditRatingField initialized with val.
+func NewUnderlyingCreditRating(val string) UnderlyingCreditRatingField {
+	return UnderlyingCreditRatingField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingCreditRatingField) Value() string { return f.String() }
+
+// UnderlyingCurrencyField is a CURRENCY field.
+type UnderlyingCurrencyField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingCurrency (318).
+func (f UnderlyingCurrencyField) Tag() quickfix.Tag { return tag.UnderlyingCurrency }
+
+// NewUnderlyingCurrency returns a new UnderlyingCurrencyField initialized with val.
+func NewUnderlyingCurrency(val string) UnderlyingCurrencyField {
+	return UnderlyingCurrencyField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingCurrencyField) Value() string { return f.String() }
+
+// UnderlyingCurrentValueField is a AMT field.
+type UnderlyingCurrentValueField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.UnderlyingCurrentValue (885).
+func (f UnderlyingCurrentValueField) Tag() quickfix.Tag { return tag.UnderlyingCurrentValue }
+
+// NewUnderlyingCurrentValue returns a new UnderlyingCurrentValueField initialized with val and scale.
+func NewUnderlyingCurrentValue(val decimal.Decimal, scale int32) UnderlyingCurrentValueField {
+	return UnderlyingCurrentValueField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f UnderlyingCurrentValueField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// UnderlyingDirtyPriceField is a PRICE field.
+type UnderlyingDirtyPriceField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.UnderlyingDirtyPrice (882).
+func (f UnderlyingDirtyPriceField) Tag() quickfix.Tag { return tag.UnderlyingDirtyPrice }
+
+// NewUnderlyingDirtyPrice returns a new UnderlyingDirtyPriceField initialized with val and scale.
+func NewUnderlyingDirtyPrice(val decimal.Decimal, scale int32) UnderlyingDirtyPriceField {
+	return UnderlyingDirtyPriceField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f UnderlyingDirtyPriceField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// UnderlyingEndPriceField is a PRICE field.
+type UnderlyingEndPriceField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.UnderlyingEndPrice (883).
+func (f UnderlyingEndPriceField) Tag() quickfix.Tag { return tag.UnderlyingEndPrice }
+
+// NewUnderlyingEndPrice returns a new UnderlyingEndPriceField initialized with val and scale.
+func NewUnderlyingEndPrice(val decimal.Decimal, scale int32) UnderlyingEndPriceField {
+	return UnderlyingEndPriceField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f UnderlyingEndPriceField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// UnderlyingEndValueField is a AMT field.
+type UnderlyingEndValueField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.UnderlyingEndValue (886).
+func (f UnderlyingEndValueField) Tag() quickfix.Tag { return tag.UnderlyingEndValue }
+
+// NewUnderlyingEndValue returns a new UnderlyingEndValueField initialized with val and scale.
+func NewUnderlyingEndValue(val decimal.Decimal, scale int32) UnderlyingEndValueField {
+	return UnderlyingEndValueField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f UnderlyingEndValueField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// UnderlyingFactorField is a FLOAT field.
+type UnderlyingFactorField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.UnderlyingFactor (246).
+func (f UnderlyingFactorField) Tag() quickfix.Tag { return tag.UnderlyingFactor }
+
+// NewUnderlyingFactor returns a new UnderlyingFactorField initialized with val and scale.
+func NewUnderlyingFactor(val decimal.Decimal, scale int32) UnderlyingFactorField {
+	return UnderlyingFactorField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f UnderlyingFactorField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// UnderlyingInstrRegistryField is a STRING field.
+type UnderlyingInstrRegistryField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingInstrRegistry (595).
+func (f UnderlyingInstrRegistryField) Tag() quickfix.Tag { return tag.UnderlyingInstrRegistry }
+
+// NewUnderlyingInstrRegistry returns a new UnderlyingInstrRegistryField initialized with val.
+func NewUnderlyingInstrRegistry(val string) UnderlyingInstrRegistryField {
+	return UnderlyingInstrRegistryField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingInstrRegistryField) Value() string { return f.String() }
+
+// UnderlyingIssueDateField is a LOCALMKTDATE field.
+type UnderlyingIssueDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingIssueDate (242).
+func (f UnderlyingIssueDateField) Tag() quickfix.Tag { return tag.UnderlyingIssueDate }
+
+// NewUnderlyingIssueDate returns a new UnderlyingIssueDateField initialized with val.
+func NewUnderlyingIssueDate(val string) UnderlyingIssueDateField {
+	return UnderlyingIssueDateField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingIssueDateField) Value() string { return f.String() }
+
+// UnderlyingIssuerField is a STRING field.
+type UnderlyingIssuerField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingIssuer (306).
+func (f UnderlyingIssuerField) Tag() quickfix.Tag { return tag.UnderlyingIssuer }
+
+// NewUnderlyingIssuer returns a new UnderlyingIssuerField initialized with val.
+func NewUnderlyingIssuer(val string) UnderlyingIssuerField {
+	return UnderlyingIssuerField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingIssuerField) Value() string { return f.String() }
+
+// UnderlyingLocaleOfIssueField is a STRING field.
+type UnderlyingLocaleOfIssueField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingLocaleOfIssue (594).
+func (f UnderlyingLocaleOfIssueField) Tag() quickfix.Tag { return tag.UnderlyingLocaleOfIssue }
+
+// NewUnderlyingLocaleOfIssue returns a new UnderlyingLocaleOfIssueField initialized with val.
+func NewUnderlyingLocaleOfIssue(val string) UnderlyingLocaleOfIssueField {
+	return UnderlyingLocaleOfIssueField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingLocaleOfIssueField) Value() string { return f.String() }
+
+// UnderlyingMaturityDateField is a LOCALMKTDATE field.
+type UnderlyingMaturityDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingMaturityDate (542).
+func (f UnderlyingMaturityDateField) Tag() quickfix.Tag { return tag.UnderlyingMaturityDate }
+
+// NewUnderlyingMaturityDate returns a new UnderlyingMaturityDateField initialized with val.
+func NewUnderlyingMaturityDate(val string) UnderlyingMaturityDateField {
+	return UnderlyingMaturityDateField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingMaturityDateField) Value() string { return f.String() }
+
+// UnderlyingMaturityMonthYearField is a MONTHYEAR field.
+type UnderlyingMaturityMonthYearField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingMaturityMonthYear (313).
+func (f UnderlyingMaturityMonthYearField) Tag() quickfix.Tag { return tag.UnderlyingMaturityMonthYear }
+
+// NewUnderlyingMaturityMonthYear returns a new UnderlyingMaturityMonthYearField initialized with val.
+func NewUnderlyingMaturityMonthYear(val string) UnderlyingMaturityMonthYearField {
+	return UnderlyingMaturityMonthYearField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingMaturityMonthYearField) Value() string { return f.String() }
+
+// UnderlyingOptAttributeField is a CHAR field.
+type UnderlyingOptAttributeField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingOptAttribute (317).
+func (f UnderlyingOptAttributeField) Tag() quickfix.Tag { return tag.UnderlyingOptAttribute }
+
+// NewUnderlyingOptAttribute returns a new UnderlyingOptAttributeField initialized with val.
+func NewUnderlyingOptAttribute(val string) UnderlyingOptAttributeField {
+	return UnderlyingOptAttributeField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingOptAttributeField) Value() string { return f.String() }
+
+// UnderlyingProductField is a INT field.
+type UnderlyingProductField struct{ quickfix.FIXInt }
+
+// Tag returns tag.UnderlyingProduct (462).
+func (f UnderlyingProductField) Tag() quickfix.Tag { return tag.UnderlyingProduct }
+
+// NewUnderlyingProduct returns a new UnderlyingProductField initialized with val.
+func NewUnderlyingProduct(val int) UnderlyingProductField {
+	return UnderlyingProductField{quickfix.FIXInt(val)}
+}
+
+func (f UnderlyingProductField) Value() int { return f.Int() }
+
+// UnderlyingPxField is a PRICE field.
+type UnderlyingPxField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.UnderlyingPx (810).
+func (f UnderlyingPxField) Tag() quickfix.Tag { return tag.UnderlyingPx }
+
+// NewUnderlyingPx returns a new UnderlyingPxField initialized with val and scale.
+func NewUnderlyingPx(val decimal.Decimal, scale int32) UnderlyingPxField {
+	return UnderlyingPxField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f UnderlyingPxField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// UnderlyingQtyField is a QTY field.
+type UnderlyingQtyField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.UnderlyingQty (879).
+func (f UnderlyingQtyField) Tag() quickfix.Tag { return tag.UnderlyingQty }
+
+// NewUnderlyingQty returns a new UnderlyingQtyField initialized with val and scale.
+func NewUnderlyingQty(val decimal.Decimal, scale int32) UnderlyingQtyField {
+	return UnderlyingQtyField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f UnderlyingQtyField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// UnderlyingRedemptionDateField is a LOCALMKTDATE field.
+type UnderlyingRedemptionDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingRedemptionDate (247).
+func (f UnderlyingRedemptionDateField) Tag() quickfix.Tag { return tag.UnderlyingRedemptionDate }
+
+// NewUnderlyingRedemptionDate returns a new UnderlyingRedemptionDateField initialized with val.
+func NewUnderlyingRedemptionDate(val string) UnderlyingRedemptionDateField {
+	return UnderlyingRedemptionDateField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingRedemptionDateField) Value() string { return f.String() }
+
+// UnderlyingRepoCollateralSecurityTypeField is a INT field.
+type UnderlyingRepoCollateralSecurityTypeField struct{ quickfix.FIXInt }
+
+// Tag returns tag.UnderlyingRepoCollateralSecurityType (243).
+func (f UnderlyingRepoCollateralSecurityTypeField) Tag() quickfix.Tag {
+	return tag.UnderlyingRepoCollateralSecurityType
+}
+
+// NewUnderlyingRepoCollateralSecurityType returns a new UnderlyingRepoCollateralSecurityTypeField initialized with val.
+func NewUnderlyingRepoCollateralSecurityType(val int) UnderlyingRepoCollateralSecurityTypeField {
+	return UnderlyingRepoCollateralSecurityTypeField{quickfix.FIXInt(val)}
+}
+
+func (f UnderlyingRepoCollateralSecurityTypeField) Value() int { return f.Int() }
+
+// UnderlyingRepurchaseRateField is a PERCENTAGE field.
+type UnderlyingRepurchaseRateField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.UnderlyingRepurchaseRate (245).
+func (f UnderlyingRepurchaseRateField) Tag() quickfix.Tag { return tag.UnderlyingRepurchaseRate }
+
+// NewUnderlyingRepurchaseRate returns a new UnderlyingRepurchaseRateField initialized with val and scale.
+func NewUnderlyingRepurchaseRate(val decimal.Decimal, scale int32) UnderlyingRepurchaseRateField {
+	return UnderlyingRepurchaseRateField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f UnderlyingRepurchaseRateField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// UnderlyingRepurchaseTermField is a INT field.
+type UnderlyingRepurchaseTermField struct{ quickfix.FIXInt }
+
+// Tag returns tag.UnderlyingRepurchaseTerm (244).
+func (f UnderlyingRepurchaseTermField) Tag() quickfix.Tag { return tag.UnderlyingRepurchaseTerm }
+
+// NewUnderlyingRepurchaseTerm returns a new UnderlyingRepurchaseTermField initialized with val.
+func NewUnderlyingRepurchaseTerm(val int) UnderlyingRepurchaseTermField {
+	return UnderlyingRepurchaseTermField{quickfix.FIXInt(val)}
+}
+
+func (f UnderlyingRepurchaseTermField) Value() int { return f.Int() }
+
+// UnderlyingSecurityAltIDField is a STRING field.
+type UnderlyingSecurityAltIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingSecurityAltID (458).
+func (f UnderlyingSecurityAltIDField) Tag() quickfix.Tag { return tag.UnderlyingSecurityAltID }
+
+// NewUnderlyingSecurityAltID returns a new UnderlyingSecurityAltIDField initialized with val.
+func NewUnderlyingSecurityAltID(val string) UnderlyingSecurityAltIDField {
+	return UnderlyingSecurityAltIDField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingSecurityAltIDField) Value() string { return f.String() }
+
+// UnderlyingSecurityAltIDSourceField is a STRING field.
+type UnderlyingSecurityAltIDSourceField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingSecurityAltIDSource (459).
+func (f UnderlyingSecurityAltIDSourceField) Tag() quickfix.Tag {
+	return tag.UnderlyingSecurityAltIDSource
+}
+
+// NewUnderlyingSecurityAltIDSource returns a new UnderlyingSecurityAltIDSourceField initialized with val.
+func NewUnderlyingSecurityAltIDSource(val string) UnderlyingSecurityAltIDSourceField {
+	return UnderlyingSecurityAltIDSourceField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingSecurityAltIDSourceField) Value() string { return f.String() }
+
+// UnderlyingSecurityDescField is a STRING field.
+type UnderlyingSecurityDescField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingSecurityDesc (307).
+func (f UnderlyingSecurityDescField) Tag() quickfix.Tag { return tag.UnderlyingSecurityDesc }
+
+// NewUnderlyingSecurityDesc returns a new UnderlyingSecurityDescField initialized with val.
+func NewUnderlyingSecurityDesc(val string) UnderlyingSecurityDescField {
+	return UnderlyingSecurityDescField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingSecurityDescField) Value() string { return f.String() }
+
+// UnderlyingSecurityExchangeField is a EXCHANGE field.
+type UnderlyingSecurityExchangeField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingSecurityExchange (308).
+func (f UnderlyingSecurityExchangeField) Tag() quickfix.Tag { return tag.UnderlyingSecurityExchange }
+
+// NewUnderlyingSecurityExchange returns a new UnderlyingSecurityExchangeField initialized with val.
+func NewUnderlyingSecurityExchange(val string) UnderlyingSecurityExchangeField {
+	return UnderlyingSecurityExchangeField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingSecurityExchangeField) Value() string { return f.String() }
+
+// UnderlyingSecurityIDField is a STRING field.
+type UnderlyingSecurityIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingSecurityID (309).
+func (f UnderlyingSecurityIDField) Tag() quickfix.Tag { return tag.UnderlyingSecurityID }
+
+// NewUnderlyingSecurityID returns a new UnderlyingSecurityIDField initialized with val.
+func NewUnderlyingSecurityID(val string) UnderlyingSecurityIDField {
+	return UnderlyingSecurityIDField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingSecurityIDField) Value() string { return f.String() }
+
+// UnderlyingSecurityIDSourceField is a STRING field.
+type UnderlyingSecurityIDSourceField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingSecurityIDSource (305).
+func (f UnderlyingSecurityIDSourceField) Tag() quickfix.Tag { return tag.UnderlyingSecurityIDSource }
+
+// NewUnderlyingSecurityIDSource returns a new UnderlyingSecurityIDSourceField initialized with val.
+func NewUnderlyingSecurityIDSource(val string) UnderlyingSecurityIDSourceField {
+	return UnderlyingSecurityIDSourceField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingSecurityIDSourceField) Value() string { return f.String() }
+
+// UnderlyingSecuritySubTypeField is a STRING field.
+type UnderlyingSecuritySubTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingSecuritySubType (763).
+func (f UnderlyingSecuritySubTypeField) Tag() quickfix.Tag { return tag.UnderlyingSecuritySubType }
+
+// NewUnderlyingSecuritySubType returns a new UnderlyingSecuritySubTypeField initialized with val.
+func NewUnderlyingSecuritySubType(val string) UnderlyingSecuritySubTypeField {
+	return UnderlyingSecuritySubTypeField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingSecuritySubTypeField) Value() string { return f.String() }
+
+// UnderlyingSecurityTypeField is a STRING field.
+type UnderlyingSecurityTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingSecurityType (310).
+func (f UnderlyingSecurityTypeField) Tag() quickfix.Tag { return tag.UnderlyingSecurityType }
+
+// NewUnderlyingSecurityType returns a new UnderlyingSecurityTypeField initialized with val.
+func NewUnderlyingSecurityType(val string) UnderlyingSecurityTypeField {
+	return UnderlyingSecurityTypeField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingSecurityTypeField) Value() string { return f.String() }
+
+// UnderlyingStartValueField is a AMT field.
+type UnderlyingStartValueField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.UnderlyingStartValue (884).
+func (f UnderlyingStartValueField) Tag() quickfix.Tag { return tag.UnderlyingStartValue }
+
+// NewUnderlyingStartValue returns a new UnderlyingStartValueField initialized with val and scale.
+func NewUnderlyingStartValue(val decimal.Decimal, scale int32) UnderlyingStartValueField {
+	return UnderlyingStartValueField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f UnderlyingStartValueField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// UnderlyingStateOrProvinceOfIssueField is a STRING field.
+type UnderlyingStateOrProvinceOfIssueField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingStateOrProvinceOfIssue (593).
+func (f UnderlyingStateOrProvinceOfIssueField) Tag() quickfix.Tag {
+	return tag.UnderlyingStateOrProvinceOfIssue
+}
+
+// NewUnderlyingStateOrProvinceOfIssue returns a new UnderlyingStateOrProvinceOfIssueField initialized with val.
+func NewUnderlyingStateOrProvinceOfIssue(val string) UnderlyingStateOrProvinceOfIssueField {
+	return UnderlyingStateOrProvinceOfIssueField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingStateOrProvinceOfIssueField) Value() string { return f.String() }
+
+// UnderlyingStipTypeField is a STRING field.
+type UnderlyingStipTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingStipType (888).
+func (f UnderlyingStipTypeField) Tag() quickfix.Tag { return tag.UnderlyingStipType }
+
+// NewUnderlyingStipType returns a new UnderlyingStipTypeField initialized with val.
+func NewUnderlyingStipType(val string) UnderlyingStipTypeField {
+	return UnderlyingStipTypeField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingStipTypeField) Value() string { return f.String() }
+
+// UnderlyingStipValueField is a STRING field.
+type UnderlyingStipValueField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingStipValue (889).
+func (f UnderlyingStipValueField) Tag() quickfix.Tag { return tag.UnderlyingStipValue }
+
+// NewUnderlyingStipValue returns a new UnderlyingStipValueField initialized with val.
+func NewUnderlyingStipValue(val string) UnderlyingStipValueField {
+	return UnderlyingStipValueField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingStipValueField) Value() string { return f.String() }
+
+// UnderlyingStrikeCurrencyField is a CURRENCY field.
+type UnderlyingStrikeCurrencyField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingStrikeCurrency (941).
+func (f UnderlyingStrikeCurrencyField) Tag() quickfix.Tag { return tag.UnderlyingStrikeCurrency }
+
+// NewUnderlyingStrikeCurrency returns a new UnderlyingStrikeCurrencyField initialized with val.
+func NewUnderlyingStrikeCurrency(val string) UnderlyingStrikeCurrencyField {
+	return UnderlyingStrikeCurrencyField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingStrikeCurrencyField) Value() string { return f.String() }
+
+// UnderlyingStrikePriceField is a PRICE field.
+type UnderlyingStrikePriceField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.UnderlyingStrikePrice (316).
+func (f UnderlyingStrikePriceField) Tag() quickfix.Tag { return tag.UnderlyingStrikePrice }
+
+// NewUnderlyingStrikePrice returns a new UnderlyingStrikePriceField initialized with val and scale.
+func NewUnderlyingStrikePrice(val decimal.Decimal, scale int32) UnderlyingStrikePriceField {
+	return UnderlyingStrikePriceField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f UnderlyingStrikePriceField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// UnderlyingSymbolField is a STRING field.
+type UnderlyingSymbolField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingSymbol (311).
+func (f UnderlyingSymbolField) Tag() quickfix.Tag { return tag.UnderlyingSymbol }
+
+// NewUnderlyingSymbol returns a new UnderlyingSymbolField initialized with val.
+func NewUnderlyingSymbol(val string) UnderlyingSymbolField {
+	return UnderlyingSymbolField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingSymbolField) Value() string { return f.String() }
+
+// UnderlyingSymbolSfxField is a STRING field.
+type UnderlyingSymbolSfxField struct{ quickfix.FIXString }
+
+// Tag returns tag.UnderlyingSymbolSfx (312).
+func (f UnderlyingSymbolSfxField) Tag() quickfix.Tag { return tag.UnderlyingSymbolSfx }
+
+// NewUnderlyingSymbolSfx returns a new UnderlyingSymbolSfxField initialized with val.
+func NewUnderlyingSymbolSfx(val string) UnderlyingSymbolSfxField {
+	return UnderlyingSymbolSfxField{quickfix.FIXString(val)}
+}
+
+func (f UnderlyingSymbolSfxField) Value() string { return f.String() }
+
+// UsernameField is a STRING field.
+type UsernameField struct{ quickfix.FIXString }
+
+// Tag returns tag.Username (553).
+func (f UsernameField) Tag() quickfix.Tag { return tag.Username }
+
+// NewUsername returns a new UsernameField initialized with val.
+func NewUsername(val string) UsernameField {
+	return UsernameField{quickfix.FIXString(val)}
+}
+
+func (f UsernameField) Value() string { return f.String() }
+
+// WaanxAppIDField is a STRING field.
+type WaanxAppIDField struct{ quickfix.FIXString }
+
+// Tag returns tag.WaanxAppID (20001).
+func (f WaanxAppIDField) Tag() quickfix.Tag { return tag.WaanxAppID }
+
+// NewWaanxAppID returns a new WaanxAppIDField initialized with val.
+func NewWaanxAppID(val string) WaanxAppIDField {
+	return WaanxAppIDField{quickfix.FIXString(val)}
+}
+
+func (f WaanxAppIDField) Value() string { return f.String() }
+
+// WaanxAppSigField is a STRING field.
+type WaanxAppSigField struct{ quickfix.FIXString }
+
+// Tag returns tag.WaanxAppSig (20002).
+func (f WaanxAppSigField) Tag() quickfix.Tag { return tag.WaanxAppSig }
+
+// NewWaanxAppSig returns a new WaanxAppSigField initialized with val.
+func NewWaanxAppSig(val string) WaanxAppSigField {
+	return WaanxAppSigField{quickfix.FIXString(val)}
+}
+
+func (f WaanxAppSigField) Value() string { return f.String() }
+
+// WaanxInstrumentTypeField is a enum.WaanxInstrumentType field.
+type WaanxInstrumentTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.WaanxInstrumentType (20015).
+func (f WaanxInstrumentTypeField) Tag() quickfix.Tag { return tag.WaanxInstrumentType }
+
+func NewWaanxInstrumentType(val enum.WaanxInstrumentType) WaanxInstrumentTypeField {
+	return WaanxInstrumentTypeField{quickfix.FIXString(val)}
+}
+
+func (f WaanxInstrumentTypeField) Value() enum.WaanxInstrumentType {
+	return enum.WaanxInstrumentType(f.String())
+}
+
+// WaanxLotSizeField is a QTY field.
+type WaanxLotSizeField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.WaanxLotSize (20011).
+func (f WaanxLotSizeField) Tag() quickfix.Tag { return tag.WaanxLotSize }
+
+// NewWaanxLotSize returns a new WaanxLotSizeField initialized with val and scale.
+func NewWaanxLotSize(val decimal.Decimal, scale int32) WaanxLotSizeField {
+	return WaanxLotSizeField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f WaanxLotSizeField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// WaanxMinNotionalField is a AMT field.
+type WaanxMinNotionalField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.WaanxMinNotional (20012).
+func (f WaanxMinNotionalField) Tag() quickfix.Tag { return tag.WaanxMinNotional }
+
+// NewWaanxMinNotional returns a new WaanxMinNotionalField initialized with val and scale.
+func NewWaanxMinNotional(val decimal.Decimal, scale int32) WaanxMinNotionalField {
+	return WaanxMinNotionalField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f WaanxMinNotionalField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// WaanxPricePrecisionField is a INT field.
+type WaanxPricePrecisionField struct{ quickfix.FIXInt }
+
+// Tag returns tag.WaanxPricePrecision (20013).
+func (f WaanxPricePrecisionField) Tag() quickfix.Tag { return tag.WaanxPricePrecision }
+
+// NewWaanxPricePrecision returns a new WaanxPricePrecisionField initialized with val.
+func NewWaanxPricePrecision(val int) WaanxPricePrecisionField {
+	return WaanxPricePrecisionField{quickfix.FIXInt(val)}
+}
+
+func (f WaanxPricePrecisionField) Value() int { return f.Int() }
+
+// WaanxQtyPrecisionField is a INT field.
+type WaanxQtyPrecisionField struct{ quickfix.FIXInt }
+
+// Tag returns tag.WaanxQtyPrecision (20014).
+func (f WaanxQtyPrecisionField) Tag() quickfix.Tag { return tag.WaanxQtyPrecision }
+
+// NewWaanxQtyPrecision returns a new WaanxQtyPrecisionField initialized with val.
+func NewWaanxQtyPrecision(val int) WaanxQtyPrecisionField {
+	return WaanxQtyPrecisionField{quickfix.FIXInt(val)}
+}
+
+func (f WaanxQtyPrecisionField) Value() int { return f.Int() }
+
+// WaanxTickSizeField is a PRICE field.
+type WaanxTickSizeField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.WaanxTickSize (20010).
+func (f WaanxTickSizeField) Tag() quickfix.Tag { return tag.WaanxTickSize }
+
+// NewWaanxTickSize returns a new WaanxTickSizeField initialized with val and scale.
+func NewWaanxTickSize(val decimal.Decimal, scale int32) WaanxTickSizeField {
+	return WaanxTickSizeField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f WaanxTickSizeField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// XmlDataField is a DATA field.
+type XmlDataField struct{ quickfix.FIXString }
+
+// Tag returns tag.XmlData (213).
+func (f XmlDataField) Tag() quickfix.Tag { return tag.XmlData }
+
+// NewXmlData returns a new XmlDataField initialized with val.
+func NewXmlData(val string) XmlDataField {
+	return XmlDataField{quickfix.FIXString(val)}
+}
+
+func (f XmlDataField) Value() string { return f.String() }
+
+// XmlDataLenField is a LENGTH field.
+type XmlDataLenField struct{ quickfix.FIXInt }
+
+// Tag returns tag.XmlDataLen (212).
+func (f XmlDataLenField) Tag() quickfix.Tag { return tag.XmlDataLen }
+
+// NewXmlDataLen returns a new XmlDataLenField initialized with val.
+func NewXmlDataLen(val int) XmlDataLenField {
+	return XmlDataLenField{quickfix.FIXInt(val)}
+}
+
+func (f XmlDataLenField) Value() int { return f.Int() }
+
+// YieldField is a PERCENTAGE field.
+type YieldField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.Yield (236).
+func (f YieldField) Tag() quickfix.Tag { return tag.Yield }
+
+// NewYield returns a new YieldField initialized with val and scale.
+func NewYield(val decimal.Decimal, scale int32) YieldField {
+	return YieldField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f YieldField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// YieldCalcDateField is a LOCALMKTDATE field.
+type YieldCalcDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.YieldCalcDate (701).
+func (f YieldCalcDateField) Tag() quickfix.Tag { return tag.YieldCalcDate }
+
+// NewYieldCalcDate returns a new YieldCalcDateField initialized with val.
+func NewYieldCalcDate(val string) YieldCalcDateField {
+	return YieldCalcDateField{quickfix.FIXString(val)}
+}
+
+func (f YieldCalcDateField) Value() string { return f.String() }
+
+// YieldRedemptionDateField is a LOCALMKTDATE field.
+type YieldRedemptionDateField struct{ quickfix.FIXString }
+
+// Tag returns tag.YieldRedemptionDate (696).
+func (f YieldRedemptionDateField) Tag() quickfix.Tag { return tag.YieldRedemptionDate }
+
+// NewYieldRedemptionDate returns a new YieldRedemptionDateField initialized with val.
+func NewYieldRedemptionDate(val string) YieldRedemptionDateField {
+	return YieldRedemptionDateField{quickfix.FIXString(val)}
+}
+
+func (f YieldRedemptionDateField) Value() string { return f.String() }
+
+// YieldRedemptionPriceField is a PRICE field.
+type YieldRedemptionPriceField struct{ quickfix.FIXDecimal }
+
+// Tag returns tag.YieldRedemptionPrice (697).
+func (f YieldRedemptionPriceField) Tag() quickfix.Tag { return tag.YieldRedemptionPrice }
+
+// NewYieldRedemptionPrice returns a new YieldRedemptionPriceField initialized with val and scale.
+func NewYieldRedemptionPrice(val decimal.Decimal, scale int32) YieldRedemptionPriceField {
+	return YieldRedemptionPriceField{quickfix.FIXDecimal{Decimal: val, Scale: scale}}
+}
+
+func (f YieldRedemptionPriceField) Value() (val decimal.Decimal) { return f.Decimal }
+
+// YieldRedemptionPriceTypeField is a INT field.
+type YieldRedemptionPriceTypeField struct{ quickfix.FIXInt }
+
+// Tag returns tag.YieldRedemptionPriceType (698).
+func (f YieldRedemptionPriceTypeField) Tag() quickfix.Tag { return tag.YieldRedemptionPriceType }
+
+// NewYieldRedemptionPriceType returns a new YieldRedemptionPriceTypeField initialized with val.
+func NewYieldRedemptionPriceType(val int) YieldRedemptionPriceTypeField {
+	return YieldRedemptionPriceTypeField{quickfix.FIXInt(val)}
+}
+
+func (f YieldRedemptionPriceTypeField) Value() int { return f.Int() }
+
+// YieldTypeField is a enum.YieldType field.
+type YieldTypeField struct{ quickfix.FIXString }
+
+// Tag returns tag.YieldType (235).
+func (f YieldTypeField) Tag() quickfix.Tag { return tag.YieldType }
+
+func NewYieldType(val enum.YieldType) YieldTypeField {
+	return YieldTypeField{quickfix.FIXString(val)}
+}
+
+func (f YieldTypeField) Value() enum.YieldType { return enum.YieldType(f.String()) }
@@ -0,0 +1,235 @@
+// Command gen generates the waanx message, field, enum and tag packages from
+// the waanx data dictionary.
+//
+// The dictionary is pruned to the requested messages, by default the ones
+// using venue tags, and to the fields and components they reference before
+// being handed to quickfixgo's generate-fix. Standard messages keep coming
+// from github.com/quickfixgo/fix44.
+package main
+
+import (
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/quickfixgo/quickfix/datadictionary"
+)
+
+// userDefinedTag is the first tag of the user defined range.
+const userDefinedTag = 5000
+
+var (
+	spec    = flag.String("spec", "", "path to the data dictionary")
+	pkgRoot = flag.String("pkg-root", "github.com/phimaker/waanx-fix-simpler/internal/fix/waanx", "import path of the generated packages")
+	msgs    = flag.String("msgs", "", "comma separated message names to generate, defaults to the messages using user defined tags")
+	out     = flag.String("out", ".", "output directory")
+)
+
+func main() {
+	flag.Parse()
+	if *spec == "" {
+		log.Fatal("-spec is required")
+	}
+
+	doc, err := readSpec(*spec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := prune(doc, splitNames(*msgs)); err != nil {
+		log.Fatal(err)
+	}
+
+	pruned, err := writeSpec(doc)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.Remove(pruned)
+
+	if err := generate(pruned); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func readSpec(path string) (*datadictionary.XMLDoc, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading spec(%s): %w", path, err)
+	}
+	var doc datadictionary.XMLDoc
+	if err := xml.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("error parsing spec(%s): %w", path, err)
+	}
+	return &doc, nil
+}
+
+func writeSpec(doc *datadictionary.XMLDoc) (string, error) {
+	b, err := xml.MarshalIndent(struct {
+		XMLName xml.Name `xml:"fix"`
+		*datadictionary.XMLDoc
+	}{XMLDoc: doc}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("error encoding pruned spec: %w", err)
+	}
+
+	f, err := os.CreateTemp("", "waanx-spec-*.xml")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err := f.Write(b); err != nil {
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// prune keeps the named messages, or the ones using user defined tags, and
+// the components and fields they need.
+func prune(doc *datadictionary.XMLDoc, names []string) error {
+	fields := make(map[string]*datadictionary.XMLField, len(doc.Fields))
+	for _, f := range doc.Fields {
+		fields[f.Name] = f
+	}
+	components := make(map[string]*datadictionary.XMLComponent, len(doc.Components))
+	for _, c := range doc.Components {
+		components[c.Name] = c
+	}
+
+	var (
+		usedFields     = map[string]bool{}
+		usedComponents = map[string]bool{}
+	)
+	var walk func(members []*datadictionary.XMLComponentMember)
+	walk = func(members []*datadictionary.XMLComponentMember) {
+		for _, m := range members {
+			switch m.XMLName.Local {
+			case "component":
+				if c, ok := components[m.Name]; ok && !usedComponents[m.Name] {
+					usedComponents[m.Name] = true
+					walk(c.Members)
+				}
+			default:
+				usedFields[m.Name] = true
+				walk(m.Members)
+			}
+		}
+	}
+	usesCustomTag := func(msg *datadictionary.XMLComponent) bool {
+		found := false
+		var visit func(members []*datadictionary.XMLComponentMember)
+		visit = func(members []*datadictionary.XMLComponentMember) {
+			for _, m := range members {
+				if m.XMLName.Local == "component" {
+					if c, ok := components[m.Name]; ok {
+						visit(c.Members)
+					}
+					continue
+				}
+				if f, ok := fields[m.Name]; ok && f.Number >= userDefinedTag {
+					found = true
+				}
+				visit(m.Members)
+			}
+		}
+		visit(msg.Members)
+		return found
+	}
+
+	wanted := map[string]bool{}
+	for _, n := range names {
+		wanted[n] = true
+	}
+	var kept []*datadictionary.XMLComponent
+	for _, msg := range doc.Messages {
+		if (len(names) == 0 && usesCustomTag(msg)) || wanted[msg.Name] {
+			kept = append(kept, msg)
+			delete(wanted, msg.Name)
+		}
+	}
+	if len(wanted) > 0 {
+		missing := make([]string, 0, len(wanted))
+		for n := range wanted {
+			missing = append(missing, n)
+		}
+		return fmt.Errorf("unknown messages: %s", strings.Join(missing, ", "))
+	}
+	if len(kept) == 0 {
+		return fmt.Errorf("no messages to generate")
+	}
+
+	walk(doc.Header.Members)
+	walk(doc.Trailer.Members)
+	for _, msg := range kept {
+		walk(msg.Members)
+	}
+
+	doc.Messages = kept
+	doc.Components = filter(doc.Components, func(c *datadictionary.XMLComponent) bool { return usedComponents[c.Name] })
+	doc.Fields = filter(doc.Fields, func(f *datadictionary.XMLField) bool {
+		return usedFields[f.Name] || f.Number >= userDefinedTag
+	})
+	return nil
+}
+
+// generate replaces the output packages with the result of quickfixgo's
+// generate-fix, which writes to its working directory.
+func generate(specPath string) error {
+	for _, pkg := range []string{"enum", "field", "fix44", "tag"} {
+		if err := os.RemoveAll(filepath.Join(*out, pkg)); err != nil {
+			return err
+		}
+	}
+
+	cmd := exec.Command("go", "run", "github.com/quickfixgo/quickfix/cmd/generate-fix", "-pkg-root", *pkgRoot, specPath)
+	cmd.Dir = *out
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error running generate-fix: %w", err)
+	}
+	return keyLiterals(filepath.Join(*out, "fix44"))
+}
+
+// keyLiterals keys the Header and Trailer literals of the generated messages,
+// which go vet reports as unkeyed.
+func keyLiterals(dir string) error {
+	r := strings.NewReplacer(
+		"fix44.Header{&m.Header}", "fix44.Header{Header: &m.Header}",
+		"fix44.Trailer{&m.Trailer}", "fix44.Trailer{Trailer: &m.Trailer}",
+	)
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") {
+			return err
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(path, []byte(r.Replace(string(b))), 0o644)
+	})
+}
+
+func splitNames(s string) []string {
+	var names []string
+	for _, n := range strings.Split(s, ",") {
+		if n = strings.TrimSpace(n); n != "" {
+			names = append(names, n)
+		}
+	}
+	return names
+}
+
+func filter[T any](items []T, keep func(T) bool) []T {
+	out := items[:0]
+	for _, item := range items {
+		if keep(item) {
+			out = append(out, item)
+		}
+	}
+	return out
+}
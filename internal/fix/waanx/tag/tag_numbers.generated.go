@@ -0,0 +1,299 @@
+package tag
+
+import "github.com/quickfixgo/quickfix"
+
+const (
+	AgreementCurrency                    quickfix.Tag = 918
+	AgreementDate                        quickfix.Tag = 915
+	AgreementDesc                        quickfix.Tag = 913
+	AgreementID                          quickfix.Tag = 914
+	ApplQueueDepth                       quickfix.Tag = 813
+	ApplQueueResolution                  quickfix.Tag = 814
+	BeginString                          quickfix.Tag = 8
+	BenchmarkCurveCurrency               quickfix.Tag = 220
+	BenchmarkCurveName                   quickfix.Tag = 221
+	BenchmarkCurvePoint                  quickfix.Tag = 222
+	BenchmarkPrice                       quickfix.Tag = 662
+	BenchmarkPriceType                   quickfix.Tag = 663
+	BenchmarkSecurityID                  quickfix.Tag = 699
+	BenchmarkSecurityIDSource            quickfix.Tag = 761
+	BodyLength                           quickfix.Tag = 9
+	CFICode                              quickfix.Tag = 461
+	CPProgram                            quickfix.Tag = 875
+	CPRegType                            quickfix.Tag = 876
+	CheckSum                             quickfix.Tag = 10
+	ContractMultiplier                   quickfix.Tag = 231
+	ContractSettlMonth                   quickfix.Tag = 667
+	CorporateAction                      quickfix.Tag = 292
+	CountryOfIssue                       quickfix.Tag = 470
+	CouponPaymentDate                    quickfix.Tag = 224
+	CouponRate                           quickfix.Tag = 223
+	CreditRating                         quickfix.Tag = 255
+	Currency                             quickfix.Tag = 15
+	DatedDate                            quickfix.Tag = 873
+	DeleteReason                         quickfix.Tag = 285
+	DeliverToCompID                      quickfix.Tag = 128
+	DeliverToLocationID                  quickfix.Tag = 145
+	DeliverToSubID                       quickfix.Tag = 129
+	DeliveryForm                         quickfix.Tag = 668
+	DeliveryType                         quickfix.Tag = 919
+	DeskID                               quickfix.Tag = 284
+	EncodedIssuer                        quickfix.Tag = 349
+	EncodedIssuerLen                     quickfix.Tag = 348
+	EncodedLegIssuer                     quickfix.Tag = 619
+	EncodedLegIssuerLen                  quickfix.Tag = 618
+	EncodedLegSecurityDesc               quickfix.Tag = 622
+	EncodedLegSecurityDescLen            quickfix.Tag = 621
+	EncodedSecurityDesc                  quickfix.Tag = 351
+	EncodedSecurityDescLen               quickfix.Tag = 350
+	EncodedText                          quickfix.Tag = 355
+	EncodedTextLen                       quickfix.Tag = 354
+	EncodedUnderlyingIssuer              quickfix.Tag = 363
+	EncodedUnderlyingIssuerLen           quickfix.Tag = 362
+	EncodedUnderlyingSecurityDesc        quickfix.Tag = 365
+	EncodedUnderlyingSecurityDescLen     quickfix.Tag = 364
+	EncryptMethod                        quickfix.Tag = 98
+	EndDate                              quickfix.Tag = 917
+	EventDate                            quickfix.Tag = 866
+	EventPx                              quickfix.Tag = 867
+	EventText                            quickfix.Tag = 868
+	EventType                            quickfix.Tag = 865
+	ExecInst                             quickfix.Tag = 18
+	ExpirationCycle                      quickfix.Tag = 827
+	ExpireDate                           quickfix.Tag = 432
+	ExpireTime                           quickfix.Tag = 126
+	Factor                               quickfix.Tag = 228
+	FinancialStatus                      quickfix.Tag = 291
+	HeartBtInt                           quickfix.Tag = 108
+	HopCompID                            quickfix.Tag = 628
+	HopRefID                             quickfix.Tag = 630
+	HopSendingTime                       quickfix.Tag = 629
+	InstrAttribType                      quickfix.Tag = 871
+	InstrAttribValue                     quickfix.Tag = 872
+	InstrRegistry                        quickfix.Tag = 543
+	InterestAccrualDate                  quickfix.Tag = 874
+	IssueDate                            quickfix.Tag = 225
+	Issuer                               quickfix.Tag = 106
+	LastFragment                         quickfix.Tag = 893
+	LastMsgSeqNumProcessed               quickfix.Tag = 369
+	LegBenchmarkCurveCurrency            quickfix.Tag = 676
+	LegBenchmarkCurveName                quickfix.Tag = 677
+	LegBenchmarkCurvePoint               quickfix.Tag = 678
+	LegBenchmarkPrice                    quickfix.Tag = 679
+	LegBenchmarkPriceType                quickfix.Tag = 680
+	LegCFICode                           quickfix.Tag = 608
+	LegContractMultiplier                quickfix.Tag = 614
+	LegContractSettlMonth                quickfix.Tag = 955
+	LegCountryOfIssue                    quickfix.Tag = 596
+	LegCouponPaymentDate                 quickfix.Tag = 248
+	LegCouponRate                        quickfix.Tag = 615
+	LegCreditRating                      quickfix.Tag = 257
+	LegCurrency                          quickfix.Tag = 556
+	LegDatedDate                         quickfix.Tag = 739
+	LegFactor                            quickfix.Tag = 253
+	LegInstrRegistry                     quickfix.Tag = 599
+	LegInterestAccrualDate               quickfix.Tag = 956
+	LegIssueDate                         quickfix.Tag = 249
+	LegIssuer                            quickfix.Tag = 617
+	LegLocaleOfIssue                     quickfix.Tag = 598
+	LegMaturityDate                      quickfix.Tag = 611
+	LegMaturityMonthYear                 quickfix.Tag = 610
+	LegOptAttribute                      quickfix.Tag = 613
+	LegPool                              quickfix.Tag = 740
+	LegProduct                           quickfix.Tag = 607
+	LegRatioQty                          quickfix.Tag = 623
+	LegRedemptionDate                    quickfix.Tag = 254
+	LegRepoCollateralSecurityType        quickfix.Tag = 250
+	LegRepurchaseRate                    quickfix.Tag = 252
+	LegRepurchaseTerm                    quickfix.Tag = 251
+	LegSecurityAltID                     quickfix.Tag = 605
+	LegSecurityAltIDSource               quickfix.Tag = 606
+	LegSecurityDesc                      quickfix.Tag = 620
+	LegSecurityExchange                  quickfix.Tag = 616
+	LegSecurityID                        quickfix.Tag = 602
+	LegSecurityIDSource                  quickfix.Tag = 603
+	LegSecuritySubType                   quickfix.Tag = 764
+	LegSecurityType                      quickfix.Tag = 609
+	LegSettlType                         quickfix.Tag = 587
+	LegSide                              quickfix.Tag = 624
+	LegStateOrProvinceOfIssue            quickfix.Tag = 597
+	LegStipulationType                   quickfix.Tag = 688
+	LegStipulationValue                  quickfix.Tag = 689
+	LegStrikeCurrency                    quickfix.Tag = 942
+	LegStrikePrice                       quickfix.Tag = 612
+	LegSwapType                          quickfix.Tag = 690
+	LegSymbol                            quickfix.Tag = 600
+	LegSymbolSfx                         quickfix.Tag = 601
+	LocaleOfIssue                        quickfix.Tag = 472
+	LocationID                           quickfix.Tag = 283
+	MDEntryBuyer                         quickfix.Tag = 288
+	MDEntryDate                          quickfix.Tag = 272
+	MDEntryID                            quickfix.Tag = 278
+	MDEntryOriginator                    quickfix.Tag = 282
+	MDEntryPositionNo                    quickfix.Tag = 290
+	MDEntryPx                            quickfix.Tag = 270
+	MDEntryRefID                         quickfix.Tag = 280
+	MDEntrySeller                        quickfix.Tag = 289
+	MDEntrySize                          quickfix.Tag = 271
+	MDEntryTime                          quickfix.Tag = 273
+	MDEntryType                          quickfix.Tag = 269
+	MDMkt                                quickfix.Tag = 275
+	MDReqID                              quickfix.Tag = 262
+	MDUpdateAction                       quickfix.Tag = 279
+	MarginRatio                          quickfix.Tag = 898
+	MaturityDate                         quickfix.Tag = 541
+	MaturityMonthYear                    quickfix.Tag = 200
+	MaxMessageSize                       quickfix.Tag = 383
+	MessageEncoding                      quickfix.Tag = 347
+	MinQty                               quickfix.Tag = 110
+	MinTradeVol                          quickfix.Tag = 562
+	MsgDirection                         quickfix.Tag = 385
+	MsgSeqNum                            quickfix.Tag = 34
+	MsgType                              quickfix.Tag = 35
+	NetChgPrevDay                        quickfix.Tag = 451
+	NextExpectedMsgSeqNum                quickfix.Tag = 789
+	NoEvents                             quickfix.Tag = 864
+	NoHops                               quickfix.Tag = 627
+	NoInstrAttrib                        quickfix.Tag = 870
+	NoLegSecurityAltID                   quickfix.Tag = 604
+	NoLegStipulations                    quickfix.Tag = 683
+	NoLegs                               quickfix.Tag = 555
+	NoMDEntries                          quickfix.Tag = 268
+	NoMsgTypes                           quickfix.Tag = 384
+	NoRelatedSym                         quickfix.Tag = 146
+	NoSecurityAltID                      quickfix.Tag = 454
+	NoStipulations                       quickfix.Tag = 232
+	NoUnderlyingSecurityAltID            quickfix.Tag = 457
+	NoUnderlyingStips                    quickfix.Tag = 887
+	NoUnderlyings                        quickfix.Tag = 711
+	NumberOfOrders                       quickfix.Tag = 346
+	OnBehalfOfCompID                     quickfix.Tag = 115
+	OnBehalfOfLocationID                 quickfix.Tag = 144
+	OnBehalfOfSubID                      quickfix.Tag = 116
+	OpenCloseSettlFlag                   quickfix.Tag = 286
+	OptAttribute                         quickfix.Tag = 206
+	OrderID                              quickfix.Tag = 37
+	OrigSendingTime                      quickfix.Tag = 122
+	Password                             quickfix.Tag = 554
+	PctAtRisk                            quickfix.Tag = 869
+	Pool                                 quickfix.Tag = 691
+	PossDupFlag                          quickfix.Tag = 43
+	PossResend                           quickfix.Tag = 97
+	PriceDelta                           quickfix.Tag = 811
+	Product                              quickfix.Tag = 460
+	QuoteCondition                       quickfix.Tag = 276
+	QuoteEntryID                         quickfix.Tag = 299
+	RawData                              quickfix.Tag = 96
+	RawDataLength                        quickfix.Tag = 95
+	RedemptionDate                       quickfix.Tag = 240
+	RefMsgType                           quickfix.Tag = 372
+	RepoCollateralSecurityType           quickfix.Tag = 239
+	RepurchaseRate                       quickfix.Tag = 227
+	RepurchaseTerm                       quickfix.Tag = 226
+	ResetSeqNumFlag                      quickfix.Tag = 141
+	RoundLot                             quickfix.Tag = 561
+	RptSeq                               quickfix.Tag = 83
+	Scope                                quickfix.Tag = 546
+	SecureData                           quickfix.Tag = 91
+	SecureDataLen                        quickfix.Tag = 90
+	SecurityAltID                        quickfix.Tag = 455
+	SecurityAltIDSource                  quickfix.Tag = 456
+	SecurityDesc                         quickfix.Tag = 107
+	SecurityExchange                     quickfix.Tag = 207
+	SecurityID                           quickfix.Tag = 48
+	SecurityIDSource                     quickfix.Tag = 22
+	SecurityReqID                        quickfix.Tag = 320
+	SecurityRequestResult                quickfix.Tag = 560
+	SecurityResponseID                   quickfix.Tag = 322
+	SecuritySubType                      quickfix.Tag = 762
+	SecurityType                         quickfix.Tag = 167
+	SellerDays                           quickfix.Tag = 287
+	SenderCompID                         quickfix.Tag = 49
+	SenderLocationID                     quickfix.Tag = 142
+	SenderSubID                          quickfix.Tag = 50
+	SendingTime                          quickfix.Tag = 52
+	Signature                            quickfix.Tag = 89
+	SignatureLength                      quickfix.Tag = 93
+	Spread                               quickfix.Tag = 218
+	StartDate                            quickfix.Tag = 916
+	StateOrProvinceOfIssue               quickfix.Tag = 471
+	StipulationType                      quickfix.Tag = 233
+	StipulationValue                     quickfix.Tag = 234
+	StrikeCurrency                       quickfix.Tag = 947
+	StrikePrice                          quickfix.Tag = 202
+	Symbol                               quickfix.Tag = 55
+	SymbolSfx                            quickfix.Tag = 65
+	TargetCompID                         quickfix.Tag = 56
+	TargetLocationID                     quickfix.Tag = 143
+	TargetSubID                          quickfix.Tag = 57
+	TerminationType                      quickfix.Tag = 788
+	TestMessageIndicator                 quickfix.Tag = 464
+	Text                                 quickfix.Tag = 58
+	TickDirection                        quickfix.Tag = 274
+	TimeInForce                          quickfix.Tag = 59
+	TotNoRelatedSym                      quickfix.Tag = 393
+	TradeCondition                       quickfix.Tag = 277
+	TradingSessionID                     quickfix.Tag = 336
+	TradingSessionSubID                  quickfix.Tag = 625
+	UnderlyingCFICode                    quickfix.Tag = 463
+	UnderlyingCPProgram                  quickfix.Tag = 877
+	UnderlyingCPRegType                  quickfix.Tag = 878
+	UnderlyingContractMultiplier         quickfix.Tag = 436
+	UnderlyingCountryOfIssue             quickfix.Tag = 592
+	UnderlyingCouponPaymentDate          quickfix.Tag = 241
+	UnderlyingCouponRate                 quickfix.Tag = 435
+	UnderlyingCreditRating               quickfix.Tag = 256
+	UnderlyingCurrency                   quickfix.Tag = 318
+	UnderlyingCurrentValue               quickfix.Tag = 885
+	UnderlyingDirtyPrice                 quickfix.Tag = 882
+	UnderlyingEndPrice                   quickfix.Tag = 883
+	UnderlyingEndValue                   quickfix.Tag = 886
+	UnderlyingFactor                     quickfix.Tag = 246
+	UnderlyingInstrRegistry              quickfix.Tag = 595
+	UnderlyingIssueDate                  quickfix.Tag = 242
+	UnderlyingIssuer                     quickfix.Tag = 306
+	UnderlyingLocaleOfIssue              quickfix.Tag = 594
+	UnderlyingMaturityDate               quickfix.Tag = 542
+	UnderlyingMaturityMonthYear          quickfix.Tag = 313
+	UnderlyingOptAttribute               quickfix.Tag = 317
+	UnderlyingProduct                    quickfix.Tag = 462
+	UnderlyingPx                         quickfix.Tag = 810
+	UnderlyingQty                        quickfix.Tag = 879
+	UnderlyingRedemptionDate             quickfix.Tag = 247
+	UnderlyingRepoCollateralSecurityType quickfix.Tag = 243
+	UnderlyingRepurchaseRate             quickfix.Tag = 245
+	UnderlyingRepurchaseTerm             quickfix.Tag = 244
+	UnderlyingSecurityAltID              quickfix.Tag = 458
+	UnderlyingSecurityAltIDSource        quickfix.Tag = 459
+	UnderlyingSecurityDesc               quickfix.Tag = 307
+	UnderlyingSecurityExchange           quickfix.Tag = 308
+	UnderlyingSecurityID                 quickfix.Tag = 309
+	UnderlyingSecurityIDSource           quickfix.Tag = 305
+	UnderlyingSecuritySubType            quickfix.Tag = 763
+	UnderlyingSecurityType               quickfix.Tag = 310
+	UnderlyingStartValue                 quickfix.Tag = 884
+	UnderlyingStateOrProvinceOfIssue     quickfix.Tag = 593
+	UnderlyingStipType                   quickfix.Tag = 888
+	UnderlyingStipValue                  quickfix.Tag = 889
+	UnderlyingStrikeCurrency             quickfix.Tag = 941
+	UnderlyingStrikePrice                quickfix.Tag = 316
+	UnderlyingSymbol                     quickfix.Tag = 311
+	UnderlyingSymbolSfx                  quickfix.Tag = 312
+	Username                             quickfix.Tag = 553
+	WaanxAppID                           quickfix.Tag = 20001
+	WaanxAppSig                          quickfix.Tag = 20002
+	WaanxInstrumentType                  quickfix.Tag = 20015
+	WaanxLotSize                         quickfix.Tag = 20011
+	WaanxMinNotional                     quickfix.Tag = 20012
+	WaanxPricePrecision                  quickfix.Tag = 20013
+	WaanxQtyPrecision                    quickfix.Tag = 20014
+	WaanxTickSize                        quickfix.Tag = 20010
+	XmlData                              quickfix.Tag = 213
+	XmlDataLen                           quickfix.Tag = 212
+	Yield                                quickfix.Tag = 236
+	YieldCalcDate                        quickfix.Tag = 701
+	YieldRedemptionDate                  quickfix.Tag = 696
+	YieldRedemptionPrice                 quickfix.Tag = 697
+	YieldRedemptionPriceType             quickfix.Tag = 698
+	YieldType                            quickfix.Tag = 235
+)
@@ -0,0 +1,7 @@
+// Package waanx holds the typed messages, fields and enums generated from the
+// waanx FIX 4.4 data dictionary. Only messages carrying venue extensions are
+// generated: waanx tags (20000+) and RptSeq in market data. Standard ones come
+// from github.com/quickfixgo/fix44.
+package waanx
+
+//go:generate go run ./gen -spec ../../infrastructure/fix/spec/FIX44-Waanx.xml -msgs Logon,SecurityList,MarketDataSnapshotFullRefresh,MarketDataIncrementalRefresh
@@ -0,0 +1,226 @@
+// Package idgen generates the identifiers of the orders and requests sent,
+// unique per day across restarts and across the processes sharing a session.
+package idgen
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/config"
+	"github.com/phimaker/waanx-fix-simpler/internal/logger"
+)
+
+// Kinds of IDs, rendered as {kind}.
+const (
+	KindOrder          = "ORD" // ClOrdID of NewOrderSingle
+	KindCancel         = "CXL" // ClOrdID of OrderCancelRequest
+	KindMassCancel     = "MCX" // ClOrdID of OrderMassCancelRequest
+	KindMassStatus     = "MSR" // MassStatusReqID
+	KindPositions      = "POS" // PosReqID
+	KindMarketData     = "MDR" // MDReqID
+	KindSecurityList   = "SLR" // SecurityReqID
+	KindSecurityStatus = "SSR" // SecurityStatusReqID
+	KindTradingSession = "TSR" // TradSesReqID
+	KindTestRequest    = "TST" // TestReqID
+	KindConsole        = "CON" // messages typed in the console
+)
+
+var kinds = []string{
+	KindOrder, KindCancel, KindMassCancel, KindMassStatus, KindPositions, KindMarketData,
+	KindSecurityList, KindSecurityStatus, KindTradingSession, KindTestRequest, KindConsole,
+}
+
+// DefaultFormat renders IDs like A1ORD20261019-42.
+const DefaultFormat = "{instance}{kind}{date}-{seq}"
+
+// block is the number of sequence numbers reserved with each write of the
+// state file. Those not used before a restart are skipped.
+const block = 1000
+
+// maxSeq is the largest sequence number checked against the max length, a
+// day never reaching it.
+const maxSeq = 999_999_999
+
+type state struct {
+	Date string `json:"date"`
+	Next int64  `json:"next"` // first sequence number not reserved
+}
+
+// Generator generates IDs from a sequence starting over every day (UTC). It
+// is safe for concurrent use.
+type Generator struct {
+	mu        sync.Mutex
+	instance  string
+	format    string
+	formats   map[string]string // by lower case kind
+	maxLength int
+	path      string
+	now       func() time.Time
+
+	date     string
+	seq      int64
+	reserved int64 // last sequence number reserved
+}
+
+type GeneratorOpt func(*Generator)
+
+// WithClock sets the clock giving the date of the IDs.
+func WithClock(now func() time.Time) GeneratorOpt {
+	return func(g *Generator) {
+		g.now = now
+	}
+}
+
+// New creates the generator of cfg, resuming the sequence of the day from
+// its state file. It fails when a format has no {date}, the sequence starting
+// over every day, no {instance} while the instance is set, or could render
+// IDs longer than the max length.
+func New(cfg config.IDs, opts ...GeneratorOpt) (*Generator, error) {
+	g := &Generator{
+		instance:  cfg.Instance,
+		format:    cfg.Format,
+		formats:   make(map[string]string, len(cfg.Formats)),
+		maxLength: cfg.MaxLength,
+		path:      cfg.StatePath,
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	if g.format == "" {
+		g.format = DefaultFormat
+	}
+	for kind, format := range cfg.Formats {
+		g.formats[strings.ToLower(kind)] = format
+	}
+
+	for _, kind := range kinds {
+		format := g.formatOf(kind)
+		if !strings.Contains(format, "{seq}") && !strings.Contains(format, "{seq36}") {
+			return nil, fmt.Errorf("id format %q of %s has no {seq}", format, kind)
+		}
+		if !strings.Contains(format, "{date}") {
+			return nil, fmt.Errorf("id format %q of %s has no {date}, IDs would repeat every day", format, kind)
+		}
+		if g.instance != "" && !strings.Contains(format, "{instance}") {
+			return nil, fmt.Errorf("id format %q of %s has no {instance}, IDs would collide with the other instances", format, kind)
+		}
+		if id := g.render(format, kind, "20060102", maxSeq); g.maxLength > 0 && len(id) > g.maxLength {
+			return nil, fmt.Errorf("id format %q of %s renders IDs up to %d characters, over max-length %d", format, kind, len(id), g.maxLength)
+		}
+	}
+
+	if g.instance == "" {
+		logger.Warn("ids.instance is empty, IDs may collide with other processes sharing the session")
+	}
+
+	if err := g.load(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// load resumes the sequence of the day after the numbers reserved before.
+func (g *Generator) load() error {
+	g.date = g.today()
+	if g.path == "" {
+		return nil
+	}
+	data, err := os.ReadFile(g.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading id state(%s): %w", g.path, err)
+	}
+	var s state
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("error decoding id state(%s): %w", g.path, err)
+	}
+	if s.Date == g.date {
+		g.seq, g.reserved = s.Next-1, s.Next-1
+	}
+	return nil
+}
+
+// Next returns a new ID of kind. It fails when the sequence could not be
+// persisted, or the ID is longer than the max length.
+func (g *Generator) Next(kind string) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if today := g.today(); today != g.date {
+		g.date, g.seq, g.reserved = today, 0, 0
+	}
+	if g.seq == g.reserved {
+		if err := g.save(state{Date: g.date, Next: g.reserved + block + 1}); err != nil {
+			return "", err
+		}
+		g.reserved += block
+	}
+	g.seq++
+	id := g.render(g.formatOf(kind), kind, g.date, g.seq)
+	if g.maxLength > 0 && len(id) > g.maxLength {
+		return "", fmt.Errorf("id %s over max-length %d", id, g.maxLength)
+	}
+	return id, nil
+}
+
+// save writes the state file, replacing it atomically.
+func (g *Generator) save(s state) error {
+	if g.path == "" {
+		return nil
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("error encoding id state: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(g.path), filepath.Base(g.path)+".*")
+	if err != nil {
+		return fmt.Errorf("error writing id state(%s): %w", g.path, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error writing id state(%s): %w", g.path, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error syncing id state(%s): %w", g.path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing id state(%s): %w", g.path, err)
+	}
+	if err := os.Rename(tmp.Name(), g.path); err != nil {
+		return fmt.Errorf("error writing id state(%s): %w", g.path, err)
+	}
+	return nil
+}
+
+func (g *Generator) formatOf(kind string) string {
+	if format, ok := g.formats[strings.ToLower(kind)]; ok {
+		return format
+	}
+	return g.format
+}
+
+func (g *Generator) render(format, kind, date string, seq int64) string {
+	return strings.NewReplacer(
+		"{instance}", g.instance,
+		"{kind}", kind,
+		"{date}", date,
+		"{seq}", strconv.FormatInt(seq, 10),
+		"{seq36}", strings.ToUpper(strconv.FormatInt(seq, 36)),
+	).Replace(format)
+}
+
+func (g *Generator) today() string {
+	return g.now().UTC().Format("20060102")
+}
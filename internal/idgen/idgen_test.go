@@ -0,0 +1,133 @@
+package idgen
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/config"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.IDs
+		wantErr string
+	}{
+		{name: "default format", cfg: config.IDs{Instance: "A1"}},
+		{name: "no instance", cfg: config.IDs{Format: "{kind}{date}-{seq}"}},
+		{name: "no seq", cfg: config.IDs{Format: "{kind}{date}"}, wantErr: "has no {seq}"},
+		{name: "no date", cfg: config.IDs{Format: "{kind}-{seq}"}, wantErr: "has no {date}"},
+		{
+			name:    "no instance in format",
+			cfg:     config.IDs{Instance: "A1", Format: "{kind}{date}-{seq}"},
+			wantErr: "has no {instance}",
+		},
+		{
+			name:    "no instance in a kind format",
+			cfg:     config.IDs{Instance: "A1", Formats: map[string]string{"ord": "{date}{seq36}"}},
+			wantErr: "has no {instance}",
+		},
+		{name: "too long", cfg: config.IDs{Instance: "A1", MaxLength: 16}, wantErr: "over max-length"},
+		{name: "short enough", cfg: config.IDs{Instance: "A1", Format: "{instance}{date}{seq36}", MaxLength: 16}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.cfg)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("New() = %v", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Fatalf("New() = %v, want an error with %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGeneratorNext(t *testing.T) {
+	day := time.Date(2026, 10, 19, 23, 59, 0, 0, time.UTC)
+	nextDay := day.Add(2 * time.Minute)
+
+	// Each step creates a generator at the clock of the step, as a restart
+	// does, then takes IDs from it.
+	type step struct {
+		now  time.Time
+		kind string
+		want []string
+	}
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "restart resumes after the reserved block",
+			steps: []step{
+				{day, KindOrder, []string{"A1ORD20261019-1", "A1ORD20261019-2"}},
+				{day, KindCancel, []string{"A1CXL20261019-1001", "A1CXL20261019-1002"}},
+				{day, KindOrder, []string{"A1ORD20261019-2001"}},
+			},
+		},
+		{
+			name: "restart on the next day starts over",
+			steps: []step{
+				{day, KindOrder, []string{"A1ORD20261019-1"}},
+				{nextDay, KindOrder, []string{"A1ORD20261020-1", "A1ORD20261020-2"}},
+				{nextDay, KindOrder, []string{"A1ORD20261020-1001"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.IDs{Instance: "A1", StatePath: filepath.Join(t.TempDir(), "ids.json")}
+			for i, step := range tt.steps {
+				now := step.now
+				g, err := New(cfg, WithClock(func() time.Time { return now }))
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, want := range step.want {
+					if got, err := g.Next(step.kind); err != nil || got != want {
+						t.Fatalf("step %d: Next() = %s, %v, want %s", i, got, err, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratorNextDayChange(t *testing.T) {
+	now := time.Date(2026, 10, 19, 23, 59, 59, 0, time.UTC)
+	g, err := New(config.IDs{Instance: "A1", StatePath: filepath.Join(t.TempDir(), "ids.json")},
+		WithClock(func() time.Time { return now }))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		at   time.Time
+		want string
+	}{
+		{now, "A1ORD20261019-1"},
+		{now, "A1ORD20261019-2"},
+		{now.Add(time.Second), "A1ORD20261020-1"},
+		{now.Add(time.Hour), "A1ORD20261020-2"},
+	}
+	for _, tt := range tests {
+		now = tt.at
+		if got, err := g.Next(KindOrder); err != nil || got != tt.want {
+			t.Errorf("Next() at %s = %s, %v, want %s", tt.at, got, err, tt.want)
+		}
+	}
+}
+
+func TestGeneratorNextMaxLength(t *testing.T) {
+	g, err := New(config.IDs{Instance: "A1", Format: "{instance}{date}{seq36}", MaxLength: 16})
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.seq, g.reserved = maxSeq*100, maxSeq*200
+	if id, err := g.Next(KindOrder); err == nil {
+		t.Errorf("Next() = %s, want an error over max-length", id)
+	}
+}
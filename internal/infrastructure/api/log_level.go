@@ -0,0 +1,54 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/logger"
+)
+
+type levelPayload struct {
+	Component string `json:"component,omitempty"`
+	Level     string `json:"level"`
+}
+
+type levelsResponse struct {
+	Level      string            `json:"level"`
+	Components map[string]string `json:"components"`
+}
+
+// LogLevelHandler returns an http.Handler to inspect and change log levels.
+//
+//	GET  returns the root level and the effective level of each component.
+//	PUT  {"level":"debug"} changes the root level,
+//	     {"component":"fix","level":"debug"} changes one component and
+//	     {"component":"fix","level":""} makes it follow the root level again.
+func LogLevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			var req levelPayload
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				WriteError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+				return
+			}
+			if err := logger.SetComponentLevel(req.Component, req.Level); err != nil {
+				WriteError(w, http.StatusBadRequest, err)
+				return
+			}
+			logger.Infof("Log level changed: component=%q level=%q", req.Component, req.Level)
+		default:
+			w.Header().Set("Allow", "GET, PUT, POST")
+			WriteError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+
+		resp := levelsResponse{Level: logger.GetLevel().String(), Components: map[string]string{}}
+		for _, name := range logger.Components() {
+			resp.Components[name] = logger.Named(name).Level().String()
+		}
+		WriteJSON(w, http.StatusOK, resp)
+	})
+}
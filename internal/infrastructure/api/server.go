@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/logger"
+)
+
+var log = logger.Named(logger.ComponentHTTP)
+
+// Server is the adapter's HTTP API used for administration and queries.
+type Server struct {
+	srv *http.Server
+	mux *http.ServeMux
+}
+
+// NewServer creates a Server listening on addr. Handlers are registered with
+// Handle before calling Start.
+func NewServer(addr string) *Server {
+	mux := http.NewServeMux()
+	s := &Server{
+		mux: mux,
+		srv: &http.Server{
+			Addr:              addr,
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+		},
+	}
+	s.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		WriteJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	return s
+}
+
+// Handle registers h for the given pattern.
+func (s *Server) Handle(pattern string, h http.Handler) {
+	log.Debugf("Adding handler for %s", pattern)
+	s.mux.Handle(pattern, h)
+}
+
+// HandleFunc registers fn for the given pattern.
+func (s *Server) HandleFunc(pattern string, fn http.HandlerFunc) {
+	s.Handle(pattern, fn)
+}
+
+// Start serves requests until ctx is done or Stop is called.
+func (s *Server) Start(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+		s.Stop()
+	}()
+
+	log.Infof("Starting HTTP API on %s", s.srv.Addr)
+	if err := s.srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Errorf("Error serving HTTP API: %v", err)
+	}
+}
+
+// Stop gracefully shuts the server down.
+func (s *Server) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.srv.Shutdown(ctx); err != nil {
+		log.Errorf("Error stopping HTTP API: %v", err)
+	}
+}
+
+// WriteJSON writes v as a JSON response with the given status code.
+func WriteJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Errorf("Error writing response: %v", err)
+	}
+}
+
+// WriteError writes err as a JSON error response.
+func WriteError(w http.ResponseWriter, status int, err error) {
+	WriteJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,57 @@
+// Package db connects to the PostgreSQL database of the adapter.
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/config"
+
+	_ "github.com/lib/pq"
+)
+
+// Open connects to the database of cfg and checks it is reachable.
+func Open(ctx context.Context, cfg *config.Db) (*sql.DB, error) {
+	db, err := sql.Open("postgres", dsn(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("error opening database: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("error connecting to database %s:%d: %w", cfg.Host, cfg.Port, err)
+	}
+	return db, nil
+}
+
+// Configured reports whether cfg points to a database.
+func Configured(cfg *config.Db) bool {
+	return cfg != nil && cfg.Host != ""
+}
+
+func dsn(cfg *config.Db) string {
+	params := []string{"host=" + quote(cfg.Host)}
+	if cfg.Port != 0 {
+		params = append(params, fmt.Sprintf("port=%d", cfg.Port))
+	}
+	for _, p := range [][2]string{
+		{"user", cfg.User},
+		{"password", cfg.Password},
+		{"dbname", cfg.DBName},
+		{"sslmode", cfg.SSLMode},
+		{"timezone", cfg.TimeZone},
+	} {
+		if p[1] != "" {
+			params = append(params, p[0]+"="+quote(p[1]))
+		}
+	}
+	return strings.Join(params, " ")
+}
+
+func quote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
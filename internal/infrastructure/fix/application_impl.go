@@ -5,31 +5,62 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/phimaker/waanx-fix-simpler/internal/logger"
 	"github.com/quickfixgo/enum"
 	"github.com/quickfixgo/field"
 	"github.com/quickfixgo/quickfix"
+	"go.uber.org/zap/zapcore"
 )
 
+var log = logger.Named(logger.ComponentFix)
+
 type FixApplication interface {
 	quickfix.Application
 
 	AddRouter(beginString string, msgType string, router quickfix.MessageRoute)
+	// AddObserver registers fn to see every message sent or received.
+	AddObserver(fn MessageObserver)
+	// AddFilter registers fn to check every application message before it
+	// is sent.
+	AddFilter(fn OutgoingFilter)
+	// AddLogoutHandler registers fn to be called when a session logs out or
+	// is disconnected.
+	AddLogoutHandler(fn func(sessionID quickfix.SessionID))
 }
 
-type fixApplicationOpt func(*fixApplicationImpl)
+// MessageObserver is called with DirectionIn or DirectionOut for every
+// message. It must not block.
+type MessageObserver func(direction string, msg *quickfix.Message, sessionID quickfix.SessionID)
+
+// OutgoingFilter is called from ToApp with every application message about to
+// be sent. An error stops the message: it is not sent, does not use a
+// sequence number and the error is returned to the sender.
+type OutgoingFilter func(msg *quickfix.Message, sessionID quickfix.SessionID) error
+
+// FixApplicationOpt configures the application created by NewApplication.
+type FixApplicationOpt func(*fixApplicationImpl)
 
 type fixApplicationImpl struct {
-	username string
-	password string
-	router   *quickfix.MessageRouter
+	username  string
+	password  string
+	router    *quickfix.MessageRouter
+	msgLog    *MessageLogger
+	recorder  Recorder
+	validator *Validator
+	testReqID func() (string, error)
+
+	observersMu sync.RWMutex
+	observers   []MessageObserver
+	filters     []OutgoingFilter
+	onLogout    []func(sessionID quickfix.SessionID)
 
 	logonHandler func(msg *quickfix.Message, sessionID quickfix.SessionID) quickfix.MessageRejectError
 }
 
-func NewApplication(opts ...fixApplicationOpt) (FixApplication, error) {
+func NewApplication(opts ...FixApplicationOpt) (FixApplication, error) {
 	e := &fixApplicationImpl{
 		username: "",
 		password: "",
@@ -43,35 +74,117 @@ func NewApplication(opts ...fixApplicationOpt) (FixApplication, error) {
 		opt(e)
 	}
 
+	if e.msgLog == nil {
+		dd, err := LoadDataDictionary("")
+		if err != nil {
+			return nil, err
+		}
+		e.msgLog = NewMessageLogger(NewDecoder(dd), LogFormatPretty)
+	}
+
 	return e, nil
 }
 
-func WithUsername(username string) fixApplicationOpt {
+func WithUsername(username string) FixApplicationOpt {
 	return func(c *fixApplicationImpl) {
 		c.username = username
 	}
 }
 
-func WithPassword(password string) fixApplicationOpt {
+func WithPassword(password string) FixApplicationOpt {
 	return func(c *fixApplicationImpl) {
 		c.password = password
 	}
 }
 
+// WithMessageLogger sets the logger used for every message sent or received.
+func WithMessageLogger(l *MessageLogger) FixApplicationOpt {
+	return func(c *fixApplicationImpl) {
+		c.msgLog = l
+	}
+}
+
+// WithRecorder captures every message sent or received with r.
+func WithRecorder(r Recorder) FixApplicationOpt {
+	return func(c *fixApplicationImpl) {
+		c.recorder = r
+	}
+}
+
+// WithTestReqIDs sets the TestReqID of the TestRequests sent from next,
+// instead of the fixed one of the engine.
+func WithTestReqIDs(next func() (string, error)) FixApplicationOpt {
+	return func(c *fixApplicationImpl) {
+		c.testReqID = next
+	}
+}
+
+// WithValidator checks every message received against v.
+func WithValidator(v *Validator) FixApplicationOpt {
+	return func(c *fixApplicationImpl) {
+		c.validator = v
+	}
+}
+
+func (e *fixApplicationImpl) AddObserver(fn MessageObserver) {
+	e.observersMu.Lock()
+	defer e.observersMu.Unlock()
+	e.observers = append(e.observers, fn)
+}
+
+func (e *fixApplicationImpl) AddFilter(fn OutgoingFilter) {
+	e.observersMu.Lock()
+	defer e.observersMu.Unlock()
+	e.filters = append(e.filters, fn)
+}
+
+func (e *fixApplicationImpl) AddLogoutHandler(fn func(sessionID quickfix.SessionID)) {
+	e.observersMu.Lock()
+	defer e.observersMu.Unlock()
+	e.onLogout = append(e.onLogout, fn)
+}
+
+func (e *fixApplicationImpl) filter(msg *quickfix.Message, sessionID quickfix.SessionID) error {
+	e.observersMu.RLock()
+	defer e.observersMu.RUnlock()
+	for _, fn := range e.filters {
+		if err := fn(msg, sessionID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *fixApplicationImpl) observe(direction string, msg *quickfix.Message, sessionID quickfix.SessionID) {
+	if e.recorder != nil {
+		e.recorder.Record(direction, msg)
+	}
+	e.observersMu.RLock()
+	defer e.observersMu.RUnlock()
+	for _, fn := range e.observers {
+		fn(direction, msg, sessionID)
+	}
+}
+
 // OnCreate implemented as part of Application interface
 func (e *fixApplicationImpl) OnCreate(sessionID quickfix.SessionID) {
-	logger.Infof("[ON_CREATE]: %s", sessionID.String())
+	log.Infof("[ON_CREATE]: %s", sessionID.String())
 }
 
 // OnLogon implemented as part of Application interface
 func (e *fixApplicationImpl) OnLogon(sessionID quickfix.SessionID) {
-	logger.Infof("[LOGGED_ON]: %s", sessionID.String())
+	log.Infof("[LOGGED_ON]: %s", sessionID.String())
 	e.logonHandler(&quickfix.Message{}, sessionID)
 }
 
 // OnLogout implemented as part of Application interface
 func (e *fixApplicationImpl) OnLogout(sessionID quickfix.SessionID) {
-	logger.Warnf("[LOGGED_OUT]: %s", sessionID.String())
+	log.Warnf("[LOGGED_OUT]: %s", sessionID.String())
+	e.observersMu.RLock()
+	defer e.observersMu.RUnlock()
+	for _, fn := range e.onLogout {
+		fn(sessionID)
+	}
 }
 
 func generateRawData() (string, error) {
@@ -122,22 +235,29 @@ func generatewaanxAppSig(rawData, appSecret string) string {
 
 // FromAdmin implemented as part of Application interface
 func (e *fixApplicationImpl) FromAdmin(msg *quickfix.Message, sessionID quickfix.SessionID) (reject quickfix.MessageRejectError) {
-	logger.Infof("[FROM_ADMIN] %s", msg.String())
-	return nil
+	e.observe(DirectionIn, msg, sessionID)
+	e.msgLog.Log(zapcore.InfoLevel, "FROM_ADMIN", msg)
+	if reject := e.validator.Check(DirectionIn, msg); reject != nil {
+		return reject
+	}
+	// Admin messages without a router, all but Heartbeat and Reject, are
+	// left to the engine.
+	return e.router.Route(msg, sessionID)
 }
 
 // ToAdmin implemented as part of Application interface
 func (e *fixApplicationImpl) ToAdmin(msg *quickfix.Message, sessionID quickfix.SessionID) {
-	logger.Infof("[TO_ADMIN] %s", msg.String())
+	defer e.observe(DirectionOut, msg, sessionID)
+	defer e.msgLog.Log(zapcore.InfoLevel, "TO_ADMIN", msg)
 
 	msgType, err := msg.MsgType()
 	if err != nil {
-		logger.Error(fmt.Sprintf("MsgType not found in message: %v", err))
+		log.Error(fmt.Sprintf("MsgType not found in message: %v", err))
 		return
 	}
 
 	if msgType == "" {
-		logger.Error("MsgType is empty")
+		log.Error("MsgType is empty")
 		return
 	}
 
@@ -164,41 +284,58 @@ func (e *fixApplicationImpl) ToAdmin(msg *quickfix.Message, sessionID quickfix.S
 		}
 
 	case enum.MsgType_HEARTBEAT:
-		logger.Infof("Sending Heartbeat to %s", sessionID.String())
+		log.Infof("Sending Heartbeat to %s", sessionID.String())
 
 	case enum.MsgType_TEST_REQUEST:
-		logger.Infof("Sending TestRequest to %s", sessionID.String())
-		// msg.SetField(field.NewTestReqID("test"))
+		log.Infof("Sending TestRequest to %s", sessionID.String())
+		if e.testReqID != nil {
+			id, err := e.testReqID()
+			if err != nil {
+				log.Errorf("Error generating TestReqID, keeping the engine's: %v", err)
+				return
+			}
+			msg.Body.Set(field.NewTestReqID(id))
+		}
 
 	default:
-		logger.Infof("MsgType %s not handled", msgType)
+		log.Infof("MsgType %s not handled", msgType)
 		return
 	}
 }
 
 // ToApp implemented as part of Application interface
 func (e *fixApplicationImpl) ToApp(msg *quickfix.Message, sessionID quickfix.SessionID) (err error) {
-	logger.Infof("[TO_APP] %s", msg.String())
+	if err := e.filter(msg, sessionID); err != nil {
+		e.msgLog.Log(zapcore.WarnLevel, "BLOCKED", msg)
+		log.Warnf("[BLOCKED] Not sending message: %v", err)
+		return err
+	}
+	e.observe(DirectionOut, msg, sessionID)
+	e.msgLog.Log(zapcore.InfoLevel, "TO_APP", msg)
 	return
 }
 
 // FromApp implemented as part of Application interface. This is the callback for all Application level messages from the counter party.
 func (e *fixApplicationImpl) FromApp(msg *quickfix.Message, sessionID quickfix.SessionID) (reject quickfix.MessageRejectError) {
+	e.observe(DirectionIn, msg, sessionID)
 	msgType, err := msg.MsgType()
 	if err != nil {
-		logger.Error(fmt.Sprintf("MsgType not found in message: %v", err))
+		log.Error(fmt.Sprintf("MsgType not found in message: %v", err))
 		return nil
 	}
 	if msgType != "y" && msgType != "W" {
-		logger.Infof("[FROM_APP] %s", msg.String())
+		e.msgLog.Log(zapcore.InfoLevel, "FROM_APP", msg)
 	} else {
-		logger.Debugf("[FROM_APP] %s", msg.String())
+		e.msgLog.Log(zapcore.DebugLevel, "FROM_APP", msg)
+	}
+	if reject := e.validator.Check(DirectionIn, msg); reject != nil {
+		return reject
 	}
 	return e.router.Route(msg, sessionID)
 }
 
 func (e *fixApplicationImpl) AddRouter(beginString string, msgType string, router quickfix.MessageRoute) {
-	logger.Infof("Adding router for %s %#v", msgType, router)
+	log.Infof("Adding router for %s %#v", msgType, router)
 	if msgType == "A" {
 		e.logonHandler = router
 	} else {
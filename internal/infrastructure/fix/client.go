@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/quickfixgo/quickfix"
+	"github.com/quickfixgo/quickfix/config"
 	"github.com/quickfixgox/zaplog"
 	"go.uber.org/zap/zapcore"
 )
@@ -16,8 +17,42 @@ type Client struct {
 	application quickfix.Application
 }
 
+// ClientOpt configures the client created by NewClient.
+type ClientOpt func(*clientOptions)
+
+type clientOptions struct {
+	dictionary   string
+	validation   ValidationMode
+	resetOnLogon bool
+}
+
+// WithDataDictionary loads the data dictionary at path, or the embedded waanx
+// one when path is empty, into every session. The quickfix validator rejects
+// violations, so it is only enabled in enforce mode; in report mode the
+// application's Validator sees the messages instead.
+func WithDataDictionary(path string, mode ValidationMode) ClientOpt {
+	return func(o *clientOptions) {
+		o.dictionary = path
+		o.validation = mode
+	}
+}
+
+// WithResetOnLogon logs on with ResetSeqNumFlag=Y, both sides starting the
+// sequence numbers over at 1, whatever the session config file says. It
+// applies to every logon of the client, reconnects included.
+func WithResetOnLogon() ClientOpt {
+	return func(o *clientOptions) {
+		o.resetOnLogon = true
+	}
+}
+
 // NewClient creates a new FIX Client with the specified configuration file.
-func NewClient(cfgFileName string, app quickfix.Application) (*Client, error) {
+func NewClient(cfgFileName string, app quickfix.Application, opts ...ClientOpt) (*Client, error) {
+	o := &clientOptions{validation: ValidationOff}
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	// Open configuration file
 	cfg, err := os.Open(cfgFileName)
 	if err != nil {
@@ -31,8 +66,20 @@ func NewClient(cfgFileName string, app quickfix.Application) (*Client, error) {
 		return nil, fmt.Errorf("error parsing config file: %w", err)
 	}
 
-	if err != nil {
-		return nil, fmt.Errorf("error creating application: %w", err)
+	if o.validation == ValidationEnforce {
+		path, err := DataDictionaryFile(o.dictionary)
+		if err != nil {
+			return nil, err
+		}
+		global := settings.GlobalSettings()
+		global.Set(config.DataDictionary, path)
+		global.Set(config.RejectInvalidMessage, "Y")
+	}
+
+	if o.resetOnLogon {
+		if settings, err = resetOnLogon(settings); err != nil {
+			return nil, err
+		}
 	}
 
 	// Create message store factory
@@ -68,6 +115,21 @@ func NewClient(cfgFileName string, app quickfix.Application) (*Client, error) {
 	}, nil
 }
 
+// resetOnLogon returns settings with ResetOnLogon set for every session, the
+// sections of the sessions overriding the global one.
+func resetOnLogon(settings *quickfix.Settings) (*quickfix.Settings, error) {
+	out := quickfix.NewSettings()
+	*out.GlobalSettings() = *settings.GlobalSettings()
+	out.GlobalSettings().Set(config.ResetOnLogon, "Y")
+	for _, session := range settings.SessionSettings() {
+		session.Set(config.ResetOnLogon, "Y")
+		if _, err := out.AddSession(session); err != nil {
+			return nil, fmt.Errorf("error setting ResetOnLogon: %w", err)
+		}
+	}
+	return out, nil
+}
+
 // Start begins the FIX session managed by the initiator.
 func (c *Client) Start() error {
 	return c.Initiator.Start()
@@ -0,0 +1,86 @@
+package fix
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/quickfixgo/quickfix"
+	"github.com/quickfixgo/quickfix/datadictionary"
+	"github.com/quickfixgo/tag"
+)
+
+// ComposeMessage builds a message from "35=V|262=...|..." text, as typed by a
+// user. Framing fields (8, 9, 10) and session fields (34, 49, 52, 56) are left
+// to the session and repeating groups are laid out with the data dictionary,
+// so the result can be passed straight to SendToTarget.
+func ComposeMessage(dd *datadictionary.DataDictionary, text string) (*quickfix.Message, error) {
+	// A decoder without sensitive tags, values must be sent as typed.
+	d := &Decoder{dd: dd, sensitive: map[int]bool{}}
+	m, err := d.DecodeRaw(strings.TrimSpace(text))
+	if err != nil {
+		return nil, err
+	}
+	if m.MsgType == "" {
+		return nil, fmt.Errorf("missing MsgType (35)")
+	}
+	def, ok := dd.Messages[m.MsgType]
+	if !ok {
+		return nil, fmt.Errorf("unknown MsgType %q", m.MsgType)
+	}
+
+	msg := quickfix.NewMessage()
+	msg.Header.SetString(tag.BeginString, beginString(dd))
+	msg.Header.SetString(tag.MsgType, m.MsgType)
+
+	var body []DecodedField
+	for _, f := range m.Fields {
+		switch quickfix.Tag(f.Tag) {
+		case tag.BeginString, tag.BodyLength, tag.CheckSum, tag.MsgType,
+			tag.SenderCompID, tag.TargetCompID, tag.MsgSeqNum, tag.SendingTime:
+			continue
+		}
+		if _, ok := dd.Header.Fields[f.Tag]; ok {
+			msg.Header.SetString(quickfix.Tag(f.Tag), f.Value)
+			continue
+		}
+		body = append(body, f)
+	}
+	setFields(&msg.Body.FieldMap, body, def.Fields)
+	return msg, nil
+}
+
+func setFields(fm *quickfix.FieldMap, fields []DecodedField, defs map[int]*datadictionary.FieldDef) {
+	for _, f := range fields {
+		def, ok := defs[f.Tag]
+		if !ok || !def.IsGroup() {
+			fm.SetString(quickfix.Tag(f.Tag), f.Value)
+			continue
+		}
+
+		children := make(map[int]*datadictionary.FieldDef, len(def.Fields))
+		for _, child := range def.Fields {
+			children[child.Tag()] = child
+		}
+		grp := quickfix.NewRepeatingGroup(quickfix.Tag(f.Tag), groupTemplate(def))
+		for _, entry := range f.Groups {
+			setFields(&grp.Add().FieldMap, entry, children)
+		}
+		fm.SetGroup(grp)
+	}
+}
+
+func groupTemplate(def *datadictionary.FieldDef) quickfix.GroupTemplate {
+	t := make(quickfix.GroupTemplate, 0, len(def.Fields))
+	for _, child := range def.Fields {
+		if child.IsGroup() {
+			t = append(t, quickfix.NewRepeatingGroup(quickfix.Tag(child.Tag()), groupTemplate(child)))
+		} else {
+			t = append(t, quickfix.GroupElement(quickfix.Tag(child.Tag())))
+		}
+	}
+	return t
+}
+
+func beginString(dd *datadictionary.DataDictionary) string {
+	return fmt.Sprintf("%s.%d.%d", dd.FIXType, dd.Major, dd.Minor)
+}
@@ -0,0 +1,317 @@
+package fix
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/quickfixgo/quickfix"
+	"github.com/quickfixgo/quickfix/datadictionary"
+	"github.com/quickfixgo/tag"
+)
+
+const soh = '\x01'
+
+const maskedValue = "****"
+
+// DefaultSensitiveTags are masked in every decoded or logged message.
+var DefaultSensitiveTags = []int{
+	int(tag.RawData),
+	int(tag.Password),
+	int(tag.NewPassword),
+}
+
+// DecodedField is a single tag=value pair annotated from the data dictionary.
+// Groups holds the entries of a repeating group when the field is a NoXxx count.
+type DecodedField struct {
+	Tag    int              `json:"tag"`
+	Name   string           `json:"name,omitempty"`
+	Value  string           `json:"value"`
+	Enum   string           `json:"enum,omitempty"`
+	Groups [][]DecodedField `json:"groups,omitempty"`
+}
+
+// DecodedMessage is a FIX message split into named fields.
+type DecodedMessage struct {
+	MsgType      string         `json:"msgType"`
+	MsgTypeName  string         `json:"msgTypeName,omitempty"`
+	SeqNum       int            `json:"seqNum,omitempty"`
+	SenderCompID string         `json:"senderCompID,omitempty"`
+	TargetCompID string         `json:"targetCompID,omitempty"`
+	Fields       []DecodedField `json:"fields"`
+}
+
+// Decoder decodes raw FIX messages using a data dictionary.
+type Decoder struct {
+	dd        *datadictionary.DataDictionary
+	sensitive map[int]bool
+}
+
+// NewDecoder creates a Decoder. Values of the sensitive tags are masked, in
+// addition to DefaultSensitiveTags.
+func NewDecoder(dd *datadictionary.DataDictionary, sensitiveTags ...int) *Decoder {
+	d := &Decoder{dd: dd, sensitive: map[int]bool{}}
+	for _, t := range append(DefaultSensitiveTags, sensitiveTags...) {
+		d.sensitive[t] = true
+	}
+	return d
+}
+
+// DataDictionary returns the dictionary used by the decoder.
+func (d *Decoder) DataDictionary() *datadictionary.DataDictionary {
+	return d.dd
+}
+
+type tagValue struct {
+	tag   int
+	value string
+}
+
+func splitRaw(raw string, sep byte) ([]tagValue, error) {
+	var tvs []tagValue
+	for _, part := range strings.Split(strings.TrimRight(raw, string(sep)), string(sep)) {
+		if part == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid field %q", part)
+		}
+		t, err := strconv.Atoi(k)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag %q", k)
+		}
+		tvs = append(tvs, tagValue{tag: t, value: v})
+	}
+	return tvs, nil
+}
+
+// Decode decodes a quickfix message.
+func (d *Decoder) Decode(msg *quickfix.Message) (*DecodedMessage, error) {
+	return d.DecodeRaw(msg.String())
+}
+
+// DecodeRaw decodes a message in wire format. Besides SOH, '|' is accepted
+// as field separator so messages copied from logs can be decoded as well.
+func (d *Decoder) DecodeRaw(raw string) (*DecodedMessage, error) {
+	var sep byte = soh
+	if !strings.ContainsRune(raw, soh) && strings.Contains(raw, "|") {
+		sep = '|'
+	}
+	tvs, err := splitRaw(raw, sep)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &DecodedMessage{}
+	for _, tv := range tvs {
+		switch quickfix.Tag(tv.tag) {
+		case tag.MsgType:
+			m.MsgType = tv.value
+		case tag.MsgSeqNum:
+			m.SeqNum, _ = strconv.Atoi(tv.value)
+		case tag.SenderCompID:
+			m.SenderCompID = tv.value
+		case tag.TargetCompID:
+			m.TargetCompID = tv.value
+		}
+	}
+
+	var body map[int]*datadictionary.FieldDef
+	if def, ok := d.dd.Messages[m.MsgType]; ok {
+		m.MsgTypeName = def.Name
+		body = def.Fields
+	}
+
+	for i := 0; i < len(tvs); {
+		def := d.lookup(tvs[i].tag, body)
+		f := d.field(tvs[i])
+		if def != nil && def.IsGroup() {
+			f.Groups, i = d.decodeGroup(tvs, i, def)
+		} else {
+			i++
+		}
+		m.Fields = append(m.Fields, f)
+	}
+	return m, nil
+}
+
+func (d *Decoder) lookup(t int, body map[int]*datadictionary.FieldDef) *datadictionary.FieldDef {
+	if def, ok := body[t]; ok {
+		return def
+	}
+	if def, ok := d.dd.Header.Fields[t]; ok {
+		return def
+	}
+	if def, ok := d.dd.Trailer.Fields[t]; ok {
+		return def
+	}
+	return nil
+}
+
+// decodeGroup decodes the repeating group whose count field is at tvs[i] and
+// returns its entries along with the index of the first field after it.
+func (d *Decoder) decodeGroup(tvs []tagValue, i int, def *datadictionary.FieldDef) ([][]DecodedField, int) {
+	count, _ := strconv.Atoi(tvs[i].value)
+	i++
+
+	children := make(map[int]*datadictionary.FieldDef, len(def.Fields))
+	for _, child := range def.Fields {
+		children[child.Tag()] = child
+	}
+	delim := def.Fields[0].Tag()
+
+	var groups [][]DecodedField
+	for len(groups) < count && i < len(tvs) && tvs[i].tag == delim {
+		var entry []DecodedField
+		for first := true; i < len(tvs); first = false {
+			child, ok := children[tvs[i].tag]
+			if !ok || (tvs[i].tag == delim && !first) {
+				break
+			}
+			f := d.field(tvs[i])
+			if child.IsGroup() {
+				f.Groups, i = d.decodeGroup(tvs, i, child)
+			} else {
+				i++
+			}
+			entry = append(entry, f)
+		}
+		groups = append(groups, entry)
+	}
+	return groups, i
+}
+
+func (d *Decoder) field(tv tagValue) DecodedField {
+	f := DecodedField{Tag: tv.tag, Value: tv.value}
+	if ft, ok := d.dd.FieldTypeByTag[tv.tag]; ok {
+		f.Name = ft.Name()
+		if e, ok := ft.Enums[tv.value]; ok {
+			f.Enum = e.Description
+		}
+	}
+	if d.sensitive[tv.tag] {
+		f.Value = maskedValue
+	}
+	return f
+}
+
+// Mask returns raw, a message in wire format, with the values of the
+// sensitive tags masked. Unlike Decode it works on messages that do not
+// parse.
+func (d *Decoder) Mask(raw string) string {
+	return maskRaw(raw, d.sensitive)
+}
+
+func maskRaw(raw string, sensitive map[int]bool) string {
+	fields := strings.Split(raw, string(soh))
+	for i, f := range fields {
+		t, _, ok := strings.Cut(f, "=")
+		if !ok {
+			continue
+		}
+		if n, err := strconv.Atoi(t); err == nil && sensitive[n] {
+			fields[i] = t + "=" + maskedValue
+		}
+	}
+	return strings.Join(fields, string(soh))
+}
+
+// Raw returns the message in wire format with '|' as separator and
+// sensitive values masked.
+func (m *DecodedMessage) Raw() string {
+	var b strings.Builder
+	writeRaw(&b, m.Fields)
+	return b.String()
+}
+
+func writeRaw(b *strings.Builder, fields []DecodedField) {
+	for _, f := range fields {
+		fmt.Fprintf(b, "%d=%s|", f.Tag, f.Value)
+		for _, g := range f.Groups {
+			writeRaw(b, g)
+		}
+	}
+}
+
+// Pretty returns a single line, human readable form of the message, e.g.
+// Symbol(55)=BTC Side(54)=1(BUY) NoPartyIDs(453)=1 [PartyID(448)=X].
+func (m *DecodedMessage) Pretty() string {
+	return pretty(m.Fields)
+}
+
+func pretty(fields []DecodedField) string {
+	parts := make([]string, 0, len(fields))
+	for _, f := range fields {
+		var b strings.Builder
+		if f.Name != "" {
+			fmt.Fprintf(&b, "%s(%d)=%s", f.Name, f.Tag, f.Value)
+		} else {
+			fmt.Fprintf(&b, "%d=%s", f.Tag, f.Value)
+		}
+		if f.Enum != "" {
+			fmt.Fprintf(&b, "(%s)", f.Enum)
+		}
+		for _, g := range f.Groups {
+			fmt.Fprintf(&b, " [%s]", pretty(g))
+		}
+		parts = append(parts, b.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+// Map returns the fields keyed by name (or tag number when the dictionary
+// has no name for it). Repeating groups become lists of maps.
+func (m *DecodedMessage) Map() map[string]any {
+	return fieldMap(m.Fields)
+}
+
+func fieldMap(fields []DecodedField) map[string]any {
+	out := make(map[string]any, len(fields))
+	for _, f := range fields {
+		key := f.Name
+		if key == "" {
+			key = strconv.Itoa(f.Tag)
+		}
+		if len(f.Groups) == 0 {
+			out[key] = f.Value
+			continue
+		}
+		groups := make([]map[string]any, 0, len(f.Groups))
+		for _, g := range f.Groups {
+			groups = append(groups, fieldMap(g))
+		}
+		out[key] = groups
+	}
+	return out
+}
+
+// Get returns the value of the first top level field with the given tag.
+func (m *DecodedMessage) Get(t int) (string, bool) {
+	for _, f := range m.Fields {
+		if f.Tag == t {
+			return f.Value, true
+		}
+	}
+	return "", false
+}
+
+// Find returns the value of the first field with the given tag, looking into
+// repeating groups as well.
+func (m *DecodedMessage) Find(t int) (string, bool) {
+	return findField(m.Fields, t)
+}
+
+func findField(fields []DecodedField, t int) (string, bool) {
+	for _, f := range fields {
+		if f.Tag == t {
+			return f.Value, true
+		}
+		for _, g := range f.Groups {
+			if v, ok := findField(g, t); ok {
+				return v, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,56 @@
+package fix
+
+import (
+	"bytes"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/quickfixgo/quickfix/datadictionary"
+)
+
+// waanxSpec is the FIX 4.4 dictionary extended with the waanx custom tags
+// (20000+): logon signature fields and instrument trading rules.
+//
+//go:embed spec/FIX44-Waanx.xml
+var waanxSpec []byte
+
+// LoadDataDictionary parses the data dictionary at path, or the embedded
+// waanx FIX 4.4 dictionary when path is empty.
+func LoadDataDictionary(path string) (*datadictionary.DataDictionary, error) {
+	if path == "" {
+		dd, err := datadictionary.ParseSrc(bytes.NewReader(waanxSpec))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing embedded data dictionary: %w", err)
+		}
+		return dd, nil
+	}
+
+	dd, err := datadictionary.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing data dictionary(%s): %w", path, err)
+	}
+	return dd, nil
+}
+
+// DataDictionaryFile returns path, or a file holding the embedded waanx
+// dictionary when path is empty. quickfix sessions only load dictionaries
+// from disk.
+func DataDictionaryFile(path string) (string, error) {
+	if path != "" {
+		return path, nil
+	}
+
+	sum := sha256.Sum256(waanxSpec)
+	name := filepath.Join(os.TempDir(), fmt.Sprintf("FIX44-Waanx-%s.xml", hex.EncodeToString(sum[:4])))
+	if _, err := os.Stat(name); err == nil {
+		return name, nil
+	}
+	if err := os.WriteFile(name, waanxSpec, 0o644); err != nil {
+		return "", fmt.Errorf("error writing embedded data dictionary: %w", err)
+	}
+	return name, nil
+}
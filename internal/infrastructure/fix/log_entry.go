@@ -0,0 +1,75 @@
+package fix
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Message directions as found in FIX logs.
+const (
+	DirectionIn  = "IN"
+	DirectionOut = "OUT"
+)
+
+// quickfix file logs prefix every message with log.Ldate|log.Ltime|log.Lmicroseconds.
+const quickfixLogTimeLayout = "2006/01/02 15:04:05.000000"
+
+// LogEntry is a FIX message extracted from a log line.
+type LogEntry struct {
+	Time      time.Time
+	Direction string
+	Raw       string
+}
+
+// ParseLogLine extracts a FIX message from a single log line. It understands
+// quickfix file logs, the zaplog message files written under FileLogPath, the
+// adapter's own JSON logs in raw format, and bare messages. ok is false when
+// the line holds no FIX message.
+func ParseLogLine(line string) (e LogEntry, ok bool) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return e, false
+	}
+
+	text := line
+	if strings.HasPrefix(line, "{") {
+		var rec map[string]any
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			return e, false
+		}
+		if ts, ok := rec["ts"].(string); ok {
+			e.Time, _ = time.Parse("2006-01-02T15:04:05.000Z0700", ts)
+		}
+		msg, _ := rec["msg"].(string)
+		e.Direction = directionOf(msg)
+		text = msg
+		if raw, ok := rec["raw"].(string); ok {
+			text = raw
+		}
+	} else {
+		if len(line) > len(quickfixLogTimeLayout) {
+			if t, err := time.Parse(quickfixLogTimeLayout, line[:len(quickfixLogTimeLayout)]); err == nil {
+				e.Time = t
+			}
+		}
+		e.Direction = directionOf(line)
+	}
+
+	start := strings.Index(text, "8=FIX")
+	if start < 0 {
+		return e, false
+	}
+	e.Raw = text[start:]
+	return e, true
+}
+
+func directionOf(s string) string {
+	switch {
+	case strings.Contains(s, "INCOMING"), strings.Contains(s, "FROM_APP"), strings.Contains(s, "FROM_ADMIN"):
+		return DirectionIn
+	case strings.Contains(s, "OUTGOING"), strings.Contains(s, "TO_APP"), strings.Contains(s, "TO_ADMIN"):
+		return DirectionOut
+	}
+	return ""
+}
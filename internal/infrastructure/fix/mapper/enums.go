@@ -0,0 +1,116 @@
+// Package mapper converts between fix44 messages and the domain types.
+package mapper
+
+import (
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	waanxenum "github.com/phimaker/waanx-fix-simpler/internal/fix/waanx/enum"
+	"github.com/quickfixgo/enum"
+	"github.com/shopspring/decimal"
+)
+
+var sides = map[enum.Side]domain.Side{
+	enum.Side_BUY:  domain.SideBuy,
+	enum.Side_SELL: domain.SideSell,
+}
+
+func Side(s enum.Side) domain.Side {
+	return sides[s]
+}
+
+func FIXSide(s domain.Side) enum.Side {
+	if s == domain.SideSell {
+		return enum.Side_SELL
+	}
+	return enum.Side_BUY
+}
+
+var orderTypes = map[enum.OrdType]domain.OrderType{
+	enum.OrdType_MARKET: domain.OrderTypeMarket,
+	enum.OrdType_LIMIT:  domain.OrderTypeLimit,
+}
+
+func OrderType(t enum.OrdType) domain.OrderType {
+	return orderTypes[t]
+}
+
+func FIXOrderType(t domain.OrderType) enum.OrdType {
+	if t == domain.OrderTypeMarket {
+		return enum.OrdType_MARKET
+	}
+	return enum.OrdType_LIMIT
+}
+
+var timesInForce = map[enum.TimeInForce]domain.TimeInForce{
+	enum.TimeInForce_DAY:                 domain.TimeInForceDay,
+	enum.TimeInForce_GOOD_TILL_CANCEL:    domain.TimeInForceGTC,
+	enum.TimeInForce_IMMEDIATE_OR_CANCEL: domain.TimeInForceIOC,
+	enum.TimeInForce_FILL_OR_KILL:        domain.TimeInForceFOK,
+}
+
+func TimeInForce(t enum.TimeInForce) domain.TimeInForce {
+	return timesInForce[t]
+}
+
+func FIXTimeInForce(t domain.TimeInForce) enum.TimeInForce {
+	for k, v := range timesInForce {
+		if v == t {
+			return k
+		}
+	}
+	return enum.TimeInForce_GOOD_TILL_CANCEL
+}
+
+var orderStatuses = map[enum.OrdStatus]domain.OrderStatus{
+	enum.OrdStatus_PENDING_NEW:      domain.OrderStatusPendingNew,
+	enum.OrdStatus_NEW:              domain.OrderStatusNew,
+	enum.OrdStatus_PARTIALLY_FILLED: domain.OrderStatusPartiallyFilled,
+	enum.OrdStatus_FILLED:           domain.OrderStatusFilled,
+	enum.OrdStatus_PENDING_CANCEL:   domain.OrderStatusPendingCancel,
+	enum.OrdStatus_CANCELED:         domain.OrderStatusCanceled,
+	enum.OrdStatus_PENDING_REPLACE:  domain.OrderStatusPendingReplace,
+	enum.OrdStatus_REPLACED:         domain.OrderStatusReplaced,
+	enum.OrdStatus_REJECTED:         domain.OrderStatusRejected,
+	enum.OrdStatus_EXPIRED:          domain.OrderStatusExpired,
+	enum.OrdStatus_DONE_FOR_DAY:     domain.OrderStatusExpired,
+}
+
+func OrderStatus(s enum.OrdStatus) domain.OrderStatus {
+	return orderStatuses[s]
+}
+
+var execTypes = map[enum.ExecType]domain.ExecType{
+	enum.ExecType_NEW:            domain.ExecTypeNew,
+	enum.ExecType_TRADE:          domain.ExecTypeTrade,
+	enum.ExecType_CANCELED:       domain.ExecTypeCanceled,
+	enum.ExecType_REPLACED:       domain.ExecTypeReplaced,
+	enum.ExecType_PENDING_CANCEL: domain.ExecTypePendingCancel,
+	enum.ExecType_REJECTED:       domain.ExecTypeRejected,
+	enum.ExecType_EXPIRED:        domain.ExecTypeExpired,
+	enum.ExecType_PENDING_NEW:    domain.ExecTypePendingNew,
+	enum.ExecType_ORDER_STATUS:   domain.ExecTypeOrderStatus,
+}
+
+func ExecType(t enum.ExecType) domain.ExecType {
+	if v, ok := execTypes[t]; ok {
+		return v
+	}
+	return domain.ExecTypeOther
+}
+
+var instrumentTypes = map[waanxenum.WaanxInstrumentType]domain.InstrumentType{
+	waanxenum.WaanxInstrumentType_SPOT:      domain.InstrumentSpot,
+	waanxenum.WaanxInstrumentType_PERPETUAL: domain.InstrumentPerpetual,
+	waanxenum.WaanxInstrumentType_FUTURE:    domain.InstrumentFuture,
+}
+
+func InstrumentType(t waanxenum.WaanxInstrumentType) domain.InstrumentType {
+	return instrumentTypes[t]
+}
+
+// scale is the number of decimals to send d with.
+func scale(d decimal.Decimal) int32 {
+	if e := d.Exponent(); e < 0 {
+		return -e
+	}
+	return 0
+}
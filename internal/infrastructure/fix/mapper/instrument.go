@@ -0,0 +1,35 @@
+package mapper
+
+import (
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/fix/waanx/fix44/securitylist"
+)
+
+// Instrument maps a SecurityList entry, including the waanx trading rules.
+func Instrument(g securitylist.NoRelatedSym) domain.Instrument {
+	var i domain.Instrument
+	i.Symbol, _ = g.GetSymbol()
+	i.SecurityID, _ = g.GetSecurityID()
+	if t, err := g.GetWaanxInstrumentType(); err == nil {
+		i.Type = InstrumentType(t)
+	}
+	i.TickSize, _ = g.GetWaanxTickSize()
+	i.LotSize, _ = g.GetWaanxLotSize()
+	i.MinNotional, _ = g.GetWaanxMinNotional()
+	i.PricePrecision, _ = g.GetWaanxPricePrecision()
+	i.QtyPrecision, _ = g.GetWaanxQtyPrecision()
+	return i
+}
+
+// Instruments maps every entry of a SecurityList.
+func Instruments(msg securitylist.SecurityList) ([]domain.Instrument, error) {
+	groups, err := msg.GetNoRelatedSym()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.Instrument, 0, groups.Len())
+	for i := 0; i < groups.Len(); i++ {
+		out = append(out, Instrument(groups.Get(i)))
+	}
+	return out, nil
+}
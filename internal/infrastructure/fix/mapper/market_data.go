@@ -0,0 +1,155 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/fix/waanx/enum"
+	"github.com/phimaker/waanx-fix-simpler/internal/fix/waanx/fix44/marketdataincrementalrefresh"
+	"github.com/phimaker/waanx-fix-simpler/internal/fix/waanx/fix44/marketdatasnapshotfullrefresh"
+	"github.com/quickfixgo/quickfix"
+	"github.com/quickfixgo/tag"
+)
+
+var updateActions = map[enum.MDUpdateAction]domain.UpdateAction{
+	enum.MDUpdateAction_NEW:    domain.UpdateNew,
+	enum.MDUpdateAction_CHANGE: domain.UpdateChange,
+	enum.MDUpdateAction_DELETE: domain.UpdateDelete,
+}
+
+// Book maps a MarketDataSnapshotFullRefresh to the book and the trades it
+// carries. Entries without MDEntryDate/MDEntryTime take the SendingTime.
+func Book(msg marketdatasnapshotfullrefresh.MarketDataSnapshotFullRefresh) (domain.Book, []domain.Trade, quickfix.MessageRejectError) {
+	book := domain.Book{Time: sendingTime(msg.Header.Header)}
+	book.Symbol, _ = msg.GetSymbol()
+	book.Seq, _ = msg.GetRptSeq()
+
+	groups, err := msg.GetNoMDEntries()
+	if err != nil {
+		return book, nil, err
+	}
+
+	var trades []domain.Trade
+	for i := 0; i < groups.Len(); i++ {
+		g := groups.Get(i)
+		typ, _ := g.GetMDEntryType()
+		px, _ := g.GetMDEntryPx()
+		size, _ := g.GetMDEntrySize()
+		date, _ := g.GetMDEntryDate()
+		tm, _ := g.GetMDEntryTime()
+		at := entryTime(date, tm, book.Time)
+
+		switch typ {
+		case enum.MDEntryType_BID, enum.MDEntryType_OFFER:
+			level := domain.BookLevel{Side: levelSide(typ), Price: px, Size: size}
+			level.Position, _ = g.GetMDEntryPositionNo()
+			level.NumOrders, _ = g.GetNumberOfOrders()
+			if typ == enum.MDEntryType_BID {
+				book.Bids = append(book.Bids, level)
+			} else {
+				book.Asks = append(book.Asks, level)
+			}
+		case enum.MDEntryType_TRADE:
+			t := domain.Trade{Symbol: book.Symbol, Price: px, Size: size, Time: at, TradeID: tradeID(g)}
+			trades = append(trades, t)
+		}
+	}
+	return book, trades, nil
+}
+
+// BookUpdates maps a MarketDataIncrementalRefresh to book updates and
+// trades. Entries without a Symbol take the one of the previous entry.
+func BookUpdates(msg marketdataincrementalrefresh.MarketDataIncrementalRefresh) ([]domain.BookUpdate, []domain.Trade, quickfix.MessageRejectError) {
+	groups, err := msg.GetNoMDEntries()
+	if err != nil {
+		return nil, nil, err
+	}
+	sent := sendingTime(msg.Header.Header)
+
+	var (
+		updates []domain.BookUpdate
+		trades  []domain.Trade
+		symbol  string
+	)
+	for i := 0; i < groups.Len(); i++ {
+		g := groups.Get(i)
+		if s, err := g.GetSymbol(); err == nil {
+			symbol = s
+		}
+		typ, _ := g.GetMDEntryType()
+		px, _ := g.GetMDEntryPx()
+		size, _ := g.GetMDEntrySize()
+		date, _ := g.GetMDEntryDate()
+		tm, _ := g.GetMDEntryTime()
+		at := entryTime(date, tm, sent)
+
+		switch typ {
+		case enum.MDEntryType_BID, enum.MDEntryType_OFFER:
+			action, _ := g.GetMDUpdateAction()
+			u := domain.BookUpdate{
+				Symbol: symbol,
+				Action: updateActions[action],
+				Level:  domain.BookLevel{Side: levelSide(typ), Price: px, Size: size},
+				Time:   at,
+			}
+			u.Level.Position, _ = g.GetMDEntryPositionNo()
+			u.Level.NumOrders, _ = g.GetNumberOfOrders()
+			u.Seq, _ = g.GetRptSeq()
+			updates = append(updates, u)
+		case enum.MDEntryType_TRADE:
+			t := domain.Trade{Symbol: symbol, Price: px, Size: size, Time: at, TradeID: tradeID(g)}
+			t.Seq, _ = g.GetRptSeq()
+			trades = append(trades, t)
+		}
+	}
+	return updates, trades, nil
+}
+
+// tradeEntry is an MDEntry of a snapshot or an incremental.
+type tradeEntry interface {
+	GetMDEntryID() (string, quickfix.MessageRejectError)
+	GetOrderID() (string, quickfix.MessageRejectError)
+}
+
+// tradeID is the MDEntryID of a trade, or its OrderID when the venue sends
+// none. Snapshots and incrementals must agree for the tape to drop a print
+// seen in both.
+func tradeID(g tradeEntry) string {
+	if id, _ := g.GetMDEntryID(); id != "" {
+		return id
+	}
+	id, _ := g.GetOrderID()
+	return id
+}
+
+func levelSide(t enum.MDEntryType) domain.Side {
+	if t == enum.MDEntryType_BID {
+		return domain.SideBuy
+	}
+	return domain.SideSell
+}
+
+func sendingTime(h *quickfix.Header) time.Time {
+	t, err := h.GetTime(tag.SendingTime)
+	if err != nil {
+		return time.Now().UTC()
+	}
+	return t
+}
+
+// entryTime combines MDEntryDate (YYYYMMDD) and MDEntryTime (HH:MM:SS[.sss]),
+// falling back to the date of fallback when only the time is set.
+func entryTime(date, tm string, fallback time.Time) time.Time {
+	if tm == "" {
+		return fallback
+	}
+	if date == "" {
+		date = fallback.UTC().Format("20060102")
+	}
+	for _, layout := range []string{"20060102 15:04:05.000000", "20060102 15:04:05.000", "20060102 15:04:05"} {
+		if t, err := time.Parse(layout, date+" "+tm); err == nil {
+			return t
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,185 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/quickfixgo/enum"
+	"github.com/quickfixgo/field"
+	"github.com/quickfixgo/fix44/executionreport"
+	"github.com/quickfixgo/fix44/newordersingle"
+	"github.com/quickfixgo/fix44/ordercancelrequest"
+	"github.com/quickfixgo/fix44/ordermasscancelrequest"
+	"github.com/quickfixgo/fix44/ordermassstatusrequest"
+	"github.com/quickfixgo/fix44/orderstatusrequest"
+	"github.com/quickfixgo/quickfix"
+)
+
+// Execution maps an ExecutionReport.
+func Execution(msg executionreport.ExecutionReport) (domain.Execution, quickfix.MessageRejectError) {
+	var e domain.Execution
+	var err quickfix.MessageRejectError
+	if e.ExecID, err = msg.GetExecID(); err != nil {
+		return e, err
+	}
+	if e.OrderID, err = msg.GetOrderID(); err != nil {
+		return e, err
+	}
+	execType, err := msg.GetExecType()
+	if err != nil {
+		return e, err
+	}
+	ordStatus, err := msg.GetOrdStatus()
+	if err != nil {
+		return e, err
+	}
+	side, err := msg.GetSide()
+	if err != nil {
+		return e, err
+	}
+	e.ExecType = ExecType(execType)
+	e.OrdStatus = OrderStatus(ordStatus)
+	e.Side = Side(side)
+
+	e.ClOrdID, _ = msg.GetClOrdID()
+	e.OrigClOrdID, _ = msg.GetOrigClOrdID()
+	e.Account, _ = msg.GetAccount()
+	e.Symbol, _ = msg.GetSymbol()
+	e.Price, _ = msg.GetPrice()
+	e.Qty, _ = msg.GetOrderQty()
+	e.LastPx, _ = msg.GetLastPx()
+	e.LastQty, _ = msg.GetLastQty()
+	e.CumQty, _ = msg.GetCumQty()
+	e.LeavesQty, _ = msg.GetLeavesQty()
+	e.AvgPx, _ = msg.GetAvgPx()
+	e.Text, _ = msg.GetText()
+	if e.Time, err = msg.GetTransactTime(); err != nil {
+		e.Time = sendingTime(msg.Header.Header)
+	}
+	return e, nil
+}
+
+// NewOrderSingle builds the message sending o.
+func NewOrderSingle(o domain.Order) newordersingle.NewOrderSingle {
+	msg := newordersingle.New(
+		field.NewClOrdID(o.ClOrdID),
+		field.NewSide(FIXSide(o.Side)),
+		field.NewTransactTime(time.Now()),
+		field.NewOrdType(FIXOrderType(o.Type)),
+	)
+	msg.SetSymbol(o.Symbol)
+	msg.SetOrderQty(o.Qty, scale(o.Qty))
+	if o.Account != "" {
+		msg.SetAccount(o.Account)
+	}
+	if o.Type == domain.OrderTypeLimit {
+		msg.SetPrice(o.Price, scale(o.Price))
+	}
+	if o.TimeInForce != "" {
+		msg.SetTimeInForce(FIXTimeInForce(o.TimeInForce))
+	}
+	return msg
+}
+
+// Order maps a NewOrderSingle back to the order it sends.
+func Order(msg newordersingle.NewOrderSingle) (domain.Order, quickfix.MessageRejectError) {
+	var o domain.Order
+	var err quickfix.MessageRejectError
+	if o.ClOrdID, err = msg.GetClOrdID(); err != nil {
+		return o, err
+	}
+	side, err := msg.GetSide()
+	if err != nil {
+		return o, err
+	}
+	ordType, err := msg.GetOrdType()
+	if err != nil {
+		return o, err
+	}
+	o.Side = Side(side)
+	o.Type = OrderType(ordType)
+
+	o.Account, _ = msg.GetAccount()
+	o.Symbol, _ = msg.GetSymbol()
+	o.Qty, _ = msg.GetOrderQty()
+	o.Price, _ = msg.GetPrice()
+	if tif, err := msg.GetTimeInForce(); err == nil {
+		o.TimeInForce = TimeInForce(tif)
+	}
+	o.Status = domain.OrderStatusPendingNew
+	o.Created, _ = msg.GetTransactTime()
+	return o, nil
+}
+
+// OrderCancelRequest builds the message canceling o with clOrdID.
+func OrderCancelRequest(o domain.Order, clOrdID string) ordercancelrequest.OrderCancelRequest {
+	msg := ordercancelrequest.New(
+		field.NewOrigClOrdID(o.ClOrdID),
+		field.NewClOrdID(clOrdID),
+		field.NewSide(FIXSide(o.Side)),
+		field.NewTransactTime(time.Now()),
+	)
+	msg.SetSymbol(o.Symbol)
+	msg.SetOrderQty(o.Qty, scale(o.Qty))
+	if o.OrderID != "" {
+		msg.SetOrderID(o.OrderID)
+	}
+	return msg
+}
+
+// OrderStatusRequest builds the request of the status of o.
+func OrderStatusRequest(o domain.Order) orderstatusrequest.OrderStatusRequest {
+	msg := orderstatusrequest.New(
+		field.NewClOrdID(o.ClOrdID),
+		field.NewSide(FIXSide(o.Side)),
+	)
+	msg.SetSymbol(o.Symbol)
+	if o.OrderID != "" {
+		msg.SetOrderID(o.OrderID)
+	}
+	return msg
+}
+
+// OrderMassStatusRequest builds the request of the status of the orders in
+// scope.
+func OrderMassStatusRequest(massStatusReqID string, scope domain.OrderScope) ordermassstatusrequest.OrderMassStatusRequest {
+	reqType := enum.MassStatusReqType_STATUS_FOR_ALL_ORDERS
+	if scope.Symbol != "" {
+		reqType = enum.MassStatusReqType_STATUS_FOR_ORDERS_FOR_A_SECURITY
+	}
+	msg := ordermassstatusrequest.New(
+		field.NewMassStatusReqID(massStatusReqID),
+		field.NewMassStatusReqType(reqType),
+	)
+	if scope.Symbol != "" {
+		msg.SetSymbol(scope.Symbol)
+	}
+	if scope.Account != "" {
+		msg.SetAccount(scope.Account)
+	}
+	if scope.Side != "" {
+		msg.SetSide(FIXSide(scope.Side))
+	}
+	return msg
+}
+
+// OrderMassCancelRequest builds the request canceling the orders of a symbol,
+// or all of them, on a side or both. FIX 4.4 has no account to cancel by.
+func OrderMassCancelRequest(clOrdID string, scope domain.OrderScope) ordermasscancelrequest.OrderMassCancelRequest {
+	reqType := enum.MassCancelRequestType_CANCEL_ALL_ORDERS
+	if scope.Symbol != "" {
+		reqType = enum.MassCancelRequestType_CANCEL_ORDERS_FOR_A_SECURITY
+	}
+	msg := ordermasscancelrequest.New(
+		field.NewClOrdID(clOrdID),
+		field.NewMassCancelRequestType(reqType),
+		field.NewTransactTime(time.Now()),
+	)
+	if scope.Symbol != "" {
+		msg.SetSymbol(scope.Symbol)
+	}
+	if scope.Side != "" {
+		msg.SetSide(FIXSide(scope.Side))
+	}
+	return msg
+}
@@ -0,0 +1,56 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/quickfixgo/enum"
+	"github.com/quickfixgo/field"
+	"github.com/quickfixgo/fix44/positionreport"
+	"github.com/quickfixgo/fix44/requestforpositions"
+	"github.com/quickfixgo/quickfix"
+)
+
+// RequestForPositions builds the request of the positions of account on the
+// business date of at.
+func RequestForPositions(posReqID, account string, at time.Time) requestforpositions.RequestForPositions {
+	return requestforpositions.New(
+		field.NewPosReqID(posReqID),
+		field.NewPosReqType(enum.PosReqType_POSITIONS),
+		field.NewAccount(account),
+		field.NewAccountType(enum.AccountType_HOUSE_TRADER),
+		field.NewClearingBusinessDate(at.UTC().Format("20060102")),
+		field.NewTransactTime(at),
+	)
+}
+
+// Position maps a PositionReport to the net position it reports: long minus
+// short quantity of its total transaction quantity entry, or of its first
+// entry without one.
+func Position(msg positionreport.PositionReport) (domain.Position, quickfix.MessageRejectError) {
+	var p domain.Position
+	var err quickfix.MessageRejectError
+	if p.Account, err = msg.GetAccount(); err != nil {
+		return p, err
+	}
+	if p.Symbol, err = msg.GetSymbol(); err != nil {
+		return p, err
+	}
+	p.Updated = sendingTime(msg.Header.Header)
+
+	positions, err := msg.GetNoPositions()
+	if err != nil || positions.Len() == 0 {
+		return p, nil
+	}
+	entry := positions.Get(0)
+	for i := 0; i < positions.Len(); i++ {
+		if posType, _ := positions.Get(i).GetPosType(); posType == enum.PosType_TOTAL_TRANSACTION_QTY {
+			entry = positions.Get(i)
+			break
+		}
+	}
+	long, _ := entry.GetLongQty()
+	short, _ := entry.GetShortQty()
+	p.Qty = long.Sub(short)
+	return p, nil
+}
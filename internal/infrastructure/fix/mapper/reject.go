@@ -0,0 +1,112 @@
+package mapper
+
+import (
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/quickfixgo/enum"
+	"github.com/quickfixgo/fix44/businessmessagereject"
+	"github.com/quickfixgo/fix44/marketdatarequestreject"
+	"github.com/quickfixgo/fix44/reject"
+)
+
+var sessionRejectReasons = map[enum.SessionRejectReason]string{
+	enum.SessionRejectReason_INVALID_TAG_NUMBER:                             "invalid_tag",
+	enum.SessionRejectReason_REQUIRED_TAG_MISSING:                           "required_tag_missing",
+	enum.SessionRejectReason_TAG_NOT_DEFINED_FOR_THIS_MESSAGE_TYPE:          "tag_not_defined",
+	enum.SessionRejectReason_UNDEFINED_TAG:                                  "undefined_tag",
+	enum.SessionRejectReason_TAG_SPECIFIED_WITHOUT_A_VALUE:                  "tag_without_value",
+	enum.SessionRejectReason_VALUE_IS_INCORRECT:                             "incorrect_value",
+	enum.SessionRejectReason_INCORRECT_DATA_FORMAT_FOR_VALUE:                "incorrect_format",
+	enum.SessionRejectReason_DECRYPTION_PROBLEM:                             "decryption",
+	enum.SessionRejectReason_SIGNATURE_PROBLEM:                              "signature",
+	enum.SessionRejectReason_COMPID_PROBLEM:                                 "compid",
+	enum.SessionRejectReason_SENDINGTIME_ACCURACY_PROBLEM:                   "sending_time",
+	enum.SessionRejectReason_INVALID_MSGTYPE:                                "invalid_msg_type",
+	enum.SessionRejectReason_XML_VALIDATION_ERROR:                           "xml_validation",
+	enum.SessionRejectReason_TAG_APPEARS_MORE_THAN_ONCE:                     "repeated_tag",
+	enum.SessionRejectReason_TAG_SPECIFIED_OUT_OF_REQUIRED_ORDER:            "tag_out_of_order",
+	enum.SessionRejectReason_REPEATING_GROUP_FIELDS_OUT_OF_ORDER:            "group_out_of_order",
+	enum.SessionRejectReason_INCORRECT_NUMINGROUP_COUNT_FOR_REPEATING_GROUP: "group_count",
+	enum.SessionRejectReason_NON_DATA_VALUE_INCLUDES_FIELD_DELIMITER:        "field_delimiter",
+	enum.SessionRejectReason_OTHER:                                          "other",
+}
+
+var businessRejectReasons = map[enum.BusinessRejectReason]string{
+	enum.BusinessRejectReason_OTHER:                                     "other",
+	enum.BusinessRejectReason_UNKNOWN_ID:                                "unknown_id",
+	enum.BusinessRejectReason_UNKNOWN_SECURITY:                          "unknown_security",
+	enum.BusinessRejectReason_UNSUPPORTED_MESSAGE_TYPE:                  "unsupported_msg_type",
+	enum.BusinessRejectReason_APPLICATION_NOT_AVAILABLE:                 "application_not_available",
+	enum.BusinessRejectReason_CONDITIONALLY_REQUIRED_FIELD_MISSING:      "required_field_missing",
+	enum.BusinessRejectReason_NOT_AUTHORIZED:                            "not_authorized",
+	enum.BusinessRejectReason_DELIVERTO_FIRM_NOT_AVAILABLE_AT_THIS_TIME: "deliver_to_firm_not_available",
+	// From later FIX versions, declared in the Waanx spec.
+	"8": domain.RejectReasonThrottled,
+}
+
+var marketDataRejectReasons = map[enum.MDReqRejReason]string{
+	enum.MDReqRejReason_UNKNOWN_SYMBOL:                      "unknown_symbol",
+	enum.MDReqRejReason_DUPLICATE_MDREQID:                   "duplicate_md_req_id",
+	enum.MDReqRejReason_INSUFFICIENT_BANDWIDTH:              "insufficient_bandwidth",
+	enum.MDReqRejReason_INSUFFICIENT_PERMISSIONS:            "insufficient_permissions",
+	enum.MDReqRejReason_UNSUPPORTED_SUBSCRIPTIONREQUESTTYPE: "unsupported_subscription_type",
+	enum.MDReqRejReason_UNSUPPORTED_MARKETDEPTH:             "unsupported_market_depth",
+	enum.MDReqRejReason_UNSUPPORTED_MDUPDATETYPE:            "unsupported_update_type",
+	enum.MDReqRejReason_UNSUPPORTED_AGGREGATEDBOOK:          "unsupported_aggregated_book",
+	enum.MDReqRejReason_UNSUPPORTED_MDENTRYTYPE:             "unsupported_entry_type",
+	enum.MDReqRejReason_UNSUPPORTED_TRADINGSESSIONID:        "unsupported_trading_session",
+	enum.MDReqRejReason_UNSUPPORTED_SCOPE:                   "unsupported_scope",
+	enum.MDReqRejReason_UNSUPPORTED_OPENCLOSESETTLEFLAG:     "unsupported_open_close_settle_flag",
+	enum.MDReqRejReason_UNSUPPORTED_MDIMPLICITDELETE:        "unsupported_implicit_delete",
+	enum.MDReqRejReason_INSUFFICIENT_CREDIT:                 "insufficient_credit",
+}
+
+// reason names a reject reason, its value when unknown.
+func reason[T ~string](names map[T]string, r T) string {
+	if name, ok := names[r]; ok {
+		return name
+	}
+	return string(r)
+}
+
+// SessionReject maps a Reject. The rejected request is only known by its
+// RefSeqNum.
+func SessionReject(msg reject.Reject) domain.Reject {
+	r := domain.Reject{Kind: domain.RejectSession, Time: sendingTime(msg.Header.Header)}
+	r.RefSeqNum, _ = msg.GetRefSeqNum()
+	r.RefMsgType, _ = msg.GetRefMsgType()
+	r.RefTagID, _ = msg.GetRefTagID()
+	r.Text, _ = msg.GetText()
+	r.Reason = "other"
+	if rr, err := msg.GetSessionRejectReason(); err == nil {
+		r.Reason = reason(sessionRejectReasons, rr)
+	}
+	return r
+}
+
+// BusinessReject maps a BusinessMessageReject.
+func BusinessReject(msg businessmessagereject.BusinessMessageReject) domain.Reject {
+	r := domain.Reject{Kind: domain.RejectBusiness, Time: sendingTime(msg.Header.Header)}
+	r.RefSeqNum, _ = msg.GetRefSeqNum()
+	r.RefMsgType, _ = msg.GetRefMsgType()
+	r.RefID, _ = msg.GetBusinessRejectRefID()
+	r.Text, _ = msg.GetText()
+	rr, _ := msg.GetBusinessRejectReason()
+	r.Reason = reason(businessRejectReasons, rr)
+	return r
+}
+
+// MarketDataReject maps a MarketDataRequestReject.
+func MarketDataReject(msg marketdatarequestreject.MarketDataRequestReject) domain.Reject {
+	r := domain.Reject{
+		Kind:       domain.RejectMarketData,
+		RefMsgType: string(enum.MsgType_MARKET_DATA_REQUEST),
+		Time:       sendingTime(msg.Header.Header),
+	}
+	r.RefID, _ = msg.GetMDReqID()
+	r.Text, _ = msg.GetText()
+	r.Reason = "other"
+	if rr, err := msg.GetMDReqRejReason(); err == nil {
+		r.Reason = reason(marketDataRejectReasons, rr)
+	}
+	return r
+}
@@ -0,0 +1,92 @@
+package fix
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/quickfixgo/quickfix"
+	"go.uber.org/zap/zapcore"
+)
+
+// LogFormat selects how message bodies are written by MessageLogger.
+type LogFormat string
+
+const (
+	// LogFormatRaw logs the wire format with '|' separators.
+	LogFormatRaw LogFormat = "raw"
+	// LogFormatPretty logs a single line of Name(tag)=value pairs.
+	LogFormatPretty LogFormat = "pretty"
+	// LogFormatJSON logs the decoded fields as a structured object.
+	LogFormatJSON LogFormat = "json"
+)
+
+// ParseLogFormat parses a LogFormat, defaulting to pretty when s is empty.
+func ParseLogFormat(s string) (LogFormat, error) {
+	switch f := LogFormat(strings.ToLower(s)); f {
+	case "":
+		return LogFormatPretty, nil
+	case LogFormatRaw, LogFormatPretty, LogFormatJSON:
+		return f, nil
+	}
+	return "", fmt.Errorf("unknown FIX log format %q", s)
+}
+
+// MessageLogger writes FIX messages as structured log entries.
+type MessageLogger struct {
+	decoder *Decoder
+	format  atomic.Value
+}
+
+func NewMessageLogger(decoder *Decoder, format LogFormat) *MessageLogger {
+	l := &MessageLogger{decoder: decoder}
+	l.SetFormat(format)
+	return l
+}
+
+// SetFormat changes the format used for subsequent messages.
+func (l *MessageLogger) SetFormat(format LogFormat) {
+	l.format.Store(format)
+}
+
+func (l *MessageLogger) Format() LogFormat {
+	return l.format.Load().(LogFormat)
+}
+
+// Decoder returns the decoder used to annotate messages.
+func (l *MessageLogger) Decoder() *Decoder {
+	return l.decoder
+}
+
+// Log writes msg at the given level, prefixed with the direction, e.g. FROM_APP.
+func (l *MessageLogger) Log(lvl zapcore.Level, direction string, msg *quickfix.Message) {
+	if !log.Enabled(lvl) {
+		return
+	}
+
+	m, err := l.decoder.Decode(msg)
+	if err != nil {
+		log.Sugar().Logw(lvl, "["+direction+"]", "error", err, "raw", strings.ReplaceAll(l.decoder.Mask(msg.String()), string(soh), "|"))
+		return
+	}
+
+	kv := []interface{}{
+		"msgType", m.MsgTypeName,
+		"seqNum", m.SeqNum,
+		"sender", m.SenderCompID,
+		"target", m.TargetCompID,
+	}
+	if m.MsgTypeName == "" {
+		kv[1] = m.MsgType
+	}
+
+	switch l.Format() {
+	case LogFormatRaw:
+		kv = append(kv, "raw", m.Raw())
+	case LogFormatJSON:
+		kv = append(kv, "fields", m.Map())
+	default:
+		kv = append(kv, "msg", m.Pretty())
+	}
+	log.Sugar().Logw(lvl, "["+direction+"]", kv...)
+}
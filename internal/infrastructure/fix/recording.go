@@ -0,0 +1,154 @@
+package fix
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/quickfixgo/quickfix"
+)
+
+// recordingMagic starts every recording file.
+const recordingMagic = "WXREC1\n"
+
+// Record is a single captured message.
+type Record struct {
+	Time      time.Time
+	Direction string
+	Raw       string
+}
+
+// Recorder captures messages as they are sent or received.
+type Recorder interface {
+	Record(direction string, msg *quickfix.Message)
+	Close() error
+}
+
+// FileRecorder appends messages to a recording file, the values of
+// DefaultSensitiveTags masked. Each record is encoded as
+//
+//	varint   unix time in nanoseconds
+//	byte     'I' or 'O'
+//	uvarint  length of the raw message
+//	bytes    raw message
+type FileRecorder struct {
+	sensitive map[int]bool
+
+	mu   sync.Mutex
+	file *os.File
+	w    *bufio.Writer
+	buf  [2*binary.MaxVarintLen64 + 1]byte
+}
+
+// NewFileRecorder opens path for appending, creating it when needed.
+func NewFileRecorder(path string) (*FileRecorder, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("error opening recording(%s): %w", path, err)
+	}
+	fi, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("error opening recording(%s): %w", path, err)
+	}
+
+	r := &FileRecorder{sensitive: map[int]bool{}, file: file, w: bufio.NewWriter(file)}
+	for _, t := range DefaultSensitiveTags {
+		r.sensitive[t] = true
+	}
+	if fi.Size() == 0 {
+		if _, err := r.w.WriteString(recordingMagic); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("error writing recording(%s): %w", path, err)
+		}
+	}
+	return r, nil
+}
+
+// Record implements Recorder. Write errors are logged and otherwise ignored so
+// that recording never interferes with the session.
+func (r *FileRecorder) Record(direction string, msg *quickfix.Message) {
+	if err := r.write(Record{Time: time.Now(), Direction: direction, Raw: maskRaw(msg.String(), r.sensitive)}); err != nil {
+		log.Errorf("Error recording message: %v", err)
+	}
+}
+
+func (r *FileRecorder) write(rec Record) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dir := byte('I')
+	if rec.Direction == DirectionOut {
+		dir = 'O'
+	}
+	n := binary.PutVarint(r.buf[:], rec.Time.UnixNano())
+	r.buf[n] = dir
+	n++
+	n += binary.PutUvarint(r.buf[n:], uint64(len(rec.Raw)))
+
+	if _, err := r.w.Write(r.buf[:n]); err != nil {
+		return err
+	}
+	if _, err := r.w.WriteString(rec.Raw); err != nil {
+		return err
+	}
+	return r.w.Flush()
+}
+
+// Close flushes and closes the recording file.
+func (r *FileRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.w.Flush(); err != nil {
+		r.file.Close()
+		return err
+	}
+	return r.file.Close()
+}
+
+// RecordingReader reads records written by FileRecorder.
+type RecordingReader struct {
+	r *bufio.Reader
+}
+
+// NewRecordingReader checks the recording header and returns a reader
+// positioned at the first record.
+func NewRecordingReader(r io.Reader) (*RecordingReader, error) {
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(recordingMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || string(magic) != recordingMagic {
+		return nil, errors.New("not a message recording")
+	}
+	return &RecordingReader{r: br}, nil
+}
+
+// Next returns the next record, or io.EOF at the end of the recording.
+func (rr *RecordingReader) Next() (Record, error) {
+	ts, err := binary.ReadVarint(rr.r)
+	if err != nil {
+		return Record{}, err
+	}
+	dir, err := rr.r.ReadByte()
+	if err != nil {
+		return Record{}, io.ErrUnexpectedEOF
+	}
+	size, err := binary.ReadUvarint(rr.r)
+	if err != nil {
+		return Record{}, io.ErrUnexpectedEOF
+	}
+	raw := make([]byte, size)
+	if _, err := io.ReadFull(rr.r, raw); err != nil {
+		return Record{}, io.ErrUnexpectedEOF
+	}
+
+	rec := Record{Time: time.Unix(0, ts), Direction: DirectionIn, Raw: string(raw)}
+	if dir == 'O' {
+		rec.Direction = DirectionOut
+	}
+	return rec, nil
+}
@@ -0,0 +1,187 @@
+package fix
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/quickfixgo/enum"
+	"github.com/quickfixgo/quickfix"
+	"github.com/quickfixgo/tag"
+)
+
+// ReplayStats summarizes a replay run.
+type ReplayStats struct {
+	Records  int
+	Replayed int
+	Rejected int
+	Failed   int
+}
+
+// OutboundHandler receives a recorded outbound application message with our
+// side's session ID.
+type OutboundHandler func(msg *quickfix.Message, sessionID quickfix.SessionID) error
+
+// Replayer feeds recorded inbound messages to an application.
+type Replayer struct {
+	app      quickfix.Application
+	outbound OutboundHandler
+	// Speed scales the original pacing: 1 replays in real time, 10 ten times
+	// faster, and 0 as fast as possible.
+	Speed float64
+}
+
+type ReplayerOpt func(*Replayer)
+
+// WithOutbound passes the outbound application messages to h, in their
+// place among the inbound ones, so the services can account for the
+// requests the responses answer. Without it they are skipped.
+func WithOutbound(h OutboundHandler) ReplayerOpt {
+	return func(r *Replayer) {
+		r.outbound = h
+	}
+}
+
+func NewReplayer(app quickfix.Application, speed float64, opts ...ReplayerOpt) *Replayer {
+	r := &Replayer{app: app, Speed: speed}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Replay reads every record from rr and passes inbound messages to
+// FromAdmin or FromApp, exactly as a live session would, and outbound
+// application messages to the OutboundHandler, if any. The other outbound
+// records only count towards the stats.
+func (r *Replayer) Replay(ctx context.Context, rr *RecordingReader) (ReplayStats, error) {
+	var (
+		stats ReplayStats
+		first time.Time
+		start = time.Now()
+	)
+
+	for {
+		rec, err := rr.Next()
+		if errors.Is(err, io.EOF) {
+			return stats, nil
+		}
+		if err != nil {
+			return stats, fmt.Errorf("error reading record %d: %w", stats.Records+1, err)
+		}
+		stats.Records++
+		if rec.Direction != DirectionIn && r.outbound == nil {
+			continue
+		}
+
+		if first.IsZero() {
+			first = rec.Time
+		}
+		if r.Speed > 0 {
+			due := start.Add(time.Duration(float64(rec.Time.Sub(first)) / r.Speed))
+			select {
+			case <-ctx.Done():
+				return stats, ctx.Err()
+			case <-time.After(time.Until(due)):
+			}
+		} else if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+
+		err = r.dispatch(rec)
+		if errors.Is(err, errSkipped) {
+			continue
+		}
+		if err != nil {
+			var reject quickfix.MessageRejectError
+			if errors.As(err, &reject) {
+				stats.Rejected++
+			} else {
+				stats.Failed++
+			}
+			log.Warnf("Replay of record %d failed: %v", stats.Records, err)
+			continue
+		}
+		stats.Replayed++
+	}
+}
+
+// errSkipped is returned by dispatch for the outbound admin messages.
+var errSkipped = errors.New("skipped")
+
+func (r *Replayer) dispatch(rec Record) error {
+	msg := quickfix.NewMessage()
+	if err := quickfix.ParseMessage(msg, bytes.NewBufferString(rec.Raw)); err != nil {
+		return fmt.Errorf("error parsing message: %w", err)
+	}
+	msgType, reject := msg.MsgType()
+	if reject != nil {
+		return reject
+	}
+
+	if rec.Direction != DirectionIn {
+		if isAdminMsgType(msgType) {
+			return errSkipped
+		}
+		sessionID, err := outboundSessionID(msg)
+		if err != nil {
+			return err
+		}
+		return r.outbound(msg, sessionID)
+	}
+
+	sessionID, err := inboundSessionID(msg)
+	if err != nil {
+		return err
+	}
+
+	if isAdminMsgType(msgType) {
+		if reject := r.app.FromAdmin(msg, sessionID); reject != nil {
+			return reject
+		}
+		return nil
+	}
+	if reject := r.app.FromApp(msg, sessionID); reject != nil {
+		return reject
+	}
+	return nil
+}
+
+// inboundSessionID returns our side's session ID for a received message.
+func inboundSessionID(msg *quickfix.Message) (quickfix.SessionID, error) {
+	sessionID, err := outboundSessionID(msg)
+	sessionID.SenderCompID, sessionID.TargetCompID = sessionID.TargetCompID, sessionID.SenderCompID
+	return sessionID, err
+}
+
+// outboundSessionID returns the session ID of the sender of msg, our side's
+// for a sent message.
+func outboundSessionID(msg *quickfix.Message) (quickfix.SessionID, error) {
+	var beginString, sender, target quickfix.FIXString
+	if err := msg.Header.GetField(tag.BeginString, &beginString); err != nil {
+		return quickfix.SessionID{}, err
+	}
+	if err := msg.Header.GetField(tag.SenderCompID, &sender); err != nil {
+		return quickfix.SessionID{}, err
+	}
+	if err := msg.Header.GetField(tag.TargetCompID, &target); err != nil {
+		return quickfix.SessionID{}, err
+	}
+	return quickfix.SessionID{
+		BeginString:  string(beginString),
+		SenderCompID: string(sender),
+		TargetCompID: string(target),
+	}, nil
+}
+
+func isAdminMsgType(msgType string) bool {
+	switch enum.MsgType(msgType) {
+	case enum.MsgType_HEARTBEAT, enum.MsgType_TEST_REQUEST, enum.MsgType_RESEND_REQUEST,
+		enum.MsgType_REJECT, enum.MsgType_SEQUENCE_RESET, enum.MsgType_LOGOUT, enum.MsgType_LOGON:
+		return true
+	}
+	return false
+}
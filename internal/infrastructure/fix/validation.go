@@ -0,0 +1,297 @@
+package fix
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/metrics"
+	"github.com/quickfixgo/enum"
+	"github.com/quickfixgo/quickfix"
+	"github.com/quickfixgo/quickfix/datadictionary"
+)
+
+// ValidationMode controls what happens to messages violating the dictionary.
+type ValidationMode string
+
+const (
+	// ValidationOff skips validation.
+	ValidationOff ValidationMode = "off"
+	// ValidationReport logs and counts violations but accepts the message.
+	ValidationReport ValidationMode = "report"
+	// ValidationEnforce rejects messages with violations.
+	ValidationEnforce ValidationMode = "enforce"
+)
+
+// ParseValidationMode parses a ValidationMode, defaulting to report.
+func ParseValidationMode(s string) (ValidationMode, error) {
+	switch m := ValidationMode(strings.ToLower(s)); m {
+	case "":
+		return ValidationReport, nil
+	case ValidationOff, ValidationReport, ValidationEnforce:
+		return m, nil
+	}
+	return "", fmt.Errorf("unknown validation mode %q", s)
+}
+
+// Violation reasons, used as counter labels.
+const (
+	ViolationUnknownMsgType  = "unknown_msg_type"
+	ViolationUnknownField    = "unknown_field"
+	ViolationFieldNotAllowed = "field_not_in_message"
+	ViolationMissingRequired = "missing_required"
+	ViolationInvalidEnum     = "invalid_enum"
+	ViolationEmptyValue      = "empty_value"
+	ViolationGroupCount      = "group_count"
+)
+
+// reportSampled are the high volume message types, market data, that report
+// mode only validates a sample of, to keep decoding off the hot path of most
+// messages received.
+var reportSampled = []string{
+	string(enum.MsgType_MARKET_DATA_SNAPSHOT_FULL_REFRESH),
+	string(enum.MsgType_MARKET_DATA_INCREMENTAL_REFRESH),
+}
+
+var (
+	validatedCounter = metrics.NewCounterVec("fix_validated_messages")
+	violationCounter = metrics.NewCounterVec("fix_validation_violations")
+)
+
+// Violation is a single difference between a message and the dictionary.
+type Violation struct {
+	Tag    int
+	Reason string
+	Detail string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s(%d): %s", v.Reason, v.Tag, v.Detail)
+}
+
+// Validator checks messages against a data dictionary. Unlike the quickfix
+// validator it can report violations without rejecting, so validation can be
+// tightened gradually.
+type Validator struct {
+	mode    ValidationMode
+	decoder *Decoder
+	sample  uint64
+	seen    map[string]*atomic.Uint64 // by MsgType of reportSampled
+}
+
+type ValidatorOpt func(*Validator)
+
+// WithSample validates one in n of the reportSampled messages in report
+// mode, the first one included. Defaults to 1, all of them.
+func WithSample(n int) ValidatorOpt {
+	return func(v *Validator) {
+		if n > 0 {
+			v.sample = uint64(n)
+		}
+	}
+}
+
+func NewValidator(dd *datadictionary.DataDictionary, mode ValidationMode, opts ...ValidatorOpt) *Validator {
+	v := &Validator{
+		mode:    mode,
+		decoder: &Decoder{dd: dd, sensitive: map[int]bool{}},
+		sample:  1,
+		seen:    make(map[string]*atomic.Uint64, len(reportSampled)),
+	}
+	for _, msgType := range reportSampled {
+		v.seen[msgType] = new(atomic.Uint64)
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+func (v *Validator) Mode() ValidationMode {
+	return v.mode
+}
+
+// Check validates msg according to the mode. In enforce mode the first
+// violation is returned as a reject, otherwise violations are only logged and
+// counted. Report mode only validates a sample of the market data.
+func (v *Validator) Check(direction string, msg *quickfix.Message) quickfix.MessageRejectError {
+	if v == nil || v.mode == ValidationOff {
+		return nil
+	}
+	if v.mode == ValidationReport && !v.sampled(msg) {
+		return nil
+	}
+
+	m, err := v.decoder.Decode(msg)
+	if err != nil {
+		log.Warnf("[VALIDATION] %s undecodable message: %v", direction, err)
+		return nil
+	}
+	violations := v.Validate(m)
+	validatedCounter.Inc(direction, m.MsgType)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	details := make([]string, 0, len(violations))
+	for _, vi := range violations {
+		violationCounter.Inc(direction, m.MsgType, vi.Reason)
+		details = append(details, vi.String())
+	}
+	log.Warnw("[VALIDATION] message violates data dictionary",
+		"direction", direction,
+		"msgType", m.MsgType,
+		"seqNum", m.SeqNum,
+		"mode", v.mode,
+		"violations", details,
+	)
+
+	if v.mode != ValidationEnforce || direction != DirectionIn {
+		return nil
+	}
+	first := violations[0]
+	ref := quickfix.Tag(first.Tag)
+	return quickfix.NewMessageRejectError(first.String(), rejectReason(first.Reason), &ref)
+}
+
+// sampled tells whether msg is to be validated in report mode: every message
+// but one in v.sample of each reportSampled MsgType.
+func (v *Validator) sampled(msg *quickfix.Message) bool {
+	msgType, err := msg.MsgType()
+	if err != nil {
+		return true
+	}
+	seen, ok := v.seen[msgType]
+	if !ok {
+		return true
+	}
+	return (seen.Add(1)-1)%v.sample == 0
+}
+
+// Validate returns all violations of a decoded message.
+func (v *Validator) Validate(m *DecodedMessage) []Violation {
+	dd := v.decoder.dd
+	def, ok := dd.Messages[m.MsgType]
+	if !ok {
+		return []Violation{{Tag: 35, Reason: ViolationUnknownMsgType, Detail: m.MsgType}}
+	}
+
+	var violations []Violation
+	present := map[int]bool{}
+	for _, f := range m.Fields {
+		present[f.Tag] = true
+		fd := def.Fields[f.Tag]
+		if fd == nil {
+			fd = dd.Header.Fields[f.Tag]
+		}
+		if fd == nil {
+			fd = dd.Trailer.Fields[f.Tag]
+		}
+		violations = append(violations, v.validateField(f, fd)...)
+	}
+
+	for t := range def.RequiredTags {
+		if !present[t] {
+			violations = append(violations, Violation{Tag: t, Reason: ViolationMissingRequired, Detail: fieldName(dd, t)})
+		}
+	}
+	for t := range dd.Header.RequiredTags {
+		if !present[t] {
+			violations = append(violations, Violation{Tag: t, Reason: ViolationMissingRequired, Detail: fieldName(dd, t)})
+		}
+	}
+	return violations
+}
+
+func (v *Validator) validateField(f DecodedField, fd *datadictionary.FieldDef) []Violation {
+	dd := v.decoder.dd
+	ft, known := dd.FieldTypeByTag[f.Tag]
+	switch {
+	case !known:
+		return []Violation{{Tag: f.Tag, Reason: ViolationUnknownField, Detail: f.Value}}
+	case fd == nil:
+		return []Violation{{Tag: f.Tag, Reason: ViolationFieldNotAllowed, Detail: ft.Name()}}
+	case f.Value == "":
+		return []Violation{{Tag: f.Tag, Reason: ViolationEmptyValue, Detail: ft.Name()}}
+	}
+
+	var violations []Violation
+	if len(ft.Enums) > 0 && !validEnum(ft, f.Value) {
+		violations = append(violations, Violation{Tag: f.Tag, Reason: ViolationInvalidEnum, Detail: ft.Name() + "=" + f.Value})
+	}
+
+	if !fd.IsGroup() {
+		return violations
+	}
+	if count, _ := strconv.Atoi(f.Value); count != len(f.Groups) {
+		violations = append(violations, Violation{
+			Tag:    f.Tag,
+			Reason: ViolationGroupCount,
+			Detail: fmt.Sprintf("%s declares %d entries, found %d", ft.Name(), count, len(f.Groups)),
+		})
+	}
+	children := make(map[int]*datadictionary.FieldDef, len(fd.Fields))
+	for _, child := range fd.Fields {
+		children[child.Tag()] = child
+	}
+	for _, entry := range f.Groups {
+		present := map[int]bool{}
+		for _, gf := range entry {
+			present[gf.Tag] = true
+			violations = append(violations, v.validateField(gf, children[gf.Tag])...)
+		}
+		for _, req := range fd.RequiredFields() {
+			if !present[req.Tag()] {
+				violations = append(violations, Violation{Tag: req.Tag(), Reason: ViolationMissingRequired, Detail: req.Name()})
+			}
+		}
+	}
+	return violations
+}
+
+// validEnum accepts MULTIPLEVALUESTRING style fields holding several
+// space separated enum values.
+func validEnum(ft *datadictionary.FieldType, value string) bool {
+	if _, ok := ft.Enums[value]; ok {
+		return true
+	}
+	if !strings.HasPrefix(ft.Type, "MULTIPLE") {
+		return false
+	}
+	for _, v := range strings.Fields(value) {
+		if _, ok := ft.Enums[v]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func fieldName(dd *datadictionary.DataDictionary, t int) string {
+	if ft, ok := dd.FieldTypeByTag[t]; ok {
+		return ft.Name()
+	}
+	return strconv.Itoa(t)
+}
+
+func rejectReason(reason string) int {
+	r := enum.SessionRejectReason_OTHER
+	switch reason {
+	case ViolationUnknownMsgType:
+		r = enum.SessionRejectReason_INVALID_MSGTYPE
+	case ViolationUnknownField:
+		r = enum.SessionRejectReason_INVALID_TAG_NUMBER
+	case ViolationFieldNotAllowed:
+		r = enum.SessionRejectReason_TAG_NOT_DEFINED_FOR_THIS_MESSAGE_TYPE
+	case ViolationMissingRequired:
+		r = enum.SessionRejectReason_REQUIRED_TAG_MISSING
+	case ViolationInvalidEnum:
+		r = enum.SessionRejectReason_VALUE_IS_INCORRECT
+	case ViolationEmptyValue:
+		r = enum.SessionRejectReason_TAG_SPECIFIED_WITHOUT_A_VALUE
+	case ViolationGroupCount:
+		r = enum.SessionRejectReason_INCORRECT_NUMINGROUP_COUNT_FOR_REPEATING_GROUP
+	}
+	n, _ := strconv.Atoi(string(r))
+	return n
+}
@@ -0,0 +1,194 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Well-known component names used with Named.
+const (
+	ComponentFix        = "fix"
+	ComponentMarketData = "marketdata"
+	ComponentOrders     = "orders"
+	ComponentHTTP       = "http"
+)
+
+var nop = zap.NewNop().Sugar()
+
+// Logger is a named child logger. Until SetLevel is called on it, a Logger
+// follows the root level; afterwards it keeps its own level until ResetLevel.
+type Logger struct {
+	name       string
+	level      zap.AtomicLevel
+	overridden atomic.Bool
+	zl         atomic.Pointer[zap.SugaredLogger]
+}
+
+// Named returns the logger for the given component, creating it on first use.
+// It is safe to call before InitLogger.
+func Named(name string) *Logger {
+	mu.Lock()
+	defer mu.Unlock()
+	if l, ok := components[name]; ok {
+		return l
+	}
+	l := &Logger{name: name, level: zap.NewAtomicLevel()}
+	components[name] = l
+	if fileEncoder != nil {
+		l.build()
+	}
+	return l
+}
+
+// Components returns the names of all component loggers created so far.
+func Components() []string {
+	mu.Lock()
+	defer mu.Unlock()
+	names := make([]string, 0, len(components))
+	for name := range components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func lookup(name string) (*Logger, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+	l, ok := components[name]
+	return l, ok
+}
+
+// build must be called with mu held. The common log file and the console
+// follow the level of the logger, the error log file only gets errors.
+func (l *Logger) build() {
+	core := zapcore.NewTee(
+		zapcore.NewCore(fileEncoder, commonWriter, l),
+		zapcore.NewCore(fileEncoder, errorWriter, zap.ErrorLevel),
+		zapcore.NewCore(consoleEncoder, zapcore.AddSync(os.Stdout), l),
+	)
+	zl := zap.New(core, zap.AddCaller(), zap.AddCallerSkip(1), zap.AddStacktrace(zap.ErrorLevel)).With(preFields...)
+	if l.name != "" {
+		zl = zl.Named(l.name)
+	}
+	l.zl.Store(zl.Sugar())
+}
+
+func (l *Logger) sugar() *zap.SugaredLogger {
+	if zl := l.zl.Load(); zl != nil {
+		return zl
+	}
+	return nop
+}
+
+// Enabled implements zapcore.LevelEnabler.
+func (l *Logger) Enabled(lvl zapcore.Level) bool {
+	return l.Level().Enabled(lvl)
+}
+
+// Level returns the effective level of the logger.
+func (l *Logger) Level() zapcore.Level {
+	if l.overridden.Load() {
+		return l.level.Level()
+	}
+	return level.Level()
+}
+
+// SetLevel gives the logger its own level, independent of the root one.
+func (l *Logger) SetLevel(text string) error {
+	if l == std {
+		return SetLevel(text)
+	}
+	lvl, err := zapcore.ParseLevel(text)
+	if err != nil {
+		return err
+	}
+	l.level.SetLevel(lvl)
+	l.overridden.Store(true)
+	return nil
+}
+
+// ResetLevel makes the logger follow the root level again.
+func (l *Logger) ResetLevel() {
+	l.overridden.Store(false)
+}
+
+// Sugar returns the underlying zap logger, e.g. to add request scoped fields.
+func (l *Logger) Sugar() *zap.SugaredLogger {
+	return l.sugar()
+}
+
+func (l *Logger) Debug(args ...interface{}) {
+	l.sugar().Debug(args...)
+}
+
+func (l *Logger) Info(args ...interface{}) {
+	l.sugar().Info(args...)
+}
+
+func (l *Logger) Warn(args ...interface{}) {
+	l.sugar().Warn(args...)
+}
+
+func (l *Logger) Error(args ...interface{}) {
+	l.sugar().Error(args...)
+}
+
+func (l *Logger) Debugf(template string, args ...interface{}) {
+	l.sugar().Debugf(template, args...)
+}
+
+func (l *Logger) Infof(template string, args ...interface{}) {
+	l.sugar().Infof(template, args...)
+}
+
+func (l *Logger) Warnf(template string, args ...interface{}) {
+	l.sugar().Warnf(template, args...)
+}
+
+func (l *Logger) Errorf(template string, args ...interface{}) {
+	l.sugar().Errorf(template, args...)
+}
+
+func (l *Logger) Fatalf(template string, args ...interface{}) {
+	l.sugar().Fatalf(template, args...)
+}
+
+func (l *Logger) Debugw(msg string, keysAndValues ...interface{}) {
+	l.sugar().Debugw(msg, keysAndValues...)
+}
+
+func (l *Logger) Infow(msg string, keysAndValues ...interface{}) {
+	l.sugar().Infow(msg, keysAndValues...)
+}
+
+func (l *Logger) Warnw(msg string, keysAndValues ...interface{}) {
+	l.sugar().Warnw(msg, keysAndValues...)
+}
+
+func (l *Logger) Errorw(msg string, keysAndValues ...interface{}) {
+	l.sugar().Errorw(msg, keysAndValues...)
+}
+
+// SetComponentLevel changes the level of a component logger created so far,
+// an empty level making it follow the root level again. An empty component
+// changes the root level.
+func SetComponentLevel(component, level string) error {
+	if component == "" {
+		return SetLevel(level)
+	}
+	l, ok := lookup(component)
+	if !ok {
+		return fmt.Errorf("unknown component %q", component)
+	}
+	if level == "" {
+		l.ResetLevel()
+		return nil
+	}
+	return l.SetLevel(level)
+}
@@ -11,10 +11,18 @@ import (
 )
 
 var (
-	zapLog    *zap.SugaredLogger
+	std       = &Logger{level: level}
+	level     = zap.NewAtomicLevel()
 	preFields []zap.Field
 	once      sync.Once
 	// undo      func()
+
+	mu             sync.Mutex
+	components     = map[string]*Logger{}
+	fileEncoder    zapcore.Encoder
+	commonWriter   zapcore.WriteSyncer
+	errorWriter    zapcore.WriteSyncer
+	consoleEncoder zapcore.Encoder
 )
 
 type LoggerOption func(*LoggerConfig)
@@ -59,7 +67,7 @@ func InitLogger(opts ...LoggerOption) {
 			opt(&config)
 		}
 
-		commonWriter := zapcore.AddSync(&lumberjack.Logger{
+		commonFile := zapcore.AddSync(&lumberjack.Logger{
 			Filename:   config.CommonLogPath,
 			MaxSize:    config.MaxSize, // megabytes
 			MaxBackups: config.MaxBackups,
@@ -67,7 +75,7 @@ func InitLogger(opts ...LoggerOption) {
 			Compress:   config.Compress,
 		})
 
-		errorWriter := zapcore.AddSync(&lumberjack.Logger{
+		errorFile := zapcore.AddSync(&lumberjack.Logger{
 			Filename:   config.ErrorLogPath,
 			MaxSize:    config.MaxSize, // megabytes
 			MaxBackups: config.MaxBackups,
@@ -79,18 +87,47 @@ func InitLogger(opts ...LoggerOption) {
 		if err != nil {
 			logLevel = 0
 		}
-		level := zapcore.Level(logLevel)
-
-		zapCore := zapcore.NewTee(
-			zapcore.NewCore(config.FileEncoder, commonWriter, zap.InfoLevel),
-			zapcore.NewCore(config.FileEncoder, errorWriter, zap.ErrorLevel),
-			zapcore.NewCore(config.ConsoleEncoder, zapcore.AddSync(os.Stdout), level),
-		)
+		if config.Debug {
+			logLevel = int(zapcore.DebugLevel)
+		}
+		level.SetLevel(zapcore.Level(logLevel))
 
-		zapLog = zap.New(zapCore, zap.AddCaller(), zap.AddCallerSkip(1), zap.AddStacktrace(zap.ErrorLevel)).Sugar()
+		mu.Lock()
+		defer mu.Unlock()
+		fileEncoder, commonWriter, errorWriter = config.FileEncoder, commonFile, errorFile
+		consoleEncoder = config.ConsoleEncoder
+		rebuild()
 	})
 }
 
+// rebuild recreates the root and every component logger from the current
+// cores and context fields. Callers must hold mu.
+func rebuild() {
+	if fileEncoder == nil {
+		return
+	}
+	std.build()
+	for _, l := range components {
+		l.build()
+	}
+}
+
+// SetLevel changes the log level at runtime, e.g. "debug" or "warn".
+// Components without a level of their own follow this level.
+func SetLevel(text string) error {
+	l, err := zapcore.ParseLevel(text)
+	if err != nil {
+		return err
+	}
+	level.SetLevel(l)
+	return nil
+}
+
+// GetLevel returns the current log level.
+func GetLevel() zapcore.Level {
+	return level.Level()
+}
+
 func WithCommonLogPath(path string) LoggerOption {
 	return func(c *LoggerConfig) {
 		c.CommonLogPath = path
@@ -145,73 +182,75 @@ func WithConsoleEncoder(encoder zapcore.Encoder) LoggerOption {
 	}
 }
 
+// With adds context fields to the root logger and to every component logger.
 func With(fileds ...zap.Field) *zap.SugaredLogger {
+	mu.Lock()
+	defer mu.Unlock()
 	preFields = append(preFields, fileds...)
-	return zapLog
+	rebuild()
+	return std.sugar()
 }
 
 func WithPair(key string, val interface{}) *zap.SugaredLogger {
-	preFields = append(preFields, zap.Any(key, val))
-	return zapLog
+	return With(zap.Any(key, val))
 }
 
 func WithString(key string, val string) *zap.SugaredLogger {
-	preFields = append(preFields, zap.String(key, val))
-	return zapLog
+	return With(zap.String(key, val))
 }
 
 func WithStringPrefix(prefix string) *zap.SugaredLogger {
-	return zapLog.With(zap.String("prefix", prefix))
+	return std.sugar().With(zap.String("prefix", prefix))
 }
 
 func Sync() error {
-	return zapLog.Sync()
+	return std.sugar().Sync()
 }
 
 // Debug uses fmt.Sprint to construct and log a message.
 func Debug(args ...interface{}) {
-	zapLog.Debug(args...)
+	std.sugar().Debug(args...)
 }
 
 // Info uses fmt.Sprint to construct and log a message.
 func Info(args ...interface{}) {
-	zapLog.Info(args...)
+	std.sugar().Info(args...)
 }
 
 // Warn uses fmt.Sprint to construct and log a message.
 func Warn(args ...interface{}) {
-	zapLog.Warn(args...)
+	std.sugar().Warn(args...)
 }
 
 // Error uses fmt.Sprint to construct and log a message.
 func Error(args ...interface{}) {
-	zapLog.Error(args...)
+	std.sugar().Error(args...)
 }
 
 // DPanic uses fmt.Sprint to construct and log a message. In development, the
 // logger then panics. (See DPanicLevel for details.)
 func DPanic(args ...interface{}) {
-	zapLog.DPanic(args...)
+	std.sugar().DPanic(args...)
 }
 
 // Panic uses fmt.Sprint to construct and log a message, then panics.
 func Panic(args ...interface{}) {
-	zapLog.Panic(args...)
+	std.sugar().Panic(args...)
 }
 
 // Fatal uses fmt.Sprint to construct and log a message, then calls os.Exit.
 func Fatal(args ...interface{}) {
-	zapLog.Fatal(args...)
+	std.sugar().Fatal(args...)
 }
 
 // Debugf uses fmt.Sprintf to log a templated message.
 func Debugf(template string, args ...interface{}) {
-	zapLog.Debugf(template, args...)
+	std.sugar().Debugf(template, args...)
 }
 
 // Infof uses fmt.Sprintf to log a templated message.
 func Infof(template string, args ...interface{}) {
-	zapLog.Infof(template, args...)
+	std.sugar().Infof(template, args...)
 }
 
 func Printf(template string, args ...interface{}) {
@@ -220,28 +259,28 @@ func Printf(template string, args ...interface{}) {
 
 // Warnf uses fmt.Sprintf to log a templated message.
 func Warnf(template string, args ...interface{}) {
-	zapLog.Warnf(template, args...)
+	std.sugar().Warnf(template, args...)
 }
 
 // Errorf uses fmt.Sprintf to log a templated message.
 func Errorf(template string, args ...interface{}) {
-	zapLog.Errorf(template, args...)
+	std.sugar().Errorf(template, args...)
 }
 
 // DPanicf uses fmt.Sprintf to log a templated message. In development, the
 // logger then panics. (See DPanicLevel for details.)
 func DPanicf(template string, args ...interface{}) {
-	zapLog.DPanicf(template, args...)
+	std.sugar().DPanicf(template, args...)
 }
 
 // Panicf uses fmt.Sprintf to log a templated message, then panics.
 func Panicf(template string, args ...interface{}) {
-	zapLog.Panicf(template, args...)
+	std.sugar().Panicf(template, args...)
 }
 
 // Fatalf uses fmt.Sprintf to log a templated message, then calls os.Exit.
 func Fatalf(template string, args ...interface{}) {
-	zapLog.Fatalf(template, args...)
+	std.sugar().Fatalf(template, args...)
 }
 
 // Debugw logs a message with some additional context. The variadic key-value
@@ -251,42 +290,42 @@ func Fatalf(template string, args ...interface{}) {
 //
 // ./(args...)zapLog
 func Debugw(msg string, keysAndValues ...interface{}) {
-	zapLog.Debugw(msg, keysAndValues...)
+	std.sugar().Debugw(msg, keysAndValues...)
 }
 
 // Infow logs a message with some additional context. The variadic key-value
 // pairs are treated as they are in With.
 func Infow(msg string, keysAndValues ...interface{}) {
-	zapLog.Infow(msg, keysAndValues...)
+	std.sugar().Infow(msg, keysAndValues...)
 }
 
 // Warnw logs a message with some additional context. The variadic key-value
 // pairs are treated as they are in With.
 func Warnw(msg string, keysAndValues ...interface{}) {
-	zapLog.Warnw(msg, keysAndValues...)
+	std.sugar().Warnw(msg, keysAndValues...)
 }
 
 // Errorw logs a message with some additional context. The variadic key-value
 // pairs are treated as they are in With.
 func Errorw(msg string, keysAndValues ...interface{}) {
-	zapLog.Errorw(msg, keysAndValues...)
+	std.sugar().Errorw(msg, keysAndValues...)
 }
 
 // DPanicw logs a message with some additional context. In development, the
 // logger then panics. (See DPanicLevel for details.) The variadic key-value
 // pairs are treated as they are in With.
 func DPanicw(msg string, keysAndValues ...interface{}) {
-	zapLog.DPanicw(msg, keysAndValues...)
+	std.sugar().DPanicw(msg, keysAndValues...)
 }
 
 // Panicw logs a message with some additional context, then panics. The
 // variadic key-value pairs are treated as they are in With.
 func Panicw(msg string, keysAndValues ...interface{}) {
-	zapLog.Panicw(msg, keysAndValues...)
+	std.sugar().Panicw(msg, keysAndValues...)
 }
 
 // Fatalw logs a message with some additional context, then calls os.Exit. The
 // variadic key-value pairs are treated as they are in With.
 func Fatalw(msg string, keysAndValues ...interface{}) {
-	zapLog.Fatalw(msg, keysAndValues...)
+	std.sugar().Fatalw(msg, keysAndValues...)
 }
@@ -0,0 +1,8 @@
+//go:build !unix
+
+package logger
+
+import "context"
+
+// WatchSignals is a no-op on platforms without SIGUSR1/SIGUSR2.
+func WatchSignals(ctx context.Context) {}
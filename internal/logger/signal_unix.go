@@ -0,0 +1,49 @@
+//go:build unix
+
+package logger
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// WatchSignals lets operators change the log level without an admin call:
+// SIGUSR1 toggles the root level between debug and its previous value,
+// SIGUSR2 restores the startup level and clears all component overrides.
+func WatchSignals(ctx context.Context) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGUSR1, syscall.SIGUSR2)
+
+	go func() {
+		defer signal.Stop(ch)
+		initial := GetLevel()
+		previous := initial
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case sig := <-ch:
+				switch sig {
+				case syscall.SIGUSR1:
+					if GetLevel() == zapcore.DebugLevel {
+						level.SetLevel(previous)
+					} else {
+						previous = GetLevel()
+						level.SetLevel(zapcore.DebugLevel)
+					}
+				case syscall.SIGUSR2:
+					level.SetLevel(initial)
+					for _, name := range Components() {
+						l, _ := lookup(name)
+						l.ResetLevel()
+					}
+				}
+				Infof("Received %s, log level is now %s", sig, GetLevel())
+			}
+		}
+	}()
+}
@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"expvar"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+var (
+	mu   sync.Mutex
+	vecs = map[string]*CounterVec{}
+)
+
+// CounterVec is a set of counters sharing a name and told apart by labels.
+// Counters are published through expvar, so they are part of Handler's output.
+type CounterVec struct {
+	m *expvar.Map
+}
+
+// NewCounterVec returns the counter vector registered under name, creating it
+// on first use.
+func NewCounterVec(name string) *CounterVec {
+	mu.Lock()
+	defer mu.Unlock()
+	if v, ok := vecs[name]; ok {
+		return v
+	}
+	v := &CounterVec{m: expvar.NewMap(name)}
+	vecs[name] = v
+	return v
+}
+
+// Inc increments the counter identified by labels, e.g. Inc("W", "invalid_enum").
+func (v *CounterVec) Inc(labels ...string) {
+	v.Add(1, labels...)
+}
+
+// Add adds delta to the counter identified by labels.
+func (v *CounterVec) Add(delta int64, labels ...string) {
+	v.m.Add(key(labels), delta)
+}
+
+// Get returns the current value of the counter identified by labels.
+func (v *CounterVec) Get(labels ...string) int64 {
+	if c, ok := v.m.Get(key(labels)).(*expvar.Int); ok {
+		return c.Value()
+	}
+	return 0
+}
+
+// Snapshot returns every counter of the vector keyed by its joined labels.
+func (v *CounterVec) Snapshot() map[string]int64 {
+	out := map[string]int64{}
+	v.m.Do(func(kv expvar.KeyValue) {
+		if c, ok := kv.Value.(*expvar.Int); ok {
+			out[kv.Key] = c.Value()
+		}
+	})
+	return out
+}
+
+func key(labels []string) string {
+	if len(labels) == 0 {
+		return "total"
+	}
+	return strings.Join(labels, "/")
+}
+
+// Handler serves all metrics as JSON.
+func Handler() http.Handler {
+	return expvar.Handler()
+}
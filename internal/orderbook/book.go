@@ -0,0 +1,115 @@
+// Package orderbook keeps the price levels of an instrument from market data
+// snapshots and incremental updates.
+package orderbook
+
+import (
+	"sort"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// Book is the order book of a symbol. It is not safe for concurrent use.
+type Book struct {
+	symbol  string
+	bids    []domain.BookLevel // best (highest) first
+	asks    []domain.BookLevel // best (lowest) first
+	updated time.Time
+}
+
+func New(symbol string) *Book {
+	return &Book{symbol: symbol}
+}
+
+func (b *Book) Symbol() string {
+	return b.symbol
+}
+
+// Reset replaces the book with a full snapshot.
+func (b *Book) Reset(s domain.Book) {
+	b.bids = b.bids[:0]
+	b.asks = b.asks[:0]
+	for _, l := range s.Bids {
+		b.set(l)
+	}
+	for _, l := range s.Asks {
+		b.set(l)
+	}
+	b.updated = s.Time
+}
+
+// Apply applies an incremental update. Levels are matched by price.
+func (b *Book) Apply(u domain.BookUpdate) {
+	if u.Action == domain.UpdateDelete || !u.Level.Size.IsPositive() {
+		b.remove(u.Level.Side, u.Level.Price)
+	} else {
+		b.set(u.Level)
+	}
+	b.updated = u.Time
+}
+
+// Quote returns the top of the book.
+func (b *Book) Quote() domain.Quote {
+	return b.Snapshot(1).Quote()
+}
+
+// Snapshot returns the best depth levels of each side, all of them when
+// depth is zero.
+func (b *Book) Snapshot(depth int) domain.Book {
+	return domain.Book{
+		Symbol: b.symbol,
+		Bids:   top(b.bids, depth),
+		Asks:   top(b.asks, depth),
+		Time:   b.updated,
+	}
+}
+
+func top(levels []domain.BookLevel, depth int) []domain.BookLevel {
+	if depth <= 0 || depth > len(levels) {
+		depth = len(levels)
+	}
+	out := make([]domain.BookLevel, depth)
+	copy(out, levels[:depth])
+	for i := range out {
+		out[i].Position = i + 1
+	}
+	return out
+}
+
+func (b *Book) side(s domain.Side) *[]domain.BookLevel {
+	if s == domain.SideBuy {
+		return &b.bids
+	}
+	return &b.asks
+}
+
+// search returns the index of price in levels, or where it would be inserted.
+func search(s domain.Side, levels []domain.BookLevel, price decimal.Decimal) (int, bool) {
+	i := sort.Search(len(levels), func(i int) bool {
+		if s == domain.SideBuy {
+			return levels[i].Price.LessThanOrEqual(price)
+		}
+		return levels[i].Price.GreaterThanOrEqual(price)
+	})
+	return i, i < len(levels) && levels[i].Price.Equal(price)
+}
+
+func (b *Book) set(l domain.BookLevel) {
+	levels := b.side(l.Side)
+	i, found := search(l.Side, *levels, l.Price)
+	if found {
+		(*levels)[i] = l
+		return
+	}
+	*levels = append(*levels, domain.BookLevel{})
+	copy((*levels)[i+1:], (*levels)[i:])
+	(*levels)[i] = l
+}
+
+func (b *Book) remove(s domain.Side, price decimal.Decimal) {
+	levels := b.side(s)
+	if i, found := search(s, *levels, price); found {
+		*levels = append((*levels)[:i], (*levels)[i+1:]...)
+	}
+}
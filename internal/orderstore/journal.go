@@ -0,0 +1,136 @@
+package orderstore
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/logger"
+)
+
+var log = logger.Named(logger.ComponentOrders)
+
+// Journal is a write-ahead journal of entries appended to a file as JSON
+// lines and synced to disk on every write. A last line cut short by a crash
+// is dropped when the journal is opened.
+type Journal struct {
+	mu   sync.Mutex
+	path string
+	f    *os.File
+	seq  int64
+}
+
+// NewJournal opens the journal at path, creating it.
+func NewJournal(path string) (*Journal, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("error opening order journal(%s): %w", path, err)
+	}
+	j := &Journal{path: path, f: f}
+	if err := j.recover(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return j, nil
+}
+
+// recover truncates a torn last line and resumes the sequence of the
+// entries.
+func (j *Journal) recover() error {
+	entries, offset, err := j.read(j.f)
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		j.seq = entries[len(entries)-1].Seq
+	}
+	if err := j.f.Truncate(offset); err != nil {
+		return fmt.Errorf("error truncating order journal(%s): %w", j.path, err)
+	}
+	if _, err := j.f.Seek(offset, io.SeekStart); err != nil {
+		return fmt.Errorf("error seeking order journal(%s): %w", j.path, err)
+	}
+	return nil
+}
+
+// read reads the complete entries of r and returns the offset after them.
+func (j *Journal) read(r io.Reader) ([]Entry, int64, error) {
+	br := bufio.NewReader(r)
+	var entries []Entry
+	var offset int64
+	for {
+		line, err := br.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(bytes.TrimSpace(line)) > 0 {
+				log.Warnf("Dropping the incomplete last entry of the order journal %s", j.path)
+			}
+			return entries, offset, nil
+		}
+		if err != nil {
+			return nil, 0, fmt.Errorf("error reading order journal(%s): %w", j.path, err)
+		}
+		var e Entry
+		if err := json.Unmarshal(line, &e); err != nil {
+			return nil, 0, fmt.Errorf("error decoding order journal(%s) at offset %d: %w", j.path, offset, err)
+		}
+		entries = append(entries, e)
+		offset += int64(len(line))
+	}
+}
+
+func (j *Journal) SaveOrder(o domain.Order, e *domain.Execution) error {
+	return j.append(Entry{Order: &o, Execution: e})
+}
+
+func (j *Journal) SaveLink(clOrdID, origClOrdID string) error {
+	return j.append(Entry{Link: &Link{ClOrdID: clOrdID, OrigClOrdID: origClOrdID}})
+}
+
+func (j *Journal) append(e Entry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.seq++
+	e.Seq = j.seq
+	e.Time = time.Now().UTC()
+	line, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("error encoding order journal entry: %w", err)
+	}
+	if _, err := j.f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("error writing order journal: %w", err)
+	}
+	if err := j.f.Sync(); err != nil {
+		return fmt.Errorf("error syncing order journal: %w", err)
+	}
+	return nil
+}
+
+// Load reads the journal from the start and returns its state.
+func (j *Journal) Load(ctx context.Context) (Snapshot, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	f, err := os.Open(j.path)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("error opening order journal(%s): %w", j.path, err)
+	}
+	defer f.Close()
+	entries, _, err := j.read(f)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	return replay(entries), nil
+}
+
+func (j *Journal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.f.Close()
+}
@@ -0,0 +1,107 @@
+package orderstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+)
+
+func TestJournalRecover(t *testing.T) {
+	const (
+		order = `{"seq":1,"time":"2024-06-03T09:00:00Z","order":{"clOrdId":"ORD-1","status":"PENDING_NEW"}}` + "\n"
+		link  = `{"seq":2,"time":"2024-06-03T09:00:01Z","link":{"clOrdId":"CXL-1","origClOrdId":"ORD-1"}}` + "\n"
+	)
+	tests := []struct {
+		name    string
+		content string
+		kept    string // content left once opened
+		seq     int64  // sequence of the next entry
+		wantErr bool
+	}{
+		{name: "empty", content: "", kept: "", seq: 1},
+		{name: "complete entries", content: order + link, kept: order + link, seq: 3},
+		{name: "torn last line", content: order + link[:40], kept: order, seq: 2},
+		{name: "torn first line", content: order[:10], kept: "", seq: 1},
+		{name: "corrupt entry", content: order + "{}x\n" + link, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "orders.journal")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			j, err := NewJournal(path)
+			if tt.wantErr {
+				if err == nil {
+					j.Close()
+					t.Fatal("NewJournal() succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer j.Close()
+
+			kept, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(kept) != tt.kept {
+				t.Errorf("journal after open = %q, want %q", kept, tt.kept)
+			}
+
+			if err := j.SaveLink("CXL-2", "ORD-1"); err != nil {
+				t.Fatal(err)
+			}
+			snapshot, err := j.Load(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if j.seq != tt.seq {
+				t.Errorf("seq of the next entry = %d, want %d", j.seq, tt.seq)
+			}
+			last := snapshot.Links[len(snapshot.Links)-1]
+			if last != (Link{ClOrdID: "CXL-2", OrigClOrdID: "ORD-1"}) {
+				t.Errorf("last link = %+v, want the one appended", last)
+			}
+			if want := strings.Count(tt.kept, `"order"`); len(snapshot.Orders) != want {
+				t.Errorf("orders = %d, want %d", len(snapshot.Orders), want)
+			}
+		})
+	}
+}
+
+func TestJournalLoad(t *testing.T) {
+	j, err := NewJournal(filepath.Join(t.TempDir(), "orders.journal"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer j.Close()
+
+	o := domain.Order{ClOrdID: "ORD-1", Status: domain.OrderStatusPendingNew}
+	if err := j.SaveOrder(o, nil); err != nil {
+		t.Fatal(err)
+	}
+	o.Status = domain.OrderStatusNew
+	e := domain.Execution{ExecID: "E-1", ClOrdID: "ORD-1", OrdStatus: domain.OrderStatusNew}
+	if err := j.SaveOrder(o, &e); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, err := j.Load(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshot.Orders) != 1 || snapshot.Orders[0].Status != domain.OrderStatusNew {
+		t.Errorf("orders = %+v, want ORD-1 NEW", snapshot.Orders)
+	}
+	if len(snapshot.Executions) != 1 || snapshot.Executions[0].ExecID != "E-1" {
+		t.Errorf("executions = %+v, want E-1", snapshot.Executions)
+	}
+}
@@ -0,0 +1,163 @@
+package orderstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+)
+
+// schema creates the tables of SQLStore: order_events holds every
+// transition, order_states the last state of every order and order_links
+// the ClOrdID chain.
+const schema = `
+CREATE TABLE IF NOT EXISTS order_events (
+	seq       BIGSERIAL PRIMARY KEY,
+	time      TIMESTAMPTZ NOT NULL,
+	cl_ord_id TEXT NOT NULL,
+	status    TEXT NOT NULL,
+	exec_id   TEXT,
+	ord       JSONB NOT NULL,
+	execution JSONB
+);
+CREATE TABLE IF NOT EXISTS order_states (
+	cl_ord_id TEXT PRIMARY KEY,
+	account   TEXT NOT NULL,
+	symbol    TEXT NOT NULL,
+	status    TEXT NOT NULL,
+	created   TIMESTAMPTZ NOT NULL,
+	updated   TIMESTAMPTZ NOT NULL,
+	ord       JSONB NOT NULL
+);
+CREATE TABLE IF NOT EXISTS order_links (
+	cl_ord_id      TEXT PRIMARY KEY,
+	orig_cl_ord_id TEXT NOT NULL,
+	time           TIMESTAMPTZ NOT NULL
+);`
+
+// SQLStore records the orders in the tables of a PostgreSQL database, each
+// transition in a transaction.
+type SQLStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore creates the tables of the store in db when missing.
+func NewSQLStore(ctx context.Context, db *sql.DB) (*SQLStore, error) {
+	if _, err := db.ExecContext(ctx, schema); err != nil {
+		return nil, fmt.Errorf("error creating order store tables: %w", err)
+	}
+	return &SQLStore{db: db}, nil
+}
+
+func (s *SQLStore) SaveOrder(o domain.Order, e *domain.Execution) error {
+	ord, err := json.Marshal(o)
+	if err != nil {
+		return fmt.Errorf("error encoding order %s: %w", o.ClOrdID, err)
+	}
+	var execID, execution any
+	if e != nil {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("error encoding execution %s: %w", e.ExecID, err)
+		}
+		execID, execution = e.ExecID, data
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("error saving order %s: %w", o.ClOrdID, err)
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec(`INSERT INTO order_events (time, cl_ord_id, status, exec_id, ord, execution)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		time.Now().UTC(), o.ClOrdID, string(o.Status), execID, ord, execution); err != nil {
+		return fmt.Errorf("error saving order %s: %w", o.ClOrdID, err)
+	}
+	if _, err := tx.Exec(`INSERT INTO order_states (cl_ord_id, account, symbol, status, created, updated, ord)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (cl_ord_id) DO UPDATE SET status = $4, updated = $6, ord = $7`,
+		o.ClOrdID, o.Account, o.Symbol, string(o.Status), o.Created, o.Updated, ord); err != nil {
+		return fmt.Errorf("error saving order %s: %w", o.ClOrdID, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error saving order %s: %w", o.ClOrdID, err)
+	}
+	return nil
+}
+
+func (s *SQLStore) SaveLink(clOrdID, origClOrdID string) error {
+	if _, err := s.db.Exec(`INSERT INTO order_links (cl_ord_id, orig_cl_ord_id, time) VALUES ($1, $2, $3)
+		ON CONFLICT (cl_ord_id) DO NOTHING`,
+		clOrdID, origClOrdID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("error saving link %s of order %s: %w", clOrdID, origClOrdID, err)
+	}
+	return nil
+}
+
+func (s *SQLStore) Load(ctx context.Context) (Snapshot, error) {
+	var snap Snapshot
+	rows, err := s.db.QueryContext(ctx, "SELECT ord FROM order_states ORDER BY created, cl_ord_id")
+	if err != nil {
+		return snap, fmt.Errorf("error querying orders: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return snap, fmt.Errorf("error reading order: %w", err)
+		}
+		var o domain.Order
+		if err := json.Unmarshal(data, &o); err != nil {
+			return snap, fmt.Errorf("error decoding order: %w", err)
+		}
+		snap.Orders = append(snap.Orders, o)
+	}
+	if err := rows.Err(); err != nil {
+		return snap, fmt.Errorf("error reading orders: %w", err)
+	}
+
+	execs, err := s.db.QueryContext(ctx, "SELECT execution FROM order_events WHERE execution IS NOT NULL ORDER BY seq")
+	if err != nil {
+		return snap, fmt.Errorf("error querying executions: %w", err)
+	}
+	defer execs.Close()
+	for execs.Next() {
+		var data []byte
+		if err := execs.Scan(&data); err != nil {
+			return snap, fmt.Errorf("error reading execution: %w", err)
+		}
+		var e domain.Execution
+		if err := json.Unmarshal(data, &e); err != nil {
+			return snap, fmt.Errorf("error decoding execution: %w", err)
+		}
+		snap.Executions = append(snap.Executions, e)
+	}
+	if err := execs.Err(); err != nil {
+		return snap, fmt.Errorf("error reading executions: %w", err)
+	}
+
+	links, err := s.db.QueryContext(ctx, "SELECT cl_ord_id, orig_cl_ord_id FROM order_links ORDER BY time")
+	if err != nil {
+		return snap, fmt.Errorf("error querying order links: %w", err)
+	}
+	defer links.Close()
+	for links.Next() {
+		var l Link
+		if err := links.Scan(&l.ClOrdID, &l.OrigClOrdID); err != nil {
+			return snap, fmt.Errorf("error reading order link: %w", err)
+		}
+		snap.Links = append(snap.Links, l)
+	}
+	if err := links.Err(); err != nil {
+		return snap, fmt.Errorf("error reading order links: %w", err)
+	}
+	return snap, nil
+}
+
+// Close does not close the database, shared with the rest of the adapter.
+func (s *SQLStore) Close() error {
+	return nil
+}
@@ -0,0 +1,92 @@
+// Package orderstore persists the state transitions of our orders so they
+// can be rebuilt after a restart.
+package orderstore
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+)
+
+// Types of store of the orders section.
+const (
+	TypeJournal = "journal"
+	TypeSQL     = "sql"
+)
+
+// Store records every state transition of the orders and the ClOrdID chain
+// of their cancel and replace requests. Writes are durable when they return.
+type Store interface {
+	// SaveOrder records the state of o after a transition, caused by the
+	// execution report e when not nil.
+	SaveOrder(o domain.Order, e *domain.Execution) error
+	// SaveLink records that the request clOrdID cancels or replaces the
+	// order origClOrdID.
+	SaveLink(clOrdID, origClOrdID string) error
+	// Load returns the last recorded state of every order, the executions
+	// and the links.
+	Load(ctx context.Context) (Snapshot, error)
+	Close() error
+}
+
+// Entry is a state transition, the order after it and the execution report
+// causing it, or a link of the ClOrdID chain.
+type Entry struct {
+	Seq       int64             `json:"seq"`
+	Time      time.Time         `json:"time"`
+	Order     *domain.Order     `json:"order,omitempty"`
+	Execution *domain.Execution `json:"execution,omitempty"`
+	Link      *Link             `json:"link,omitempty"`
+}
+
+// Link ties the ClOrdID of a cancel or replace request to the order it
+// refers to.
+type Link struct {
+	ClOrdID     string `json:"clOrdId"`
+	OrigClOrdID string `json:"origClOrdId"`
+}
+
+// Snapshot is the state rebuilt from a store.
+type Snapshot struct {
+	// Orders holds the last state of every order, by creation time.
+	Orders []domain.Order
+	// Executions holds the execution reports recorded, in the order they
+	// were.
+	Executions []domain.Execution
+	Links      []Link
+}
+
+// replay rebuilds the snapshot of entries, in the order they were recorded.
+func replay(entries []Entry) Snapshot {
+	orders := make(map[string]domain.Order)
+	var s Snapshot
+	for _, e := range entries {
+		switch {
+		case e.Order != nil:
+			orders[e.Order.ClOrdID] = *e.Order
+			if e.Execution != nil {
+				s.Executions = append(s.Executions, *e.Execution)
+			}
+		case e.Link != nil:
+			s.Links = append(s.Links, *e.Link)
+		}
+	}
+	s.Orders = sortOrders(orders)
+	return s
+}
+
+func sortOrders(orders map[string]domain.Order) []domain.Order {
+	out := make([]domain.Order, 0, len(orders))
+	for _, o := range orders {
+		out = append(out, o)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].Created.Equal(out[j].Created) {
+			return out[i].Created.Before(out[j].Created)
+		}
+		return out[i].ClOrdID < out[j].ClOrdID
+	})
+	return out
+}
@@ -0,0 +1,39 @@
+package position
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/api"
+)
+
+// Handler serves the positions and the reconciliation breaks.
+//
+//	GET ?account=A1&symbol=BTC-USDT     a position
+//	GET ?breaks=true                    the breaks of the last reconciliations
+//	GET                                 every position
+func Handler(k *Keeper) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			api.WriteError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+
+		q := r.URL.Query()
+		if q.Get("breaks") == "true" {
+			api.WriteJSON(w, http.StatusOK, k.Breaks())
+			return
+		}
+		if symbol := q.Get("symbol"); symbol != "" {
+			p, ok := k.Get(q.Get("account"), symbol)
+			if !ok {
+				api.WriteError(w, http.StatusNotFound, fmt.Errorf("no position in %s", symbol))
+				return
+			}
+			api.WriteJSON(w, http.StatusOK, p)
+			return
+		}
+		api.WriteJSON(w, http.StatusOK, k.All())
+	})
+}
@@ -0,0 +1,302 @@
+// Package position keeps the positions of every account and symbol from the
+// fills of their orders, with the P&L of the day, and reconciles them with
+// the positions reported by the venue.
+package position
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+	"github.com/phimaker/waanx-fix-simpler/internal/logger"
+	"github.com/phimaker/waanx-fix-simpler/internal/metrics"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	log = logger.Named(logger.ComponentOrders)
+
+	breakCounter = metrics.NewCounterVec("position_breaks")
+)
+
+type key struct {
+	account string
+	symbol  string
+}
+
+// Keeper keeps the positions from the start of day positions and the fills
+// of the execution reports. Its P&L is the one of the trading day, started
+// over when a new day starts. It implements risk.Exposure and is safe for
+// concurrent use.
+type Keeper struct {
+	mu        sync.Mutex
+	positions map[key]*domain.Position
+	execs     map[string]time.Time // ExecIDs of the fills applied, by day
+	breaks    map[key]event.PositionBreak
+	mark      func(symbol string) (decimal.Decimal, bool)
+	dayStart  func(t time.Time) time.Time
+	now       func() time.Time
+	day       time.Time // start of the current trading day
+}
+
+type KeeperOpt func(*Keeper)
+
+// WithMarks sets the price positions are marked at for their unrealized
+// P&L, e.g. the mid of the book. Positions are not marked by default.
+func WithMarks(mark func(symbol string) (decimal.Decimal, bool)) KeeperOpt {
+	return func(k *Keeper) {
+		k.mark = mark
+	}
+}
+
+// WithTradingDays sets the start of the trading day of a time, midnight UTC
+// by default.
+func WithTradingDays(dayStart func(t time.Time) time.Time) KeeperOpt {
+	return func(k *Keeper) {
+		k.dayStart = dayStart
+	}
+}
+
+// WithClock sets the clock telling when a new trading day starts.
+func WithClock(now func() time.Time) KeeperOpt {
+	return func(k *Keeper) {
+		k.now = now
+	}
+}
+
+func NewKeeper(opts ...KeeperOpt) *Keeper {
+	k := &Keeper{
+		positions: make(map[key]*domain.Position),
+		execs:     make(map[string]time.Time),
+		breaks:    make(map[key]event.PositionBreak),
+		mark:      func(string) (decimal.Decimal, bool) { return decimal.Zero, false },
+		dayStart:  func(t time.Time) time.Time { return t.UTC().Truncate(24 * time.Hour) },
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(k)
+	}
+	k.day = k.dayStart(k.now())
+	return k
+}
+
+// DayStart returns the start of the current trading day.
+func (k *Keeper) DayStart() time.Time {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.roll()
+	return k.day
+}
+
+// roll starts a new trading day once it has started: the P&L starts over
+// from the last mark, which becomes the average price, and the ExecIDs of the
+// day before the one ending are forgotten. k.mu must be held.
+func (k *Keeper) roll() {
+	start := k.dayStart(k.now())
+	if !start.After(k.day) {
+		return
+	}
+	for _, p := range k.positions {
+		k.markTo(p)
+		if p.Mark.IsPositive() && !p.Qty.IsZero() {
+			p.AvgPx = p.Mark
+		}
+		p.Realized = decimal.Zero
+		p.MarkTo(p.Mark)
+	}
+	for id, at := range k.execs {
+		if at.Before(k.day) {
+			delete(k.execs, id)
+		}
+	}
+	log.Infof("Trading day started at %s, P&L reset", start.Format(time.RFC3339))
+	k.day = start
+}
+
+// Load replaces the positions with the start of day positions, without P&L.
+// Their average price is the price the P&L of the day is counted from, e.g.
+// the previous close.
+func (k *Keeper) Load(positions []domain.Position) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.positions = make(map[key]*domain.Position, len(positions))
+	k.execs = make(map[string]time.Time)
+	k.day = k.dayStart(k.now())
+	k.breaks = make(map[key]event.PositionBreak)
+	for _, p := range positions {
+		p.Realized, p.Unrealized, p.Mark = decimal.Zero, decimal.Zero, decimal.Zero
+		k.positions[key{p.Account, p.Symbol}] = &p
+	}
+	log.Infof("Loaded %d start of day positions", len(positions))
+}
+
+// Apply adds the fill of e to the position of its account and symbol and
+// returns the position. Executions without a fill, or already applied, are
+// ignored.
+func (k *Keeper) Apply(e domain.Execution) (domain.Position, bool) {
+	if !e.Fill() {
+		return domain.Position{}, false
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.roll()
+	if _, ok := k.execs[e.ExecID]; ok {
+		return domain.Position{}, false
+	}
+	k.execs[e.ExecID] = k.now()
+
+	p := k.position(e.Account, e.Symbol)
+	p.Fill(e.Side, e.LastQty, e.LastPx, e.Time)
+	k.markTo(p)
+	return *p, true
+}
+
+// position returns the position of account in symbol, creating it. k.mu
+// must be held.
+func (k *Keeper) position(account, symbol string) *domain.Position {
+	p, ok := k.positions[key{account, symbol}]
+	if !ok {
+		p = &domain.Position{Account: account, Symbol: symbol}
+		k.positions[key{account, symbol}] = p
+	}
+	return p
+}
+
+// markTo marks p at the current price of its symbol, keeping the last mark
+// when there is none. k.mu must be held.
+func (k *Keeper) markTo(p *domain.Position) {
+	if mark, ok := k.mark(p.Symbol); ok && mark.IsPositive() {
+		p.MarkTo(mark)
+	}
+}
+
+// Position implements risk.Exposure.
+func (k *Keeper) Position(account, symbol string) decimal.Decimal {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if p, ok := k.positions[key{account, symbol}]; ok {
+		return p.Qty
+	}
+	return decimal.Zero
+}
+
+// DailyPnL implements risk.Exposure, marking every position.
+func (k *Keeper) DailyPnL() decimal.Decimal {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.roll()
+	pnl := decimal.Zero
+	for _, p := range k.positions {
+		k.markTo(p)
+		pnl = pnl.Add(p.PnL())
+	}
+	return pnl
+}
+
+// Get returns the marked position of account in symbol.
+func (k *Keeper) Get(account, symbol string) (domain.Position, bool) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.roll()
+	p, ok := k.positions[key{account, symbol}]
+	if !ok {
+		return domain.Position{}, false
+	}
+	k.markTo(p)
+	return *p, true
+}
+
+// All returns the marked positions, by account and symbol.
+func (k *Keeper) All() []domain.Position {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.roll()
+	out := make([]domain.Position, 0, len(k.positions))
+	for _, p := range k.positions {
+		k.markTo(p)
+		out = append(out, *p)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Account != out[j].Account {
+			return out[i].Account < out[j].Account
+		}
+		return out[i].Symbol < out[j].Symbol
+	})
+	return out
+}
+
+// Reconcile compares the positions of account with the ones reported by the
+// venue, every position of the account not reported being flat, and returns
+// the breaks. They are kept until the next reconciliation of the account.
+func (k *Keeper) Reconcile(account string, reported []domain.Position, at time.Time) []event.PositionBreak {
+	venue := make(map[string]decimal.Decimal, len(reported))
+	for _, p := range reported {
+		venue[p.Symbol] = venue[p.Symbol].Add(p.Qty)
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	symbols := make(map[string]struct{}, len(venue))
+	for symbol := range venue {
+		symbols[symbol] = struct{}{}
+	}
+	for pk := range k.positions {
+		if pk.account == account {
+			symbols[pk.symbol] = struct{}{}
+		}
+	}
+	for pk := range k.breaks {
+		if pk.account == account {
+			delete(k.breaks, pk)
+		}
+	}
+
+	var breaks []event.PositionBreak
+	for symbol := range symbols {
+		local := decimal.Zero
+		if p, ok := k.positions[key{account, symbol}]; ok {
+			local = p.Qty
+		}
+		if local.Equal(venue[symbol]) {
+			continue
+		}
+		b := event.PositionBreak{Account: account, Symbol: symbol, Local: local, Venue: venue[symbol], Time: at}
+		k.breaks[key{account, symbol}] = b
+		breaks = append(breaks, b)
+		breakCounter.Inc(account, symbol)
+		log.Warnf("[BREAK] %s %s: position %s, venue reports %s", account, symbol, local, b.Venue)
+	}
+	sort.Slice(breaks, func(i, j int) bool { return breaks[i].Symbol < breaks[j].Symbol })
+	if len(breaks) == 0 {
+		log.Infof("Positions of %q reconciled with %d venue reports", account, len(reported))
+	}
+	return breaks
+}
+
+// Breaks returns the breaks found by the last reconciliation of every
+// account, by account and symbol.
+func (k *Keeper) Breaks() []event.PositionBreak {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	out := make([]event.PositionBreak, 0, len(k.breaks))
+	for _, b := range k.breaks {
+		out = append(out, b)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Account != out[j].Account {
+			return out[i].Account < out[j].Account
+		}
+		return out[i].Symbol < out[j].Symbol
+	})
+	return out
+}
+
+// Snapshot implements service.Snapshotter.
+func (k *Keeper) Snapshot() any {
+	return map[string]any{
+		"positions": k.All(),
+		"breaks":    k.Breaks(),
+	}
+}
@@ -0,0 +1,56 @@
+package position
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+)
+
+// LoadFile reads the start of day positions from a JSON file holding an
+// array of positions, e.g.
+//
+//	[{"account":"A1","symbol":"BTC-USDT","qty":"1.5","avgPx":"65000"}]
+func LoadFile(path string) ([]domain.Position, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading start of day positions: %w", err)
+	}
+	var positions []domain.Position
+	if err := json.Unmarshal(data, &positions); err != nil {
+		return nil, fmt.Errorf("error decoding start of day positions %s: %w", path, err)
+	}
+	return positions, nil
+}
+
+var tableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// LoadTable reads the start of day positions from the account, symbol, qty
+// and avg_px columns of table.
+func LoadTable(ctx context.Context, db *sql.DB, table string) ([]domain.Position, error) {
+	if !tableName.MatchString(table) {
+		return nil, fmt.Errorf("invalid start of day positions table %q", table)
+	}
+	rows, err := db.QueryContext(ctx, "SELECT account, symbol, qty, avg_px FROM "+table)
+	if err != nil {
+		return nil, fmt.Errorf("error querying start of day positions: %w", err)
+	}
+	defer rows.Close()
+
+	var positions []domain.Position
+	for rows.Next() {
+		var p domain.Position
+		if err := rows.Scan(&p.Account, &p.Symbol, &p.Qty, &p.AvgPx); err != nil {
+			return nil, fmt.Errorf("error reading start of day position: %w", err)
+		}
+		positions = append(positions, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error reading start of day positions: %w", err)
+	}
+	return positions, nil
+}
@@ -0,0 +1,92 @@
+package publisher
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/config"
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+	"github.com/phimaker/waanx-fix-simpler/internal/logger"
+)
+
+// Output types of config.PublisherTarget.
+const (
+	TypeLog    = "log"
+	TypeStdout = "stdout"
+	TypeFile   = "file"
+)
+
+// Output delivers events outside the adapter.
+type Output interface {
+	Write(e event.Event) error
+	Close() error
+}
+
+// record is how events are encoded by the JSON outputs.
+type record struct {
+	Topic string      `json:"topic"`
+	Time  time.Time   `json:"time"`
+	Data  event.Event `json:"data"`
+}
+
+// NewOutput creates the output described by t.
+func NewOutput(t config.PublisherTarget) (Output, error) {
+	switch t.Type {
+	case TypeLog, "":
+		return &logOutput{log: logger.Named(logger.ComponentMarketData)}, nil
+	case TypeStdout:
+		return &jsonOutput{w: os.Stdout}, nil
+	case TypeFile:
+		if t.Address == "" {
+			return nil, fmt.Errorf("publisher target %s: file output needs an address", t.Name)
+		}
+		f, err := os.OpenFile(t.Address, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("error opening publisher target %s(%s): %w", t.Name, t.Address, err)
+		}
+		return &jsonOutput{w: f, c: f}, nil
+	}
+	return nil, fmt.Errorf("publisher target %s: unknown type %q", t.Name, t.Type)
+}
+
+type logOutput struct {
+	log *logger.Logger
+}
+
+func (o *logOutput) Write(e event.Event) error {
+	o.log.Infow("Event", "topic", e.Topic(), "data", e)
+	return nil
+}
+
+func (o *logOutput) Close() error {
+	return nil
+}
+
+// jsonOutput writes one JSON record per line.
+type jsonOutput struct {
+	mu sync.Mutex
+	w  io.Writer
+	c  io.Closer
+}
+
+func (o *jsonOutput) Write(e event.Event) error {
+	b, err := json.Marshal(record{Topic: e.Topic(), Time: time.Now(), Data: e})
+	if err != nil {
+		return err
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	_, err = o.w.Write(append(b, '\n'))
+	return err
+}
+
+func (o *jsonOutput) Close() error {
+	if o.c == nil {
+		return nil
+	}
+	return o.c.Close()
+}
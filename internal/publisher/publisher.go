@@ -0,0 +1,74 @@
+// Package publisher delivers the events of the bus to the outputs configured
+// in the publisher section. Every output is written from its own goroutine
+// behind a bounded queue, so the FIX receive path publishing the events is
+// never blocked by a slow consumer.
+package publisher
+
+import (
+	"sync"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/config"
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+	"github.com/phimaker/waanx-fix-simpler/internal/logger"
+	"github.com/phimaker/waanx-fix-simpler/internal/metrics"
+)
+
+var (
+	log = logger.Named(logger.ComponentMarketData)
+
+	publishedCounter = metrics.NewCounterVec("publisher_events")
+	errorCounter     = metrics.NewCounterVec("publisher_errors")
+	droppedCounter   = metrics.NewCounterVec("publisher_dropped")
+	conflatedCounter = metrics.NewCounterVec("publisher_conflated")
+)
+
+// Publisher subscribes every configured target to its topics.
+type Publisher struct {
+	mu      sync.Mutex
+	bus     *event.Bus
+	targets []*target
+}
+
+func New(bus *event.Bus) *Publisher {
+	return &Publisher{bus: bus}
+}
+
+// Apply replaces the current targets with targets. A target that cannot be
+// created is logged and skipped so the others keep publishing.
+func (p *Publisher) Apply(targets []config.PublisherTarget) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closeTargets()
+
+	for _, t := range targets {
+		out, err := NewOutput(t)
+		if err != nil {
+			log.Errorf("Error creating publisher target: %v", err)
+			continue
+		}
+		tg := newTarget(t, out)
+		topics := t.Topics
+		if len(topics) == 0 {
+			topics = []string{event.TopicAll}
+		}
+		for _, topic := range topics {
+			tg.unsubscribe = append(tg.unsubscribe, p.bus.Subscribe(topic, tg.publish))
+		}
+		p.targets = append(p.targets, tg)
+		log.Infof("Publishing %v to %s (%s), conflate %s, depth %d", topics, t.Name, t.Type, t.Conflate, t.Depth)
+	}
+}
+
+// Close unsubscribes and closes all targets.
+func (p *Publisher) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closeTargets()
+}
+
+func (p *Publisher) closeTargets() {
+	for _, t := range p.targets {
+		t.close()
+	}
+	p.targets = nil
+}
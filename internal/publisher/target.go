@@ -0,0 +1,150 @@
+package publisher
+
+import (
+	"sync"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/config"
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+)
+
+// DefaultQueueSize is the number of events a target buffers when its
+// queue-size is not set.
+const DefaultQueueSize = 10000
+
+// target delivers events to an output from its own goroutine, so a slow
+// output never blocks the publisher of the event. Events are queued up to
+// the queue size and dropped beyond it. With conflation, state events only
+// keep the latest of each key and are delivered every conflate interval.
+type target struct {
+	name        string
+	out         Output
+	depth       int
+	conflate    time.Duration
+	unsubscribe []func()
+
+	queue chan event.Event
+
+	mu     sync.Mutex
+	latest map[string]event.State
+	keys   []string // in order of arrival, to deliver them in order
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func newTarget(t config.PublisherTarget, out Output) *target {
+	size := t.QueueSize
+	if size <= 0 {
+		size = DefaultQueueSize
+	}
+	tg := &target{
+		name:     t.Name,
+		out:      out,
+		depth:    t.Depth,
+		conflate: t.Conflate,
+		queue:    make(chan event.Event, size),
+		latest:   make(map[string]event.State),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go tg.run()
+	return tg
+}
+
+// publish is the bus handler of the target. It never blocks.
+func (t *target) publish(e event.Event) {
+	if s, ok := e.(event.State); ok && t.conflate > 0 {
+		key := s.Topic() + "|" + s.StateKey()
+		t.mu.Lock()
+		if _, ok := t.latest[key]; ok {
+			conflatedCounter.Inc(t.name, e.Topic())
+		} else {
+			t.keys = append(t.keys, key)
+		}
+		t.latest[key] = s
+		t.mu.Unlock()
+		return
+	}
+
+	select {
+	case t.queue <- e:
+	default:
+		droppedCounter.Inc(t.name, e.Topic())
+	}
+}
+
+func (t *target) run() {
+	defer close(t.done)
+
+	var tick <-chan time.Time
+	if t.conflate > 0 {
+		ticker := time.NewTicker(t.conflate)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		select {
+		case e := <-t.queue:
+			t.write(e)
+		case <-tick:
+			t.flush()
+		case <-t.stop:
+			for {
+				select {
+				case e := <-t.queue:
+					t.write(e)
+				default:
+					t.flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// flush delivers the conflated state events.
+func (t *target) flush() {
+	t.mu.Lock()
+	keys, latest := t.keys, t.latest
+	t.keys, t.latest = nil, make(map[string]event.State, len(latest))
+	t.mu.Unlock()
+
+	for _, key := range keys {
+		t.write(latest[key])
+	}
+}
+
+func (t *target) write(e event.Event) {
+	if b, ok := e.(event.Book); ok && t.depth > 0 {
+		b.Bids = top(b.Bids, t.depth)
+		b.Asks = top(b.Asks, t.depth)
+		e = b
+	}
+	if err := t.out.Write(e); err != nil {
+		errorCounter.Inc(t.name)
+		log.Errorf("Error publishing %s to %s: %v", e.Topic(), t.name, err)
+		return
+	}
+	publishedCounter.Inc(t.name, e.Topic())
+}
+
+// close unsubscribes the target, delivers what it holds and closes the
+// output.
+func (t *target) close() {
+	for _, unsubscribe := range t.unsubscribe {
+		unsubscribe()
+	}
+	close(t.stop)
+	<-t.done
+	if err := t.out.Close(); err != nil {
+		log.Errorf("Error closing publisher target %s: %v", t.name, err)
+	}
+}
+
+func top[T any](levels []T, n int) []T {
+	if len(levels) > n {
+		return levels[:n]
+	}
+	return levels
+}
@@ -0,0 +1,373 @@
+// Package risk runs the pre-trade checks of outgoing orders: the kill switch,
+// trading status, fat finger checks against the security master, size and
+// price limits, open orders, position and daily loss limits.
+package risk
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/config"
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/logger"
+	"github.com/phimaker/waanx-fix-simpler/internal/metrics"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	log = logger.Named(logger.ComponentOrders)
+
+	rejectionCounter = metrics.NewCounterVec("risk_rejections")
+)
+
+// Rules, used as rejection rules and counter labels.
+const (
+	RuleKillSwitch        = "kill_switch"
+	RuleInvalidOrder      = "invalid_order"
+	RuleUnknownInstrument = "unknown_instrument"
+	RuleNotTrading        = "not_trading"
+	RuleTickSize          = "tick_size"
+	RuleLotSize           = "lot_size"
+	RuleMinNotional       = "min_notional"
+	RuleMaxQty            = "max_qty"
+	RuleMaxNotional       = "max_notional"
+	RulePriceCollar       = "price_collar"
+	RuleNoReferencePrice  = "no_reference_price"
+	RuleMaxOpenOrders     = "max_open_orders"
+	RuleMaxPosition       = "max_position"
+	RuleDailyLoss         = "daily_loss"
+)
+
+// DefaultLimits is the key of config.Risk.Symbols applying to symbols
+// without their own limits.
+const DefaultLimits = "*"
+
+// Rejection is the error of an order failing a check.
+type Rejection struct {
+	Rule   string `json:"rule"`
+	Reason string `json:"reason"`
+}
+
+func (r *Rejection) Error() string {
+	return fmt.Sprintf("risk check %s failed: %s", r.Rule, r.Reason)
+}
+
+func reject(rule, format string, args ...any) *Rejection {
+	return &Rejection{Rule: rule, Reason: fmt.Sprintf(format, args...)}
+}
+
+// Exposure is the position and P&L the position and loss limits apply to.
+type Exposure interface {
+	// Position returns the signed position of account in symbol.
+	Position(account, symbol string) decimal.Decimal
+	// DailyPnL returns the realized and unrealized P&L of the day.
+	DailyPnL() decimal.Decimal
+}
+
+type symbolLimits struct {
+	maxQty      decimal.Decimal
+	maxNotional decimal.Decimal
+	maxPosition decimal.Decimal
+}
+
+type limits struct {
+	killSwitch    bool
+	maxOpenOrders int
+	maxDailyLoss  decimal.Decimal
+	collar        decimal.Decimal // fraction of the reference price
+	symbols       map[string]symbolLimits
+}
+
+func newLimits(cfg config.Risk) limits {
+	l := limits{
+		killSwitch:    cfg.KillSwitch,
+		maxOpenOrders: cfg.MaxOpenOrders,
+		maxDailyLoss:  decimal.NewFromFloat(cfg.MaxDailyLoss),
+		collar:        decimal.NewFromFloat(cfg.CollarPercent).Div(decimal.NewFromInt(100)),
+		symbols:       make(map[string]symbolLimits, len(cfg.Symbols)),
+	}
+	for symbol, s := range cfg.Symbols {
+		l.symbols[strings.ToLower(symbol)] = symbolLimits{
+			maxQty:      decimal.NewFromFloat(s.MaxQty),
+			maxNotional: decimal.NewFromFloat(s.MaxNotional),
+			maxPosition: decimal.NewFromFloat(s.MaxPosition),
+		}
+	}
+	return l
+}
+
+func (l limits) symbol(symbol string) symbolLimits {
+	if s, ok := l.symbols[strings.ToLower(symbol)]; ok {
+		return s
+	}
+	return l.symbols[DefaultLimits]
+}
+
+// Checker checks orders against the risk limits. The kill switch can be set
+// by the configuration or at runtime with Kill.
+type Checker struct {
+	mu         sync.RWMutex
+	limits     limits
+	cfg        config.Risk
+	killed     bool
+	killReason string
+
+	instrument func(symbol string) (domain.Instrument, bool)
+	trading    func(symbol string) bool
+	reference  func(symbol string) (decimal.Decimal, bool)
+	openOrders func() []domain.Order
+	exposure   Exposure
+}
+
+type CheckerOpt func(*Checker)
+
+// WithInstruments looks up the trading rules of symbols in the security
+// master. Without it orders are not checked against tick and lot sizes.
+func WithInstruments(fn func(symbol string) (domain.Instrument, bool)) CheckerOpt {
+	return func(c *Checker) {
+		c.instrument = fn
+	}
+}
+
+// WithTradingHours rejects orders for the symbols for which trading returns
+// false, e.g. halted or out of their trading session.
+func WithTradingHours(trading func(symbol string) bool) CheckerOpt {
+	return func(c *Checker) {
+		c.trading = trading
+	}
+}
+
+// WithReferencePrice sets the price limit orders are collared around and
+// market orders are valued at.
+func WithReferencePrice(fn func(symbol string) (decimal.Decimal, bool)) CheckerOpt {
+	return func(c *Checker) {
+		c.reference = fn
+	}
+}
+
+// WithOpenOrders counts the open orders towards the open order and position
+// limits.
+func WithOpenOrders(fn func() []domain.Order) CheckerOpt {
+	return func(c *Checker) {
+		c.openOrders = fn
+	}
+}
+
+// WithExposure enables the position and daily loss limits.
+func WithExposure(e Exposure) CheckerOpt {
+	return func(c *Checker) {
+		c.exposure = e
+	}
+}
+
+func NewChecker(cfg config.Risk, opts ...CheckerOpt) *Checker {
+	c := &Checker{
+		limits:     newLimits(cfg),
+		cfg:        cfg,
+		trading:    func(string) bool { return true },
+		openOrders: func() []domain.Order { return nil },
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.exposure == nil && (cfg.MaxDailyLoss > 0 || hasPositionLimit(cfg)) {
+		log.Warn("Risk position and daily loss limits are configured but no exposure is available, they are not checked")
+	}
+	return c
+}
+
+func hasPositionLimit(cfg config.Risk) bool {
+	for _, s := range cfg.Symbols {
+		if s.MaxPosition > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply replaces the limits, e.g. after a configuration reload.
+func (c *Checker) Apply(cfg config.Risk) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.limits = newLimits(cfg)
+	c.cfg = cfg
+	log.Infof("Risk limits applied, kill switch %t", cfg.KillSwitch)
+}
+
+// Kill rejects every new order until Resume is called.
+func (c *Checker) Kill(reason string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.killed, c.killReason = true, reason
+	log.Warnf("[KILL_SWITCH] Engaged: %s", reason)
+}
+
+// Resume releases the kill switch set by Kill. The one of the configuration
+// stays engaged.
+func (c *Checker) Resume() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.killed, c.killReason = false, ""
+	log.Warn("[KILL_SWITCH] Released")
+}
+
+// Status is the state of the checker.
+type Status struct {
+	Killed     bool        `json:"killed"`
+	KillReason string      `json:"killReason,omitempty"`
+	Limits     config.Risk `json:"limits"`
+}
+
+func (c *Checker) Status() Status {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s := Status{Killed: c.killed || c.limits.killSwitch, KillReason: c.killReason, Limits: c.cfg}
+	if c.limits.killSwitch {
+		s.KillReason = "configuration"
+	}
+	return s
+}
+
+// Check returns a *Rejection if o fails a check. The order itself may
+// already be among the open orders, it is not counted twice.
+func (c *Checker) Check(o domain.Order) error {
+	if r := c.check(o); r != nil {
+		rejectionCounter.Inc(r.Rule, o.Symbol)
+		log.Warnw("[RISK] Order rejected", "clOrdId", o.ClOrdID, "symbol", o.Symbol, "rule", r.Rule, "reason", r.Reason)
+		return r
+	}
+	return nil
+}
+
+func (c *Checker) check(o domain.Order) *Rejection {
+	c.mu.RLock()
+	l := c.limits
+	killed, reason := c.killed, c.killReason
+	c.mu.RUnlock()
+
+	switch {
+	case l.killSwitch:
+		return reject(RuleKillSwitch, "kill switch engaged by configuration")
+	case killed:
+		return reject(RuleKillSwitch, "kill switch engaged: %s", reason)
+	case !o.Qty.IsPositive():
+		return reject(RuleInvalidOrder, "quantity %s is not positive", o.Qty)
+	case o.Type == domain.OrderTypeLimit && !o.Price.IsPositive():
+		return reject(RuleInvalidOrder, "limit price %s is not positive", o.Price)
+	case !c.trading(o.Symbol):
+		return reject(RuleNotTrading, "%s is halted or its trading session is closed", o.Symbol)
+	}
+
+	if r := c.checkInstrument(o); r != nil {
+		return r
+	}
+
+	ref, hasRef := decimal.Zero, false
+	if c.reference != nil {
+		ref, hasRef = c.reference(o.Symbol)
+	}
+	notional := o.Notional()
+	if o.Type != domain.OrderTypeLimit {
+		notional = ref.Mul(o.Qty)
+	}
+
+	sl := l.symbol(o.Symbol)
+	if sl.maxQty.IsPositive() && o.Qty.GreaterThan(sl.maxQty) {
+		return reject(RuleMaxQty, "quantity %s above %s", o.Qty, sl.maxQty)
+	}
+	if sl.maxNotional.IsPositive() {
+		if o.Type != domain.OrderTypeLimit && !hasRef {
+			return reject(RuleNoReferencePrice, "no price to value the market order for %s", o.Symbol)
+		}
+		if notional.GreaterThan(sl.maxNotional) {
+			return reject(RuleMaxNotional, "notional %s above %s", notional, sl.maxNotional)
+		}
+	}
+	if r := collar(o, ref, hasRef, l.collar); r != nil {
+		return r
+	}
+
+	open := c.openOrders()
+	if r := c.checkOpenOrders(o, open, l, sl); r != nil {
+		return r
+	}
+
+	if c.exposure != nil && l.maxDailyLoss.IsPositive() {
+		if pnl := c.exposure.DailyPnL(); pnl.LessThan(l.maxDailyLoss.Neg()) {
+			return reject(RuleDailyLoss, "daily P&L %s beyond the loss limit %s", pnl, l.maxDailyLoss)
+		}
+	}
+	return nil
+}
+
+// checkInstrument runs the fat finger checks against the trading rules of
+// the security master.
+func (c *Checker) checkInstrument(o domain.Order) *Rejection {
+	if c.instrument == nil {
+		return nil
+	}
+	inst, ok := c.instrument(o.Symbol)
+	switch {
+	case !ok:
+		return reject(RuleUnknownInstrument, "%s is not in the security list", o.Symbol)
+	case inst.Halted:
+		return reject(RuleNotTrading, "%s is halted (%s)", o.Symbol, inst.TradingStatus)
+	case o.Type == domain.OrderTypeLimit && !inst.ValidPrice(o.Price):
+		return reject(RuleTickSize, "price %s is not a multiple of the tick size %s", o.Price, inst.TickSize)
+	case !inst.ValidQty(o.Qty):
+		return reject(RuleLotSize, "quantity %s is not a multiple of the lot size %s", o.Qty, inst.LotSize)
+	case o.Type == domain.OrderTypeLimit && inst.MinNotional.IsPositive() && o.Notional().LessThan(inst.MinNotional):
+		return reject(RuleMinNotional, "notional %s below the minimum %s", o.Notional(), inst.MinNotional)
+	}
+	return nil
+}
+
+// collar rejects limit orders priced through the reference by more than the
+// collar: buys above it, sells below it.
+func collar(o domain.Order, ref decimal.Decimal, hasRef bool, pct decimal.Decimal) *Rejection {
+	if o.Type != domain.OrderTypeLimit || !pct.IsPositive() || !hasRef || !ref.IsPositive() {
+		return nil
+	}
+	one := decimal.NewFromInt(1)
+	if high := ref.Mul(one.Add(pct)); o.Side == domain.SideBuy && o.Price.GreaterThan(high) {
+		return reject(RulePriceCollar, "buy at %s above %s, the reference %s plus the collar", o.Price, high, ref)
+	}
+	if low := ref.Mul(one.Sub(pct)); o.Side == domain.SideSell && o.Price.LessThan(low) {
+		return reject(RulePriceCollar, "sell at %s below %s, the reference %s minus the collar", o.Price, low, ref)
+	}
+	return nil
+}
+
+// checkOpenOrders checks the open order count and the position the account
+// would reach if its open orders on the side of o and o were all filled.
+func (c *Checker) checkOpenOrders(o domain.Order, open []domain.Order, l limits, sl symbolLimits) *Rejection {
+	count := 1
+	pending := o.Side.Signed(o.Qty)
+	for _, other := range open {
+		if other.ClOrdID == o.ClOrdID {
+			continue
+		}
+		count++
+		if other.Account == o.Account && other.Symbol == o.Symbol && other.Side == o.Side {
+			pending = pending.Add(other.Side.Signed(other.LeavesQty))
+		}
+	}
+	if l.maxOpenOrders > 0 && count > l.maxOpenOrders {
+		return reject(RuleMaxOpenOrders, "%d open orders above %d", count, l.maxOpenOrders)
+	}
+
+	if c.exposure == nil || !sl.maxPosition.IsPositive() {
+		return nil
+	}
+	position := c.exposure.Position(o.Account, o.Symbol).Add(pending)
+	if position.Abs().GreaterThan(sl.maxPosition) {
+		return reject(RuleMaxPosition, "position would reach %s, above %s", position, sl.maxPosition)
+	}
+	return nil
+}
+
+// Snapshot implements service.Snapshotter.
+func (c *Checker) Snapshot() any {
+	return c.Status()
+}
@@ -0,0 +1,150 @@
+package risk
+
+import (
+	"testing"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/config"
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+type exposure struct {
+	position decimal.Decimal
+	pnl      decimal.Decimal
+}
+
+func (e exposure) Position(account, symbol string) decimal.Decimal { return e.position }
+
+func (e exposure) DailyPnL() decimal.Decimal { return e.pnl }
+
+var d = decimal.RequireFromString
+
+func TestCheckerCheck(t *testing.T) {
+	btc := domain.Instrument{Symbol: "BTC-USDT", TickSize: d("0.5"), LotSize: d("0.1"), MinNotional: d("10")}
+	limit := func(side domain.Side, qty, price string) domain.Order {
+		return domain.Order{ClOrdID: "ORD-1", Account: "ACC1", Symbol: "BTC-USDT", Side: side,
+			Type: domain.OrderTypeLimit, Qty: d(qty), Price: d(price)}
+	}
+	market := domain.Order{ClOrdID: "ORD-1", Account: "ACC1", Symbol: "BTC-USDT", Side: domain.SideBuy,
+		Type: domain.OrderTypeMarket, Qty: d("1")}
+	open := domain.Order{ClOrdID: "ORD-0", Account: "ACC1", Symbol: "BTC-USDT", Side: domain.SideBuy,
+		Type: domain.OrderTypeLimit, Qty: d("2"), LeavesQty: d("2"), Price: d("100")}
+
+	tests := []struct {
+		name     string
+		cfg      config.Risk
+		killed   string // reason of a runtime kill
+		halted   bool
+		unknown  bool // not in the security list
+		ref      string
+		open     []domain.Order
+		exposure *exposure
+		order    domain.Order
+		want     string // rule, empty when accepted
+	}{
+		{name: "accepted", order: limit(domain.SideBuy, "1", "100")},
+		{name: "kill switch", cfg: config.Risk{KillSwitch: true}, order: limit(domain.SideBuy, "1", "100"), want: RuleKillSwitch},
+		{name: "runtime kill", killed: "test", order: limit(domain.SideBuy, "1", "100"), want: RuleKillSwitch},
+		{name: "zero quantity", order: limit(domain.SideBuy, "0", "100"), want: RuleInvalidOrder},
+		{name: "zero limit price", order: limit(domain.SideBuy, "1", "0"), want: RuleInvalidOrder},
+		{name: "not trading", halted: true, order: limit(domain.SideBuy, "1", "100"), want: RuleNotTrading},
+		{name: "unknown instrument", unknown: true, order: limit(domain.SideBuy, "1", "100"), want: RuleUnknownInstrument},
+		{name: "off tick", order: limit(domain.SideBuy, "1", "100.2"), want: RuleTickSize},
+		{name: "off lot", order: limit(domain.SideBuy, "1.05", "100"), want: RuleLotSize},
+		{name: "below min notional", order: limit(domain.SideBuy, "0.1", "50"), want: RuleMinNotional},
+		{
+			name:  "max qty by symbol, case insensitive",
+			cfg:   config.Risk{Symbols: map[string]config.SymbolLimits{"btc-usdt": {MaxQty: 5}}},
+			order: limit(domain.SideBuy, "6", "100"),
+			want:  RuleMaxQty,
+		},
+		{
+			name:  "default limits",
+			cfg:   config.Risk{Symbols: map[string]config.SymbolLimits{DefaultLimits: {MaxNotional: 500}}},
+			order: limit(domain.SideBuy, "6", "100"),
+			want:  RuleMaxNotional,
+		},
+		{
+			name:  "market order without reference",
+			cfg:   config.Risk{Symbols: map[string]config.SymbolLimits{DefaultLimits: {MaxNotional: 500}}},
+			order: market,
+			want:  RuleNoReferencePrice,
+		},
+		{
+			name:  "market order valued at the reference",
+			cfg:   config.Risk{Symbols: map[string]config.SymbolLimits{DefaultLimits: {MaxNotional: 500}}},
+			ref:   "600",
+			order: market,
+			want:  RuleMaxNotional,
+		},
+		{name: "buy through the collar", cfg: config.Risk{CollarPercent: 5}, ref: "100", order: limit(domain.SideBuy, "1", "105.5"), want: RulePriceCollar},
+		{name: "buy within the collar", cfg: config.Risk{CollarPercent: 5}, ref: "100", order: limit(domain.SideBuy, "1", "105")},
+		{name: "passive sell", cfg: config.Risk{CollarPercent: 5}, ref: "100", order: limit(domain.SideSell, "1", "200")},
+		{name: "sell through the collar", cfg: config.Risk{CollarPercent: 5}, ref: "100", order: limit(domain.SideSell, "1", "94.5"), want: RulePriceCollar},
+		{name: "max open orders", cfg: config.Risk{MaxOpenOrders: 1}, open: []domain.Order{open}, order: limit(domain.SideBuy, "1", "100"), want: RuleMaxOpenOrders},
+		{
+			name:  "order itself not counted twice",
+			cfg:   config.Risk{MaxOpenOrders: 1},
+			open:  []domain.Order{limit(domain.SideBuy, "1", "100")},
+			order: limit(domain.SideBuy, "1", "100"),
+		},
+		{
+			name:     "position with open orders",
+			cfg:      config.Risk{Symbols: map[string]config.SymbolLimits{DefaultLimits: {MaxPosition: 5}}},
+			open:     []domain.Order{open},
+			exposure: &exposure{position: d("2.5")},
+			order:    limit(domain.SideBuy, "1", "100"),
+			want:     RuleMaxPosition,
+		},
+		{
+			name:     "position reduced",
+			cfg:      config.Risk{Symbols: map[string]config.SymbolLimits{DefaultLimits: {MaxPosition: 5}}},
+			open:     []domain.Order{open},
+			exposure: &exposure{position: d("4")},
+			order:    limit(domain.SideSell, "1", "100"),
+		},
+		{
+			name:     "daily loss",
+			cfg:      config.Risk{MaxDailyLoss: 1000},
+			exposure: &exposure{pnl: d("-1000.01")},
+			order:    limit(domain.SideBuy, "1", "100"),
+			want:     RuleDailyLoss,
+		},
+		{
+			name:     "daily loss at the limit",
+			cfg:      config.Risk{MaxDailyLoss: 1000},
+			exposure: &exposure{pnl: d("-1000")},
+			order:    limit(domain.SideBuy, "1", "100"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := []CheckerOpt{
+				WithInstruments(func(symbol string) (domain.Instrument, bool) { return btc, !tt.unknown }),
+				WithTradingHours(func(string) bool { return !tt.halted }),
+				WithReferencePrice(func(string) (decimal.Decimal, bool) {
+					if tt.ref == "" {
+						return decimal.Zero, false
+					}
+					return d(tt.ref), true
+				}),
+				WithOpenOrders(func() []domain.Order { return tt.open }),
+			}
+			if tt.exposure != nil {
+				opts = append(opts, WithExposure(*tt.exposure))
+			}
+			c := NewChecker(tt.cfg, opts...)
+			if tt.killed != "" {
+				c.Kill(tt.killed)
+			}
+
+			var got string
+			if r := c.check(tt.order); r != nil {
+				got = r.Rule
+			}
+			if got != tt.want {
+				t.Errorf("check() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
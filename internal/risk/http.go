@@ -0,0 +1,46 @@
+package risk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/api"
+)
+
+type killRequest struct {
+	Killed bool   `json:"killed"`
+	Reason string `json:"reason"`
+}
+
+// Handler serves the risk status and the kill switch.
+//
+//	GET                                        the kill switch and limits
+//	PUT {"killed":true,"reason":"..."}         engages the kill switch
+//	PUT {"killed":false}                       releases it
+func Handler(c *Checker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			var req killRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				api.WriteError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+				return
+			}
+			if req.Killed {
+				if req.Reason == "" {
+					req.Reason = "API"
+				}
+				c.Kill(req.Reason)
+			} else {
+				c.Resume()
+			}
+		default:
+			w.Header().Set("Allow", "GET, PUT")
+			api.WriteError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+		api.WriteJSON(w, http.StatusOK, c.Status())
+	})
+}
@@ -2,57 +2,116 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
 	"github.com/phimaker/waanx-fix-simpler/internal/config"
+	"github.com/phimaker/waanx-fix-simpler/internal/fix/waanx/fix44/logon"
+	"github.com/phimaker/waanx-fix-simpler/internal/idgen"
 	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/fix"
-	"github.com/phimaker/waanx-fix-simpler/internal/logger"
-	"github.com/quickfixgo/fix44/logon"
 	"github.com/quickfixgo/quickfix"
 )
 
 type FixService interface {
-	Start(ctx context.Context)
+	Start(ctx context.Context) error
 	RegisterRouters(ctx context.Context)
 	Stop()
+	// Connect starts a new FIX client unless one is running, Disconnect logs
+	// out and stops it. Unlike Start and Stop, they may be called any number
+	// of times, e.g. to follow the sessions of the venue.
+	Connect() error
+	Disconnect()
+	Connected() bool
+	// ResetSequence makes the next client log on with ResetSeqNumFlag=Y. A
+	// running client, logged on or trying to, is logged out and restarted.
+	ResetSequence()
+
+	// Application returns the FIX application routing messages to services.
+	Application() fix.FixApplication
 
 	OnLoggedOn() <-chan quickfix.SessionID
+	// SessionID returns the session logged on, false while logged out.
+	SessionID() (quickfix.SessionID, bool)
 }
 
 type fixServiceImpl struct {
-	app    fix.FixApplication
-	client *fix.Client
+	cfg      *config.Fix
+	mode     fix.ValidationMode
+	app      fix.FixApplication
+	recorder fix.Recorder
+
+	clientMu     sync.Mutex
+	client       *fix.Client
+	resetPending bool // the next client logs on with ResetSeqNumFlag=Y
 
 	heartbeatSrv    HeartbeatService
 	securityListSrv SecurityListService
 
 	loggedOnCh chan quickfix.SessionID
+
+	mu        sync.RWMutex
+	sessionID *quickfix.SessionID
 }
 
 func NewFIXService(
 	cfg *config.Fix,
 	heartbeatSrv HeartbeatService,
 	securityListSrv SecurityListService,
+	ids *idgen.Generator,
 ) (FixService, error) {
-	logger.Infof("Creating FIX service with config: %+v", cfg)
-	app, err := fix.NewApplication(
+	fixLog.Infof("Creating FIX service with config path: %s", cfg.ConfigPath)
+	dd, err := fix.LoadDataDictionary(cfg.DataDictionary)
+	if err != nil {
+		return nil, err
+	}
+	format, err := fix.ParseLogFormat(config.GetConfig().Log.FixFormat)
+	if err != nil {
+		return nil, err
+	}
+	mode, err := fix.ParseValidationMode(cfg.Validation)
+	if err != nil {
+		return nil, err
+	}
+	msgLog := fix.NewMessageLogger(fix.NewDecoder(dd, cfg.MaskTags...), format)
+	config.OnChange(func(e config.ChangeEvent) {
+		if !e.Changed(config.SectionLog) {
+			return
+		}
+		format, err := fix.ParseLogFormat(e.New.Log.FixFormat)
+		if err != nil {
+			fixLog.Errorf("Error changing FIX log format: %v", err)
+			return
+		}
+		msgLog.SetFormat(format)
+	})
+
+	appOpts := []fix.FixApplicationOpt{
 		fix.WithUsername(cfg.Username),
 		fix.WithPassword(cfg.Password),
-	)
-	if err != nil {
-		logger.Fatalf("error creating application: %w", err)
+		fix.WithMessageLogger(msgLog),
+		fix.WithValidator(fix.NewValidator(dd, mode, fix.WithSample(cfg.ValidationSample))),
+		fix.WithTestReqIDs(func() (string, error) { return ids.Next(idgen.KindTestRequest) }),
+	}
+
+	var recorder fix.Recorder
+	if cfg.RecordPath != "" {
+		fixLog.Infof("Recording messages to %s", cfg.RecordPath)
+		if recorder, err = fix.NewFileRecorder(cfg.RecordPath); err != nil {
+			return nil, err
+		}
+		appOpts = append(appOpts, fix.WithRecorder(recorder))
 	}
 
-	client, err := fix.NewClient(
-		cfg.ConfigPath,
-		app,
-	)
+	app, err := fix.NewApplication(appOpts...)
 	if err != nil {
-		logger.Fatalf("error creating client: %w", err)
+		fixLog.Fatalf("error creating application: %w", err)
 	}
 
 	return &fixServiceImpl{
+		cfg:             cfg,
+		mode:            mode,
 		app:             app,
-		client:          client,
+		recorder:        recorder,
 		securityListSrv: securityListSrv,
 		heartbeatSrv:    heartbeatSrv,
 		loggedOnCh:      make(chan quickfix.SessionID, 10),
@@ -61,28 +120,109 @@ func NewFIXService(
 
 func (s *fixServiceImpl) RegisterRouters(ctx context.Context) {
 	s.app.AddRouter(logon.Route(func(msg logon.Logon, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+		s.mu.Lock()
+		s.sessionID = &sessionID
+		s.mu.Unlock()
 		s.loggedOnCh <- sessionID
 		return nil
 	}))
 
+	s.app.AddLogoutHandler(func(sessionID quickfix.SessionID) {
+		s.mu.Lock()
+		s.sessionID = nil
+		s.mu.Unlock()
+	})
+
 	s.heartbeatSrv.RegisterRouters(s.app.AddRouter)
 	s.securityListSrv.RegisterRouters(s.app.AddRouter)
 }
 
-func (s *fixServiceImpl) Start(ctx context.Context) {
-	logger.Info("Starting FIX client")
-	if err := s.client.Start(); err != nil {
-		logger.Error("Error starting FIX client: ", err)
+// Start creates the FIX client from the session config file and starts it.
+func (s *fixServiceImpl) Start(ctx context.Context) error {
+	return s.Connect()
+}
+
+func (s *fixServiceImpl) Connect() error {
+	s.clientMu.Lock()
+	defer s.clientMu.Unlock()
+	if s.client != nil {
+		return nil
+	}
+
+	fixLog.Info("Starting FIX client")
+	opts := []fix.ClientOpt{fix.WithDataDictionary(s.cfg.DataDictionary, s.mode)}
+	if s.resetPending {
+		fixLog.Info("Logging on with ResetSeqNumFlag=Y, the sequence reset time passed")
+		opts = append(opts, fix.WithResetOnLogon())
+	}
+	client, err := fix.NewClient(s.cfg.ConfigPath, s.app, opts...)
+	if err != nil {
+		return fmt.Errorf("error creating client: %w", err)
+	}
+	if err := client.Start(); err != nil {
+		return fmt.Errorf("error starting FIX client: %w", err)
+	}
+	s.client = client
+	s.resetPending = false
+	return nil
+}
+
+func (s *fixServiceImpl) ResetSequence() {
+	s.clientMu.Lock()
+	s.resetPending = true
+	running := s.client != nil
+	s.clientMu.Unlock()
+	if !running {
+		fixLog.Info("Sequence reset time while logged out, resetting on the next logon")
 		return
 	}
+	fixLog.Info("Sequence reset time, restarting the FIX client to log on with ResetSeqNumFlag=Y")
+	s.Disconnect()
+	if err := s.Connect(); err != nil {
+		fixLog.Errorf("Error restarting the FIX client: %v", err)
+	}
 }
 
-func (s *fixServiceImpl) Stop() {
-	logger.Info("Stopping FIX client")
+func (s *fixServiceImpl) Disconnect() {
+	s.clientMu.Lock()
+	defer s.clientMu.Unlock()
+	if s.client == nil {
+		return
+	}
+	fixLog.Info("Stopping FIX client")
 	s.client.Stop()
+	s.client = nil
+}
+
+func (s *fixServiceImpl) Connected() bool {
+	s.clientMu.Lock()
+	defer s.clientMu.Unlock()
+	return s.client != nil
+}
+
+func (s *fixServiceImpl) Stop() {
+	s.Disconnect()
+	if s.recorder != nil {
+		if err := s.recorder.Close(); err != nil {
+			fixLog.Errorf("Error closing recording: %v", err)
+		}
+	}
 	close(s.loggedOnCh)
 }
 
+func (s *fixServiceImpl) Application() fix.FixApplication {
+	return s.app
+}
+
 func (s *fixServiceImpl) OnLoggedOn() <-chan quickfix.SessionID {
 	return s.loggedOnCh
 }
+
+func (s *fixServiceImpl) SessionID() (quickfix.SessionID, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.sessionID == nil {
+		return quickfix.SessionID{}, false
+	}
+	return *s.sessionID, true
+}
@@ -1,7 +1,6 @@
 package service
 
 import (
-	"github.com/phimaker/waanx-fix-simpler/internal/logger"
 	"github.com/quickfixgo/fix44/heartbeat"
 	"github.com/quickfixgo/quickfix"
 )
@@ -23,6 +22,6 @@ func (s *heartbeatServiceImpl) RegisterRouters(route func(beginString string, ms
 }
 
 func (s *heartbeatServiceImpl) OnHeartbeat(msg heartbeat.Heartbeat, sessionID quickfix.SessionID) quickfix.MessageRejectError {
-	logger.Infof("Received heartbeat")
+	fixLog.Debugf("Received heartbeat")
 	return nil
 }
@@ -0,0 +1,9 @@
+package service
+
+import "github.com/phimaker/waanx-fix-simpler/internal/logger"
+
+var (
+	fixLog = logger.Named(logger.ComponentFix)
+	mdLog  = logger.Named(logger.ComponentMarketData)
+	ordLog = logger.Named(logger.ComponentOrders)
+)
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/metrics"
+)
+
+const (
+	// recoveryTimeout is how long a snapshot request may stay unanswered
+	// before it is sent again.
+	recoveryTimeout = 5 * time.Second
+	// maxBuffered bounds the incrementals kept per symbol during a recovery.
+	// When it is exceeded the oldest are dropped and the replay finds a gap
+	// again, which requests another snapshot.
+	maxBuffered = 10000
+)
+
+var (
+	mdGapCounter       = metrics.NewCounterVec("md_gaps")
+	mdDuplicateCounter = metrics.NewCounterVec("md_duplicates")
+	mdRecoveryCounter  = metrics.NewCounterVec("md_recoveries")
+	mdOutdatedCounter  = metrics.NewCounterVec("md_outdated_snapshots")
+)
+
+// mdEntry is an entry of an incremental refresh, either a book update or a
+// trade.
+type mdEntry struct {
+	seq    int
+	update *domain.BookUpdate
+	trade  *domain.Trade
+}
+
+func (e mdEntry) symbol() string {
+	if e.update != nil {
+		return e.update.Symbol
+	}
+	return e.trade.Symbol
+}
+
+// mdEntries merges updates and trades back into RptSeq order. Only the
+// sequenced entries of each symbol are sorted, within the slots they take:
+// the entries without RptSeq keep their place, updates before trades as the
+// mapper returns them, and sequence.next applies them as they come.
+func mdEntries(updates []domain.BookUpdate, trades []domain.Trade) []mdEntry {
+	entries := make([]mdEntry, 0, len(updates)+len(trades))
+	for i := range updates {
+		entries = append(entries, mdEntry{seq: updates[i].Seq, update: &updates[i]})
+	}
+	for i := range trades {
+		entries = append(entries, mdEntry{seq: trades[i].Seq, trade: &trades[i]})
+	}
+
+	slots := map[string][]int{} // indexes of the sequenced entries by symbol
+	for i, e := range entries {
+		if e.seq != 0 {
+			slots[e.symbol()] = append(slots[e.symbol()], i)
+		}
+	}
+	for _, idx := range slots {
+		sorted := make([]mdEntry, len(idx))
+		for i, j := range idx {
+			sorted[i] = entries[j]
+		}
+		sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].seq < sorted[j].seq })
+		for i, j := range idx {
+			entries[j] = sorted[i]
+		}
+	}
+	return entries
+}
+
+type seqResult int
+
+const (
+	seqApply seqResult = iota
+	seqDuplicate
+	seqGap
+	seqBuffered
+)
+
+// sequence tracks the RptSeq of the incrementals of one symbol. Entries
+// without RptSeq are always applied, and the first sequenced entry after a
+// subscription is taken as is.
+type sequence struct {
+	last      int
+	stale     bool
+	failed    bool // the venue rejected the snapshot request, not sent again
+	requested time.Time
+	buffered  []mdEntry
+}
+
+// next classifies e and advances the sequence when it is applied. A gap
+// makes the sequence stale: e and the entries following it are buffered
+// until recover is called with a snapshot.
+func (s *sequence) next(e mdEntry) seqResult {
+	switch {
+	case s.stale:
+		s.buffer(e)
+		return seqBuffered
+	case e.seq == 0:
+		return seqApply
+	case s.last == 0 || e.seq == s.last+1:
+		s.last = e.seq
+		return seqApply
+	case e.seq <= s.last:
+		return seqDuplicate
+	}
+	s.stale = true
+	s.requested = time.Time{}
+	s.buffer(e)
+	return seqGap
+}
+
+func (s *sequence) buffer(e mdEntry) {
+	if len(s.buffered) >= maxBuffered {
+		s.buffered = append(s.buffered[:0], s.buffered[1:]...)
+	}
+	s.buffered = append(s.buffered, e)
+}
+
+// outdated reports whether a snapshot at seq is older than the entries
+// already applied. It is only checked when no recovery is pending, a
+// recovery taking whichever snapshot comes.
+func (s *sequence) outdated(seq int) bool {
+	return !s.stale && seq != 0 && seq < s.last
+}
+
+// recover restarts the sequence at the RptSeq of a snapshot and returns the
+// buffered entries following it. Without a snapshot sequence there is no
+// telling which entries it includes, so the buffer is dropped.
+func (s *sequence) recover(seq int) []mdEntry {
+	buffered := s.buffered
+	s.last, s.stale, s.failed, s.buffered = seq, false, false, nil
+	if seq == 0 {
+		return nil
+	}
+	var replay []mdEntry
+	for _, e := range buffered {
+		if e.seq > seq {
+			replay = append(replay, e)
+		}
+	}
+	return replay
+}
+
+// due reports whether a snapshot must be requested, which is right after a
+// gap and again when the last request timed out, unless the venue rejected
+// it.
+func (s *sequence) due(now time.Time) bool {
+	if !s.stale || s.failed || now.Sub(s.requested) < recoveryTimeout {
+		return false
+	}
+	s.requested = now
+	return true
+}
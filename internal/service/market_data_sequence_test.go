@@ -0,0 +1,178 @@
+package service
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+)
+
+func seqs(entries []mdEntry) []int {
+	var out []int
+	for _, e := range entries {
+		out = append(out, e.seq)
+	}
+	return out
+}
+
+func TestSequenceNext(t *testing.T) {
+	tests := []struct {
+		name     string
+		seq      sequence
+		entries  []int
+		want     []seqResult
+		last     int
+		stale    bool
+		buffered []int
+	}{
+		{
+			name:    "first entry taken as is",
+			entries: []int{7, 8},
+			want:    []seqResult{seqApply, seqApply},
+			last:    8,
+		},
+		{
+			name:    "unsequenced entries always applied",
+			seq:     sequence{last: 3},
+			entries: []int{0, 4, 0},
+			want:    []seqResult{seqApply, seqApply, seqApply},
+			last:    4,
+		},
+		{
+			name:    "duplicates",
+			seq:     sequence{last: 5},
+			entries: []int{4, 5, 6},
+			want:    []seqResult{seqDuplicate, seqDuplicate, seqApply},
+			last:    6,
+		},
+		{
+			name:     "gap buffers the following entries",
+			seq:      sequence{last: 5},
+			entries:  []int{7, 8, 0, 6},
+			want:     []seqResult{seqGap, seqBuffered, seqBuffered, seqBuffered},
+			last:     5,
+			stale:    true,
+			buffered: []int{7, 8, 0, 6},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := tt.seq
+			var got []seqResult
+			for _, seq := range tt.entries {
+				got = append(got, s.next(mdEntry{seq: seq}))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("next() = %v, want %v", got, tt.want)
+			}
+			if s.last != tt.last || s.stale != tt.stale {
+				t.Errorf("last, stale = %d, %t, want %d, %t", s.last, s.stale, tt.last, tt.stale)
+			}
+			if got := seqs(s.buffered); !reflect.DeepEqual(got, tt.buffered) {
+				t.Errorf("buffered = %v, want %v", got, tt.buffered)
+			}
+		})
+	}
+}
+
+func TestSequenceRecover(t *testing.T) {
+	tests := []struct {
+		name     string
+		buffered []int
+		snapshot int
+		want     []int
+	}{
+		{name: "replays the entries after the snapshot", buffered: []int{7, 8, 9, 10}, snapshot: 8, want: []int{9, 10}},
+		{name: "snapshot past the buffer", buffered: []int{7, 8}, snapshot: 12, want: nil},
+		{name: "unsequenced snapshot drops the buffer", buffered: []int{7, 8}, snapshot: 0, want: nil},
+		{name: "nothing buffered", snapshot: 3, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := sequence{last: 5, stale: true, failed: true}
+			for _, seq := range tt.buffered {
+				s.buffer(mdEntry{seq: seq})
+			}
+			replay := s.recover(tt.snapshot)
+			if got := seqs(replay); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("recover(%d) = %v, want %v", tt.snapshot, got, tt.want)
+			}
+			if s.last != tt.snapshot || s.stale || s.failed || s.buffered != nil {
+				t.Errorf("after recover(%d): %+v", tt.snapshot, s)
+			}
+			if res := s.next(mdEntry{seq: tt.snapshot + 1}); tt.snapshot != 0 && res != seqApply {
+				t.Errorf("next(%d) after recover = %v, want seqApply", tt.snapshot+1, res)
+			}
+		})
+	}
+}
+
+func TestSequenceDue(t *testing.T) {
+	now := time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC)
+	s := sequence{}
+	if s.due(now) {
+		t.Fatal("due without a gap")
+	}
+	s.next(mdEntry{seq: 1})
+	s.next(mdEntry{seq: 3})
+	if !s.due(now) {
+		t.Fatal("not due after a gap")
+	}
+	if s.due(now.Add(recoveryTimeout - time.Millisecond)) {
+		t.Fatal("due again before the recovery timeout")
+	}
+	if !s.due(now.Add(recoveryTimeout)) {
+		t.Fatal("not due again after the recovery timeout")
+	}
+	s.failed = true
+	if s.due(now.Add(2 * recoveryTimeout)) {
+		t.Fatal("due after the snapshot request was rejected")
+	}
+}
+
+func TestMDEntries(t *testing.T) {
+	update := func(symbol string, seq int) domain.BookUpdate {
+		return domain.BookUpdate{Symbol: symbol, Seq: seq}
+	}
+	trade := func(symbol string, seq int) domain.Trade {
+		return domain.Trade{Symbol: symbol, Seq: seq}
+	}
+	tests := []struct {
+		name    string
+		updates []domain.BookUpdate
+		trades  []domain.Trade
+		want    []string
+	}{
+		{
+			name:    "trades merged by RptSeq",
+			updates: []domain.BookUpdate{update("A", 1), update("A", 3)},
+			trades:  []domain.Trade{trade("A", 2)},
+			want:    []string{"A/1", "A/2", "A/3"},
+		},
+		{
+			name:    "unsequenced entries keep their place",
+			updates: []domain.BookUpdate{update("A", 0), update("A", 5), update("A", 0)},
+			trades:  []domain.Trade{trade("A", 4)},
+			want:    []string{"A/0", "A/4", "A/0", "A/5"},
+		},
+		{
+			name:    "symbols sorted apart",
+			updates: []domain.BookUpdate{update("B", 9), update("A", 2)},
+			trades:  []domain.Trade{trade("B", 8), trade("A", 1)},
+			want:    []string{"B/8", "A/1", "B/9", "A/2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, e := range mdEntries(tt.updates, tt.trades) {
+				got = append(got, e.symbol()+"/"+strconv.Itoa(e.seq))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mdEntries() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,568 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/analytics"
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/dq"
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+	"github.com/phimaker/waanx-fix-simpler/internal/fix/waanx/fix44/marketdataincrementalrefresh"
+	"github.com/phimaker/waanx-fix-simpler/internal/fix/waanx/fix44/marketdatasnapshotfullrefresh"
+	"github.com/phimaker/waanx-fix-simpler/internal/idgen"
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/fix/mapper"
+	"github.com/phimaker/waanx-fix-simpler/internal/orderbook"
+	"github.com/phimaker/waanx-fix-simpler/internal/tape"
+	"github.com/phimaker/waanx-fix-simpler/internal/throttle"
+	"github.com/quickfixgo/enum"
+	"github.com/quickfixgo/field"
+	"github.com/quickfixgo/fix44/marketdatarequest"
+	"github.com/quickfixgo/fix44/marketdatarequestreject"
+	"github.com/quickfixgo/quickfix"
+)
+
+// MarketDataService subscribes to the book and trades of instruments, keeps
+// their order books and publishes event.Trade, event.Quote and event.Book,
+// along with event.Analytics when the metrics derived from a book change and
+// the event.QualityAlert of the data quality checks. Trades are added to the
+// tape first and only published once.
+//
+// Incrementals are sequenced per symbol by RptSeq. Duplicates are dropped and
+// a gap marks the symbol stale: its incrementals are buffered and a snapshot
+// is requested, then the buffered incrementals newer than the snapshot are
+// replayed on top of it. Both are published as event.MarketDataStatus.
+//
+// A subscription or snapshot request rejected by the venue, with a Reject,
+// a BusinessMessageReject or a MarketDataRequestReject, is dropped: its
+// symbols get a FAILED event.MarketDataStatus and a stale one is no longer
+// recovered until subscribed again.
+type MarketDataService interface {
+	RouterService
+
+	MarketDataRequest(ctx context.Context, sessionID quickfix.SessionID, symbols []string, depth int) (string, error)
+	// Wait blocks until the venue answered the subscription reqID returned
+	// by MarketDataRequest with its first refresh, and returns the
+	// *domain.Reject if it rejected it.
+	Wait(ctx context.Context, reqID string) error
+	// Unsubscribe ends the subscriptions of symbols and drops their books.
+	Unsubscribe(ctx context.Context, sessionID quickfix.SessionID, symbols []string) error
+
+	// Book returns the best depth levels of symbol, all of them when depth is
+	// zero.
+	Book(symbol string, depth int) (domain.Book, bool)
+
+	// Stale reports whether the book of symbol is being recovered after a
+	// sequence gap.
+	Stale(symbol string) bool
+
+	// OnReject is the RejectHandler dropping the requests the venue
+	// rejected.
+	OnReject(r *domain.Reject, sessionID quickfix.SessionID)
+}
+
+// mdRequest is a MarketDataRequest sent, until the venue rejects it or, for
+// a snapshot, answers it.
+type mdRequest struct {
+	symbols  []string
+	snapshot bool
+}
+
+type marketDataServiceImpl struct {
+	mu        sync.Mutex
+	books     map[string]*orderbook.Book
+	seqs      map[string]*sequence
+	requests  map[string]mdRequest // by MDReqID
+	results   *pendingRequests     // of the subscriptions, by MDReqID
+	sessionID quickfix.SessionID
+	depth     int
+	tape      *tape.Tape
+	analytics *analytics.Analyzer
+	quality   *dq.Monitor
+	throttle  *throttle.Throttler
+	ids       *idgen.Generator
+	bus       *event.Bus
+}
+
+func NewMarketDataService(bus *event.Bus, tp *tape.Tape, an *analytics.Analyzer, quality *dq.Monitor, throttler *throttle.Throttler, ids *idgen.Generator) MarketDataService {
+	return &marketDataServiceImpl{
+		books:     make(map[string]*orderbook.Book),
+		seqs:      make(map[string]*sequence),
+		requests:  make(map[string]mdRequest),
+		results:   newPendingRequests(),
+		tape:      tp,
+		analytics: an,
+		quality:   quality,
+		throttle:  throttler,
+		ids:       ids,
+		bus:       bus,
+	}
+}
+
+// Snapshot implements Snapshotter.
+func (srv *marketDataServiceImpl) Snapshot() any {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	quotes := make([]domain.Quote, 0, len(srv.books))
+	for _, b := range srv.books {
+		quotes = append(quotes, b.Quote())
+	}
+	sort.Slice(quotes, func(i, j int) bool { return quotes[i].Symbol < quotes[j].Symbol })
+	return quotes
+}
+
+func (srv *marketDataServiceImpl) RegisterRouters(route func(beginString string, msgType string, router quickfix.MessageRoute)) {
+	route(marketdatasnapshotfullrefresh.Route(srv.OnSnapshot))
+	route(marketdataincrementalrefresh.Route(srv.OnIncrementalRefresh))
+	route(marketdatarequestreject.Route(srv.OnMarketDataRequestReject))
+}
+
+func (srv *marketDataServiceImpl) OnSnapshot(msg marketdatasnapshotfullrefresh.MarketDataSnapshotFullRefresh, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+	snapshot, trades, err := mapper.Book(msg)
+	if err != nil {
+		mdLog.Errorf("Error reading snapshot of %s: %v", snapshot.Symbol, err)
+		return err
+	}
+
+	reqID, _ := msg.GetMDReqID()
+	srv.results.resolve(reqID, nil)
+
+	changes := bookChanges{}
+	srv.mu.Lock()
+	if srv.requests[reqID].snapshot {
+		delete(srv.requests, reqID)
+	}
+	seq := srv.sequence(snapshot.Symbol)
+	if seq.outdated(snapshot.Seq) {
+		last := seq.last
+		srv.mu.Unlock()
+		mdOutdatedCounter.Inc(snapshot.Symbol)
+		mdLog.Warnf("Ignoring snapshot of %s at RptSeq %d, book already at %d", snapshot.Symbol, snapshot.Seq, last)
+		return nil
+	}
+	b := srv.book(snapshot.Symbol)
+	changes.track(b)
+	b.Reset(snapshot)
+	trades = srv.record(trades)
+
+	stale := seq.stale
+	replay := seq.recover(snapshot.Seq)
+	_, statuses := srv.apply(replay, true, changes)
+	if stale && !seq.stale {
+		mdRecoveryCounter.Inc(snapshot.Symbol)
+		mdLog.Infof("[RECOVERED] %s from snapshot at RptSeq %d, replayed %d incrementals", snapshot.Symbol, snapshot.Seq, len(replay))
+		statuses = append(statuses, event.MarketDataStatus{
+			Symbol:   snapshot.Symbol,
+			Status:   event.MarketDataRecovered,
+			Replayed: len(replay),
+			Time:     time.Now().UTC(),
+		})
+	}
+	quotes := changes.quotes(srv.books)
+	books := changes.books(srv.books, srv.depth)
+	due := srv.dueRecoveries()
+	srv.mu.Unlock()
+
+	srv.publishStatuses(statuses)
+	srv.publishQuotes(quotes)
+	srv.publishBooks(books)
+	srv.publishTrades(trades)
+	srv.requestSnapshots(sessionID, due)
+	return nil
+}
+
+func (srv *marketDataServiceImpl) OnIncrementalRefresh(msg marketdataincrementalrefresh.MarketDataIncrementalRefresh, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+	updates, trades, err := mapper.BookUpdates(msg)
+	if err != nil {
+		mdLog.Errorf("Error reading incremental refresh: %v", err)
+		return err
+	}
+	if reqID, err := msg.GetMDReqID(); err == nil {
+		srv.results.resolve(reqID, nil)
+	}
+	for _, u := range updates {
+		srv.quality.Publish(srv.quality.CheckUpdate(u))
+	}
+
+	changes := bookChanges{}
+	srv.mu.Lock()
+	trades, statuses := srv.apply(mdEntries(updates, trades), false, changes)
+	trades = srv.record(trades)
+	quotes := changes.quotes(srv.books)
+	books := changes.books(srv.books, srv.depth)
+	due := srv.dueRecoveries()
+	srv.mu.Unlock()
+
+	srv.publishStatuses(statuses)
+	srv.publishQuotes(quotes)
+	srv.publishBooks(books)
+	srv.publishTrades(trades)
+	srv.requestSnapshots(sessionID, due)
+	return nil
+}
+
+func (srv *marketDataServiceImpl) OnMarketDataRequestReject(msg marketdatarequestreject.MarketDataRequestReject, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+	r := mapper.MarketDataReject(msg)
+	mdLog.Error(r.Error())
+	srv.fail(r.RefID, &r)
+	return nil
+}
+
+func (srv *marketDataServiceImpl) OnReject(r *domain.Reject, sessionID quickfix.SessionID) {
+	if r.RefMsgType != string(enum.MsgType_MARKET_DATA_REQUEST) {
+		return
+	}
+	srv.fail(r.RefID, r)
+}
+
+// fail drops the request reqID the venue rejected with r. Its symbols are no
+// longer sequenced, or, for a snapshot request, no longer recovered, so the
+// request is not sent again; subscribing again starts them over.
+func (srv *marketDataServiceImpl) fail(reqID string, r *domain.Reject) {
+	srv.results.resolve(reqID, r)
+	srv.mu.Lock()
+	req, ok := srv.requests[reqID]
+	delete(srv.requests, reqID)
+	for _, symbol := range req.symbols {
+		if !req.snapshot {
+			delete(srv.seqs, symbol)
+		} else if seq, ok := srv.seqs[symbol]; ok {
+			seq.failed = true
+		}
+	}
+	srv.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	now := time.Now().UTC()
+	statuses := make([]event.MarketDataStatus, 0, len(req.symbols))
+	for _, symbol := range req.symbols {
+		mdLog.Errorf("[FAILED] %s dropped: %v", symbol, r)
+		statuses = append(statuses, event.MarketDataStatus{
+			Symbol: symbol,
+			Status: event.MarketDataFailed,
+			Text:   r.Error(),
+			Time:   now,
+		})
+	}
+	srv.publishStatuses(statuses)
+}
+
+func (srv *marketDataServiceImpl) Book(symbol string, depth int) (domain.Book, bool) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	b, ok := srv.books[symbol]
+	if !ok {
+		return domain.Book{}, false
+	}
+	return b.Snapshot(depth), true
+}
+
+func (srv *marketDataServiceImpl) Stale(symbol string) bool {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	seq, ok := srv.seqs[symbol]
+	return ok && seq.stale
+}
+
+// MarketDataRequest subscribes to symbols. Their sequences start over, as
+// the venue sends a snapshot first.
+func (srv *marketDataServiceImpl) MarketDataRequest(ctx context.Context, sessionID quickfix.SessionID, symbols []string, depth int) (string, error) {
+	srv.mu.Lock()
+	srv.depth = depth
+	for _, symbol := range symbols {
+		delete(srv.seqs, symbol)
+	}
+	srv.mu.Unlock()
+	return srv.marketDataRequest(ctx, sessionID, symbols, depth, enum.SubscriptionRequestType_SNAPSHOT_PLUS_UPDATES)
+}
+
+func (srv *marketDataServiceImpl) Wait(ctx context.Context, reqID string) error {
+	return srv.results.wait(ctx, reqID)
+}
+
+func (srv *marketDataServiceImpl) marketDataRequest(ctx context.Context, sessionID quickfix.SessionID, symbols []string, depth int, typ enum.SubscriptionRequestType) (string, error) {
+	reqID, err := srv.ids.Next(idgen.KindMarketData)
+	if err != nil {
+		return "", err
+	}
+	req := newMarketDataRequest(reqID, symbols, depth, typ)
+
+	snapshot := typ == enum.SubscriptionRequestType_SNAPSHOT
+	if !snapshot {
+		srv.results.add(reqID)
+	}
+	srv.mu.Lock()
+	srv.requests[reqID] = mdRequest{symbols: symbols, snapshot: snapshot}
+	srv.mu.Unlock()
+	if err := srv.throttle.Send(ctx, req, sessionID); err != nil {
+		srv.results.drop(reqID)
+		srv.mu.Lock()
+		delete(srv.requests, reqID)
+		srv.mu.Unlock()
+		return "", fmt.Errorf("error sending market data request: %w", err)
+	}
+	return reqID, nil
+}
+
+// Unsubscribe sends, for each subscription of symbols, a request disabling
+// it with the MDReqID it was made with.
+func (srv *marketDataServiceImpl) Unsubscribe(ctx context.Context, sessionID quickfix.SessionID, symbols []string) error {
+	srv.mu.Lock()
+	removed := make(map[string][]string) // by MDReqID
+	for reqID, req := range srv.requests {
+		if req.snapshot {
+			continue
+		}
+		var kept []string
+		for _, symbol := range req.symbols {
+			if slices.Contains(symbols, symbol) {
+				removed[reqID] = append(removed[reqID], symbol)
+			} else {
+				kept = append(kept, symbol)
+			}
+		}
+		if len(kept) == 0 {
+			delete(srv.requests, reqID)
+			srv.results.drop(reqID)
+		} else {
+			req.symbols = kept
+			srv.requests[reqID] = req
+		}
+	}
+	for _, symbol := range symbols {
+		delete(srv.seqs, symbol)
+		delete(srv.books, symbol)
+	}
+	depth := srv.depth
+	srv.mu.Unlock()
+
+	var errs []error
+	for reqID, symbols := range removed {
+		req := newMarketDataRequest(reqID, symbols, depth, enum.SubscriptionRequestType_DISABLE_PREVIOUS_SNAPSHOT_PLUS_UPDATE_REQUEST)
+		if err := srv.throttle.Send(ctx, req, sessionID); err != nil {
+			errs = append(errs, fmt.Errorf("error sending market data unsubscribe request: %w", err))
+			continue
+		}
+		mdLog.Infof("Unsubscribed %v of %s", symbols, reqID)
+	}
+	return errors.Join(errs...)
+}
+
+func newMarketDataRequest(reqID string, symbols []string, depth int, typ enum.SubscriptionRequestType) marketdatarequest.MarketDataRequest {
+	req := marketdatarequest.New(
+		field.NewMDReqID(reqID),
+		field.NewSubscriptionRequestType(typ),
+		field.NewMarketDepth(depth),
+	)
+	if typ == enum.SubscriptionRequestType_SNAPSHOT_PLUS_UPDATES {
+		req.SetMDUpdateType(enum.MDUpdateType_INCREMENTAL_REFRESH)
+	}
+
+	entryTypes := marketdatarequest.NewNoMDEntryTypesRepeatingGroup()
+	for _, t := range []enum.MDEntryType{enum.MDEntryType_BID, enum.MDEntryType_OFFER, enum.MDEntryType_TRADE} {
+		entryTypes.Add().SetMDEntryType(t)
+	}
+	req.SetNoMDEntryTypes(entryTypes)
+
+	related := marketdatarequest.NewNoRelatedSymRepeatingGroup()
+	for _, symbol := range symbols {
+		related.Add().SetSymbol(symbol)
+	}
+	req.SetNoRelatedSym(related)
+	return req
+}
+
+// requestSnapshots requests a snapshot of each stale symbol to recover its
+// book. The requests are sent apart from the session delivering the
+// incrementals, as they may wait for the throttle.
+func (srv *marketDataServiceImpl) requestSnapshots(sessionID quickfix.SessionID, symbols []string) {
+	if len(symbols) == 0 {
+		return
+	}
+	srv.mu.Lock()
+	depth := srv.depth
+	srv.mu.Unlock()
+	go func() {
+		for _, symbol := range symbols {
+			mdLog.Warnf("[RECOVERY] Requesting snapshot of stale %s", symbol)
+			if _, err := srv.marketDataRequest(context.Background(), sessionID, []string{symbol}, depth, enum.SubscriptionRequestType_SNAPSHOT); err != nil {
+				mdLog.Errorf("Error requesting snapshot of %s: %v", symbol, err)
+			}
+		}
+	}()
+}
+
+// apply runs entries through the sequence of their symbol, applying the book
+// updates in sequence. It returns the trades to record, which are not held
+// back while stale, and the statuses of the gaps found. When replaying, the
+// trades were recorded already. srv.mu must be held.
+func (srv *marketDataServiceImpl) apply(entries []mdEntry, replay bool, changes bookChanges) ([]domain.Trade, []event.MarketDataStatus) {
+	var (
+		trades   []domain.Trade
+		statuses []event.MarketDataStatus
+	)
+	for _, e := range entries {
+		symbol := e.symbol()
+		seq := srv.sequence(symbol)
+		expected := seq.last + 1
+		switch seq.next(e) {
+		case seqDuplicate:
+			mdDuplicateCounter.Inc(symbol)
+			mdLog.Debugf("Duplicate incremental of %s at RptSeq %d, last %d", symbol, e.seq, seq.last)
+			continue
+		case seqGap:
+			mdGapCounter.Inc(symbol)
+			mdLog.Warnf("[GAP] %s expected RptSeq %d, received %d", symbol, expected, e.seq)
+			statuses = append(statuses, event.MarketDataStatus{
+				Symbol:   symbol,
+				Status:   event.MarketDataStale,
+				Expected: expected,
+				Received: e.seq,
+				Time:     time.Now().UTC(),
+			})
+		case seqApply:
+			if e.update != nil {
+				b := srv.book(symbol)
+				changes.track(b)
+				b.Apply(*e.update)
+			}
+		}
+		if e.trade != nil && !replay {
+			trades = append(trades, *e.trade)
+		}
+	}
+	return trades, statuses
+}
+
+// dueRecoveries returns the stale symbols whose snapshot must be requested.
+// srv.mu must be held.
+func (srv *marketDataServiceImpl) dueRecoveries() []string {
+	now := time.Now()
+	var due []string
+	for symbol, seq := range srv.seqs {
+		if seq.due(now) {
+			due = append(due, symbol)
+		}
+	}
+	sort.Strings(due)
+	return due
+}
+
+// sequence returns the sequence of symbol, creating it. srv.mu must be held.
+func (srv *marketDataServiceImpl) sequence(symbol string) *sequence {
+	seq, ok := srv.seqs[symbol]
+	if !ok {
+		seq = &sequence{}
+		srv.seqs[symbol] = seq
+	}
+	return seq
+}
+
+// book returns the book of symbol, creating it. srv.mu must be held.
+func (srv *marketDataServiceImpl) book(symbol string) *orderbook.Book {
+	b, ok := srv.books[symbol]
+	if !ok {
+		b = orderbook.New(symbol)
+		srv.books[symbol] = b
+	}
+	return b
+}
+
+// bookChanges holds the top of the books changed by a message as it was
+// before the message.
+type bookChanges map[string]domain.Quote
+
+func (c bookChanges) track(b *orderbook.Book) {
+	if _, ok := c[b.Symbol()]; !ok {
+		c[b.Symbol()] = b.Quote()
+	}
+}
+
+// quotes returns the tops of book that changed. srv.mu must be held.
+func (c bookChanges) quotes(books map[string]*orderbook.Book) []domain.Quote {
+	var quotes []domain.Quote
+	for symbol, before := range c {
+		after := books[symbol].Quote()
+		if before.BidPx.Equal(after.BidPx) && before.BidSize.Equal(after.BidSize) &&
+			before.AskPx.Equal(after.AskPx) && before.AskSize.Equal(after.AskSize) {
+			continue
+		}
+		quotes = append(quotes, after)
+	}
+	return quotes
+}
+
+// books returns the subscribed levels of the changed books. srv.mu must be
+// held.
+func (c bookChanges) books(books map[string]*orderbook.Book, depth int) []domain.Book {
+	out := make([]domain.Book, 0, len(c))
+	for symbol := range c {
+		out = append(out, books[symbol].Snapshot(depth))
+	}
+	return out
+}
+
+func (srv *marketDataServiceImpl) publishBooks(books []domain.Book) {
+	for _, b := range books {
+		srv.bus.Publish(event.Book{Book: b})
+		if an, changed := srv.analytics.Update(b); changed {
+			srv.bus.Publish(event.Analytics{Analytics: an})
+		}
+		srv.quality.Publish(srv.quality.CheckBook(b))
+	}
+}
+
+func (srv *marketDataServiceImpl) publishQuotes(quotes []domain.Quote) {
+	for _, q := range quotes {
+		srv.bus.Publish(event.Quote{Quote: q})
+	}
+}
+
+func (srv *marketDataServiceImpl) publishStatuses(statuses []event.MarketDataStatus) {
+	for _, st := range statuses {
+		srv.bus.Publish(st)
+	}
+}
+
+// record sets the aggressor side of trades from the book and adds them to the
+// tape, returning the ones not seen before. srv.mu must be held.
+func (srv *marketDataServiceImpl) record(trades []domain.Trade) []domain.Trade {
+	var out []domain.Trade
+	for _, t := range trades {
+		if t.Side == "" {
+			t.Side = aggressor(t, srv.book(t.Symbol).Quote())
+		}
+		if srv.tape.Add(t) {
+			out = append(out, t)
+		} else {
+			mdLog.Debugf("Duplicate trade %s %s at %s", t.Symbol, t.TradeID, t.Time)
+		}
+	}
+	return out
+}
+
+// aggressor infers the side that took liquidity: a trade at or through the
+// offer was a buy, at or through the bid a sell.
+func aggressor(t domain.Trade, q domain.Quote) domain.Side {
+	switch {
+	case q.AskPx.IsPositive() && t.Price.GreaterThanOrEqual(q.AskPx):
+		return domain.SideBuy
+	case q.BidPx.IsPositive() && t.Price.LessThanOrEqual(q.BidPx):
+		return domain.SideSell
+	}
+	return ""
+}
+
+func (srv *marketDataServiceImpl) publishTrades(trades []domain.Trade) {
+	for _, t := range trades {
+		srv.bus.Publish(event.Trade{Trade: t})
+		srv.quality.Publish(srv.quality.CheckTrade(t))
+	}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/api"
+	"github.com/phimaker/waanx-fix-simpler/internal/risk"
+	"github.com/phimaker/waanx-fix-simpler/internal/throttle"
+	"github.com/quickfixgo/quickfix"
+)
+
+// OrderHandler serves the orders sent on the session of fix.
+//
+//	GET ?clOrdId=ORD-1                     an order
+//	GET ?open=true                         the open orders, all by default
+//	POST {"symbol":"BTC-USDT",...}         sends an order, 422 with the
+//	                                       rejected order if it fails the
+//	                                       risk checks, 429 if throttled
+//	DELETE ?clOrdId=ORD-1                  requests the cancel of an order
+func OrderHandler(orders OrderService, fix FixService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch r.Method {
+		case http.MethodGet:
+			if clOrdID := q.Get("clOrdId"); clOrdID != "" {
+				o, ok := orders.Order(clOrdID)
+				if !ok {
+					api.WriteError(w, http.StatusNotFound, fmt.Errorf("unknown order %s", clOrdID))
+					return
+				}
+				api.WriteJSON(w, http.StatusOK, o)
+				return
+			}
+			api.WriteJSON(w, http.StatusOK, orders.Orders(q.Get("open") == "true"))
+
+		case http.MethodPost:
+			var o domain.Order
+			if err := json.NewDecoder(r.Body).Decode(&o); err != nil {
+				api.WriteError(w, http.StatusBadRequest, fmt.Errorf("invalid order: %w", err))
+				return
+			}
+			sessionID, ok := fix.SessionID()
+			if !ok {
+				api.WriteError(w, http.StatusServiceUnavailable, errors.New("not logged on"))
+				return
+			}
+			o, err := orders.NewOrder(r.Context(), sessionID, o)
+			if err != nil {
+				var rejection *risk.Rejection
+				if errors.As(err, &rejection) {
+					api.WriteJSON(w, http.StatusUnprocessableEntity, o)
+					return
+				}
+				var throttled *throttle.Rejection
+				if errors.As(err, &throttled) {
+					api.WriteJSON(w, http.StatusTooManyRequests, o)
+					return
+				}
+				api.WriteError(w, http.StatusBadGateway, err)
+				return
+			}
+			api.WriteJSON(w, http.StatusCreated, o)
+
+		case http.MethodDelete:
+			clOrdID := q.Get("clOrdId")
+			if clOrdID == "" {
+				api.WriteError(w, http.StatusBadRequest, errors.New("missing clOrdId"))
+				return
+			}
+			sessionID, ok := fix.SessionID()
+			if !ok {
+				api.WriteError(w, http.StatusServiceUnavailable, errors.New("not logged on"))
+				return
+			}
+			cancelID, err := orders.CancelOrder(r.Context(), sessionID, clOrdID)
+			if err != nil {
+				api.WriteError(w, http.StatusConflict, err)
+				return
+			}
+			api.WriteJSON(w, http.StatusAccepted, map[string]string{"clOrdId": cancelID, "origClOrdId": clOrdID})
+
+		default:
+			w.Header().Set("Allow", "GET, POST, DELETE")
+			api.WriteError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		}
+	})
+}
+
+// CancelAllHandler cancels the open orders in a scope, every open order
+// without one.
+//
+//	POST {"account":"A1","symbol":"BTC-USDT","side":"BUY"}
+func CancelAllHandler(orders OrderService, fix FixService) http.Handler {
+	return massHandler(fix, func(r *http.Request, sessionID quickfix.SessionID, scope domain.OrderScope) (any, error) {
+		ids, err := orders.CancelAll(r.Context(), sessionID, scope)
+		return map[string]any{"clOrdIds": ids}, err
+	})
+}
+
+// MassStatusHandler requests the status of the orders in a scope from the
+// venue, closing the open ones it does not know.
+//
+//	POST {"account":"A1","symbol":"BTC-USDT","side":"BUY"}
+func MassStatusHandler(orders OrderService, fix FixService) http.Handler {
+	return massHandler(fix, func(r *http.Request, sessionID quickfix.SessionID, scope domain.OrderScope) (any, error) {
+		reqID, err := orders.MassStatus(r.Context(), sessionID, scope)
+		return map[string]string{"massStatusReqId": reqID}, err
+	})
+}
+
+func massHandler(fix FixService, fn func(r *http.Request, sessionID quickfix.SessionID, scope domain.OrderScope) (any, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", "POST")
+			api.WriteError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+		var scope domain.OrderScope
+		if r.ContentLength != 0 {
+			if err := json.NewDecoder(r.Body).Decode(&scope); err != nil {
+				api.WriteError(w, http.StatusBadRequest, fmt.Errorf("invalid scope: %w", err))
+				return
+			}
+		}
+		sessionID, ok := fix.SessionID()
+		if !ok {
+			api.WriteError(w, http.StatusServiceUnavailable, errors.New("not logged on"))
+			return
+		}
+		resp, err := fn(r, sessionID, scope)
+		if err != nil {
+			api.WriteError(w, http.StatusBadGateway, err)
+			return
+		}
+		api.WriteJSON(w, http.StatusAccepted, resp)
+	})
+}
@@ -0,0 +1,737 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+	"github.com/phimaker/waanx-fix-simpler/internal/idgen"
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/fix/mapper"
+	"github.com/phimaker/waanx-fix-simpler/internal/metrics"
+	"github.com/phimaker/waanx-fix-simpler/internal/orderstore"
+	"github.com/phimaker/waanx-fix-simpler/internal/position"
+	"github.com/phimaker/waanx-fix-simpler/internal/risk"
+	"github.com/phimaker/waanx-fix-simpler/internal/throttle"
+	"github.com/quickfixgo/enum"
+	"github.com/quickfixgo/fix44/executionreport"
+	"github.com/quickfixgo/fix44/newordersingle"
+	"github.com/quickfixgo/fix44/ordercancelreject"
+	"github.com/quickfixgo/fix44/ordercancelrequest"
+	"github.com/quickfixgo/fix44/ordermasscancelreport"
+	"github.com/quickfixgo/quickfix"
+	"github.com/shopspring/decimal"
+)
+
+var storeErrorCounter = metrics.NewCounterVec("order_store_errors")
+
+// OrderService sends orders and keeps their state from the execution reports,
+// publishing event.Execution and event.Order. Fills are added to the
+// positions, published as event.Position.
+//
+// With a store, every state transition is recorded before it is published,
+// and a new order before it is sent, so Recover can rebuild the orders after
+// a restart.
+//
+// Every NewOrderSingle sent goes through the risk checks in the ToApp path,
+// see Filter. An order failing them never reaches the venue: it is rejected
+// with a synthetic execution report and NewOrder returns the *risk.Rejection.
+type OrderService interface {
+	RouterService
+
+	// NewOrder sends o, with a new ClOrdID when it has none, and returns it
+	// as sent, or rejected along with the error.
+	NewOrder(ctx context.Context, sessionID quickfix.SessionID, o domain.Order) (domain.Order, error)
+	// CancelOrder requests the cancel of an open order and returns the
+	// ClOrdID of the request.
+	CancelOrder(ctx context.Context, sessionID quickfix.SessionID, clOrdID string) (string, error)
+	// CancelAll cancels the open orders in scope and returns the ClOrdIDs of
+	// the requests sent: an OrderMassCancelRequest, or a cancel per order
+	// when the scope has an account, which FIX 4.4 cannot mass cancel by, or
+	// when the venue rejects the mass cancel.
+	CancelAll(ctx context.Context, sessionID quickfix.SessionID, scope domain.OrderScope) ([]string, error)
+	// MassStatus requests the status of the orders in scope. Once the venue
+	// reported them all, the open orders in scope sent before the request
+	// that it did not report are UNKNOWN and their status is requested.
+	MassStatus(ctx context.Context, sessionID quickfix.SessionID, scope domain.OrderScope) (string, error)
+	// AwaitClosed waits until no order in scope is open, or ctx is done.
+	AwaitClosed(ctx context.Context, scope domain.OrderScope) error
+
+	// Recover rebuilds the orders and the ClOrdID chain of their cancels from
+	// the store, before the session logs on. The open orders are UNKNOWN
+	// until the venue reports them. The fills of the trading day are added
+	// to the positions.
+	Recover(ctx context.Context) error
+
+	// OnReject is the RejectHandler failing the orders and requests the
+	// venue rejected: a rejected order is REJECTED, a rejected mass cancel
+	// falls back to a cancel per order.
+	OnReject(r *domain.Reject, sessionID quickfix.SessionID)
+
+	// OnLogout marks the open orders UNKNOWN: they may trade or be canceled
+	// unnoticed until the venue reports them again after logon.
+	OnLogout(sessionID quickfix.SessionID)
+
+	Order(clOrdID string) (domain.Order, bool)
+	// Orders returns the orders by creation time, only the open ones if open
+	// is set.
+	Orders(open bool) []domain.Order
+
+	// Filter is the fix.OutgoingFilter running the risk checks on every
+	// NewOrderSingle about to be sent.
+	Filter(msg *quickfix.Message, sessionID quickfix.SessionID) error
+	// OnSent is the fix.OutboundHandler of a replay: it records the
+	// NewOrderSingle and OrderCancelRequest of a recording as if the service
+	// had sent them, without sending or checking them, and ignores the other
+	// messages.
+	OnSent(msg *quickfix.Message, sessionID quickfix.SessionID) error
+}
+
+type massStatus struct {
+	scope    domain.OrderScope
+	sent     time.Time
+	reports  int
+	reported map[string]struct{} // ClOrdIDs
+}
+
+type orderServiceImpl struct {
+	mu           sync.RWMutex
+	orders       map[string]*domain.Order // by ClOrdID
+	cancels      map[string]string        // ClOrdID of cancel requests to the one of their order
+	massCancels  map[string]domain.OrderScope
+	massStatuses map[string]*massStatus // by MassStatusReqID
+	risk         *risk.Checker
+	positions    *position.Keeper
+	store        orderstore.Store // nil keeps the orders in memory only
+	throttle     *throttle.Throttler
+	ids          *idgen.Generator
+	bus          *event.Bus
+}
+
+func NewOrderService(checker *risk.Checker, positions *position.Keeper, store orderstore.Store, throttler *throttle.Throttler, ids *idgen.Generator, bus *event.Bus) OrderService {
+	return &orderServiceImpl{
+		orders:       make(map[string]*domain.Order),
+		cancels:      make(map[string]string),
+		massCancels:  make(map[string]domain.OrderScope),
+		massStatuses: make(map[string]*massStatus),
+		risk:         checker,
+		positions:    positions,
+		store:        store,
+		throttle:     throttler,
+		ids:          ids,
+		bus:          bus,
+	}
+}
+
+// save records a transition of o in the store. Errors are logged and counted
+// in order_store_errors, the transitions coming from the venue being applied
+// anyway: only the requests sent fail with them.
+func (srv *orderServiceImpl) save(o domain.Order, e *domain.Execution) error {
+	if srv.store == nil {
+		return nil
+	}
+	if err := srv.store.SaveOrder(o, e); err != nil {
+		storeErrorCounter.Inc(string(o.Status))
+		ordLog.Errorf("Error saving order %s as %s: %v", o.ClOrdID, o.Status, err)
+		return err
+	}
+	return nil
+}
+
+func (srv *orderServiceImpl) Recover(ctx context.Context) error {
+	if srv.store == nil {
+		return nil
+	}
+	snap, err := srv.store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("error loading orders: %w", err)
+	}
+	now := time.Now().UTC()
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	open := 0
+	for _, o := range snap.Orders {
+		if o.Open() && o.Status != domain.OrderStatusUnknown {
+			o.Status = domain.OrderStatusUnknown
+			o.Updated = now
+			if err := srv.save(o, nil); err != nil {
+				return fmt.Errorf("error recovering order %s: %w", o.ClOrdID, err)
+			}
+		}
+		if o.Open() {
+			open++
+		}
+		srv.orders[o.ClOrdID] = &o
+	}
+	for _, l := range snap.Links {
+		srv.cancels[l.ClOrdID] = l.OrigClOrdID
+	}
+	// The fills of the day are not in the start of day positions.
+	dayStart := srv.positions.DayStart()
+	fills := 0
+	for _, e := range snap.Executions {
+		if e.Time.Before(dayStart) {
+			continue
+		}
+		if _, ok := srv.positions.Apply(e); ok {
+			fills++
+		}
+	}
+	ordLog.Infof("Recovered %d orders, %d open, %d cancel requests and %d fills of the day", len(snap.Orders), open, len(snap.Links), fills)
+	return nil
+}
+
+// Snapshot implements Snapshotter.
+func (srv *orderServiceImpl) Snapshot() any {
+	return srv.Orders(false)
+}
+
+func (srv *orderServiceImpl) RegisterRouters(route func(beginString string, msgType string, router quickfix.MessageRoute)) {
+	route(executionreport.Route(srv.OnExecutionReport))
+	route(ordercancelreject.Route(srv.OnOrderCancelReject))
+	route(ordermasscancelreport.Route(srv.OnOrderMassCancelReport))
+}
+
+func (srv *orderServiceImpl) Filter(msg *quickfix.Message, sessionID quickfix.SessionID) error {
+	if !msg.IsMsgTypeOf(string(enum.MsgType_ORDER_SINGLE)) {
+		return nil
+	}
+	o, err := mapper.Order(newordersingle.FromMessage(msg))
+	if err != nil {
+		ordLog.Errorf("Error reading order to check: %v", err)
+		return err
+	}
+	return srv.risk.Check(o)
+}
+
+func (srv *orderServiceImpl) NewOrder(ctx context.Context, sessionID quickfix.SessionID, o domain.Order) (domain.Order, error) {
+	if o.ClOrdID == "" {
+		id, err := srv.ids.Next(idgen.KindOrder)
+		if err != nil {
+			return o, err
+		}
+		o.ClOrdID = id
+	}
+	now := time.Now().UTC()
+	o.OrderID, o.OrigClOrdID = "", ""
+	o.Status = domain.OrderStatusPendingNew
+	o.CumQty, o.LeavesQty, o.AvgPx = decimal.Zero, o.Qty, decimal.Zero
+	o.Created, o.Updated = now, now
+
+	srv.mu.Lock()
+	if _, ok := srv.orders[o.ClOrdID]; ok {
+		srv.mu.Unlock()
+		return o, fmt.Errorf("duplicate ClOrdID %s", o.ClOrdID)
+	}
+	if err := srv.save(o, nil); err != nil {
+		srv.mu.Unlock()
+		return o, fmt.Errorf("error saving order: %w", err)
+	}
+	srv.orders[o.ClOrdID] = &o
+	srv.mu.Unlock()
+
+	err := srv.throttle.Send(ctx, mapper.NewOrderSingle(o), sessionID)
+	if err != nil {
+		var rejection *risk.Rejection
+		if !errors.As(err, &rejection) {
+			err = fmt.Errorf("error sending order: %w", err)
+		}
+		return srv.reject(o.ClOrdID, err.Error()), err
+	}
+	ordLog.Infof("[NEW] %s %s %s %s @ %s", o.ClOrdID, o.Side, o.Qty, o.Symbol, o.Price)
+	srv.bus.Publish(event.Order{Order: o})
+	return o, nil
+}
+
+func (srv *orderServiceImpl) OnSent(msg *quickfix.Message, sessionID quickfix.SessionID) error {
+	msgType, reject := msg.MsgType()
+	if reject != nil {
+		return reject
+	}
+	switch enum.MsgType(msgType) {
+	case enum.MsgType_ORDER_SINGLE:
+		o, reject := mapper.Order(newordersingle.FromMessage(msg))
+		if reject != nil {
+			return reject
+		}
+		o.CumQty, o.LeavesQty, o.AvgPx = decimal.Zero, o.Qty, decimal.Zero
+		o.Updated = o.Created
+
+		srv.mu.Lock()
+		if _, ok := srv.orders[o.ClOrdID]; ok {
+			srv.mu.Unlock()
+			return fmt.Errorf("duplicate ClOrdID %s", o.ClOrdID)
+		}
+		if err := srv.save(o, nil); err != nil {
+			srv.mu.Unlock()
+			return fmt.Errorf("error saving order: %w", err)
+		}
+		srv.orders[o.ClOrdID] = &o
+		srv.mu.Unlock()
+
+		ordLog.Infof("[NEW] %s %s %s %s @ %s", o.ClOrdID, o.Side, o.Qty, o.Symbol, o.Price)
+		srv.bus.Publish(event.Order{Order: o})
+
+	case enum.MsgType_ORDER_CANCEL_REQUEST:
+		req := ordercancelrequest.FromMessage(msg)
+		cancelID, reject := req.GetClOrdID()
+		if reject != nil {
+			return reject
+		}
+		clOrdID, reject := req.GetOrigClOrdID()
+		if reject != nil {
+			return reject
+		}
+
+		srv.mu.Lock()
+		if _, ok := srv.orders[clOrdID]; !ok {
+			srv.mu.Unlock()
+			return fmt.Errorf("unknown order %s", clOrdID)
+		}
+		if srv.store != nil {
+			if err := srv.store.SaveLink(cancelID, clOrdID); err != nil {
+				srv.mu.Unlock()
+				return fmt.Errorf("error saving order cancel request: %w", err)
+			}
+		}
+		srv.cancels[cancelID] = clOrdID
+		srv.mu.Unlock()
+		ordLog.Infof("[CANCEL] %s requested with %s", clOrdID, cancelID)
+	}
+	return nil
+}
+
+// reject rejects an order that was not sent with a synthetic execution
+// report.
+func (srv *orderServiceImpl) reject(clOrdID, text string) domain.Order {
+	srv.mu.Lock()
+	order, e := srv.rejectLocked(clOrdID, text)
+	srv.mu.Unlock()
+	srv.publishReject(order, e)
+	return order
+}
+
+// rejectLocked applies the synthetic execution report rejecting the order
+// clOrdID, to be published with publishReject. srv.mu must be held.
+func (srv *orderServiceImpl) rejectLocked(clOrdID, text string) (domain.Order, domain.Execution) {
+	o := srv.orders[clOrdID]
+	e := domain.Execution{
+		ExecID:    "REJ-" + clOrdID,
+		ClOrdID:   clOrdID,
+		Account:   o.Account,
+		Symbol:    o.Symbol,
+		Side:      o.Side,
+		ExecType:  domain.ExecTypeRejected,
+		OrdStatus: domain.OrderStatusRejected,
+		Price:     o.Price,
+		Qty:       o.Qty,
+		Text:      text,
+		Time:      time.Now().UTC(),
+	}
+	o.Apply(e)
+	order := *o
+	srv.save(order, &e)
+	return order, e
+}
+
+func (srv *orderServiceImpl) publishReject(order domain.Order, e domain.Execution) {
+	ordLog.Warnf("[REJECTED] %s %s %s %s: %s", order.ClOrdID, order.Side, order.Qty, order.Symbol, e.Text)
+	srv.bus.Publish(event.Execution{Execution: e})
+	srv.bus.Publish(event.Order{Order: order})
+}
+
+func (srv *orderServiceImpl) CancelOrder(ctx context.Context, sessionID quickfix.SessionID, clOrdID string) (string, error) {
+	srv.mu.Lock()
+	o, ok := srv.orders[clOrdID]
+	if !ok {
+		srv.mu.Unlock()
+		return "", fmt.Errorf("unknown order %s", clOrdID)
+	}
+	if !o.Open() {
+		srv.mu.Unlock()
+		return "", fmt.Errorf("order %s is %s", clOrdID, o.Status)
+	}
+	cancelID, err := srv.ids.Next(idgen.KindCancel)
+	if err != nil {
+		srv.mu.Unlock()
+		return "", err
+	}
+	if srv.store != nil {
+		if err := srv.store.SaveLink(cancelID, clOrdID); err != nil {
+			srv.mu.Unlock()
+			return "", fmt.Errorf("error saving order cancel request: %w", err)
+		}
+	}
+	srv.cancels[cancelID] = clOrdID
+	req := mapper.OrderCancelRequest(*o, cancelID)
+	srv.mu.Unlock()
+
+	if err := srv.throttle.Send(ctx, req, sessionID); err != nil {
+		return "", fmt.Errorf("error sending order cancel request: %w", err)
+	}
+	ordLog.Infof("[CANCEL] %s requested with %s", clOrdID, cancelID)
+	return cancelID, nil
+}
+
+func (srv *orderServiceImpl) OnExecutionReport(msg executionreport.ExecutionReport, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+	e, err := mapper.Execution(msg)
+	if err != nil {
+		ordLog.Errorf("Error reading execution report: %v", err)
+		return err
+	}
+
+	reqID := useExactValueIgnoreError(msg.GetMassStatusReqID)
+	if e.ClOrdID == "" && e.OrderID == "" {
+		// E.g. the answer to a mass status request without any order.
+		if reqID != "" {
+			srv.reported(sessionID, reqID, "",
+				useExactValueIgnoreError(msg.GetTotNumReports), useExactValueIgnoreError(msg.GetLastRptRequested))
+		}
+		return nil
+	}
+
+	srv.mu.Lock()
+	if e.ClOrdID == "" {
+		// Entered by other means than FIX, known by the OrderID only.
+		e.ClOrdID = e.OrderID
+	}
+	o := srv.order(e.ClOrdID, e.OrigClOrdID)
+	if o == nil {
+		// Sent by another client or before a restart.
+		ordLog.Warnf("Execution report %s for unknown order %s", e.ExecID, e.ClOrdID)
+		o = &domain.Order{
+			ClOrdID: e.ClOrdID,
+			Account: e.Account,
+			Symbol:  e.Symbol,
+			Side:    e.Side,
+			Price:   e.Price,
+			Qty:     e.Qty,
+			Created: e.Time,
+		}
+		srv.orders[e.ClOrdID] = o
+	}
+	o.Apply(e)
+	order := *o
+	srv.save(order, &e)
+	srv.mu.Unlock()
+
+	if e.Fill() {
+		ordLog.Infof("[FILL] %s %s %s %s @ %s, %s/%s", order.ClOrdID, order.Side, e.LastQty, order.Symbol, e.LastPx, order.CumQty, order.Qty)
+	} else {
+		ordLog.Infof("[%s] %s %s", e.ExecType, order.ClOrdID, order.Status)
+	}
+	srv.bus.Publish(event.Execution{Execution: e})
+	srv.bus.Publish(event.Order{Order: order})
+	if p, ok := srv.positions.Apply(e); ok {
+		srv.bus.Publish(event.Position{Position: p})
+	}
+	if reqID != "" {
+		srv.reported(sessionID, reqID, order.ClOrdID,
+			useExactValueIgnoreError(msg.GetTotNumReports), useExactValueIgnoreError(msg.GetLastRptRequested))
+	}
+	return nil
+}
+
+func (srv *orderServiceImpl) MassStatus(ctx context.Context, sessionID quickfix.SessionID, scope domain.OrderScope) (string, error) {
+	reqID, err := srv.ids.Next(idgen.KindMassStatus)
+	if err != nil {
+		return "", err
+	}
+	srv.mu.Lock()
+	srv.massStatuses[reqID] = &massStatus{scope: scope, sent: time.Now().UTC(), reported: make(map[string]struct{})}
+	srv.mu.Unlock()
+
+	if err := srv.throttle.Send(ctx, mapper.OrderMassStatusRequest(reqID, scope), sessionID); err != nil {
+		srv.mu.Lock()
+		delete(srv.massStatuses, reqID)
+		srv.mu.Unlock()
+		return "", fmt.Errorf("error sending order mass status request: %w", err)
+	}
+	ordLog.Infof("[MASS_STATUS] %s requested for %+v", reqID, scope)
+	return reqID, nil
+}
+
+// reported records an order reported for the mass status request reqID, none
+// when clOrdID is empty. After the last report, the open orders it did not
+// report, which may have been filled or canceled meanwhile, are marked
+// UNKNOWN and their status requested one by one.
+func (srv *orderServiceImpl) reported(sessionID quickfix.SessionID, reqID, clOrdID string, total int, last bool) {
+	srv.mu.Lock()
+	req, ok := srv.massStatuses[reqID]
+	if !ok {
+		srv.mu.Unlock()
+		return
+	}
+	if clOrdID != "" {
+		req.reported[clOrdID] = struct{}{}
+		req.reports++
+	}
+	if !last && (total == 0 || req.reports < total) {
+		srv.mu.Unlock()
+		return
+	}
+	delete(srv.massStatuses, reqID)
+
+	now := time.Now().UTC()
+	var missing []domain.Order
+	for clOrdID, o := range srv.orders {
+		if _, ok := req.reported[clOrdID]; ok || !o.Open() || !req.scope.Match(*o) || !o.Created.Before(req.sent) {
+			continue
+		}
+		o.Status = domain.OrderStatusUnknown
+		o.Text = "not reported by the venue"
+		o.Updated = now
+		srv.save(*o, nil)
+		missing = append(missing, *o)
+	}
+	srv.mu.Unlock()
+
+	ordLog.Infof("[MASS_STATUS] %s done, %d orders reported, %d missing", reqID, req.reports, len(missing))
+	for _, o := range missing {
+		ordLog.Warnf("[UNKNOWN] %s %s %s %s not reported by the venue, requesting its status", o.ClOrdID, o.Side, o.Qty, o.Symbol)
+		srv.bus.Publish(event.Order{Order: o})
+	}
+	// The requests may wait for the throttle, which must not hold up the
+	// session delivering the reports.
+	go func() {
+		for _, o := range missing {
+			if err := srv.throttle.Send(context.Background(), mapper.OrderStatusRequest(o), sessionID); err != nil {
+				ordLog.Errorf("Error sending order status request for %s: %v", o.ClOrdID, err)
+			}
+		}
+	}()
+}
+
+func (srv *orderServiceImpl) CancelAll(ctx context.Context, sessionID quickfix.SessionID, scope domain.OrderScope) ([]string, error) {
+	if scope.Account != "" {
+		return srv.cancelEach(ctx, sessionID, scope)
+	}
+	clOrdID, err := srv.ids.Next(idgen.KindMassCancel)
+	if err != nil {
+		return nil, err
+	}
+	srv.mu.Lock()
+	srv.massCancels[clOrdID] = scope
+	srv.mu.Unlock()
+
+	if err := srv.throttle.Send(ctx, mapper.OrderMassCancelRequest(clOrdID, scope), sessionID); err != nil {
+		srv.mu.Lock()
+		delete(srv.massCancels, clOrdID)
+		srv.mu.Unlock()
+		return nil, fmt.Errorf("error sending order mass cancel request: %w", err)
+	}
+	ordLog.Infof("[CANCEL_ALL] %s requested for %+v", clOrdID, scope)
+	return []string{clOrdID}, nil
+}
+
+// cancelEach requests the cancel of every open order in scope.
+func (srv *orderServiceImpl) cancelEach(ctx context.Context, sessionID quickfix.SessionID, scope domain.OrderScope) ([]string, error) {
+	var ids []string
+	var errs []error
+	for _, o := range srv.Orders(true) {
+		if !scope.Match(o) || o.Status == domain.OrderStatusPendingCancel {
+			continue
+		}
+		id, err := srv.CancelOrder(ctx, sessionID, o.ClOrdID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	ordLog.Infof("[CANCEL_ALL] %d cancels requested for %+v", len(ids), scope)
+	return ids, errors.Join(errs...)
+}
+
+// cancelEachAfterReject cancels the orders in scope one by one after the
+// venue rejected their mass cancel. It is run apart from the session
+// delivering the reject, as the cancels may wait for the throttle.
+func (srv *orderServiceImpl) cancelEachAfterReject(sessionID quickfix.SessionID, scope domain.OrderScope) {
+	if _, err := srv.cancelEach(context.Background(), sessionID, scope); err != nil {
+		ordLog.Errorf("Error canceling orders one by one: %v", err)
+	}
+}
+
+func (srv *orderServiceImpl) AwaitClosed(ctx context.Context, scope domain.OrderScope) error {
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		open := 0
+		for _, o := range srv.Orders(true) {
+			if scope.Match(o) {
+				open++
+			}
+		}
+		if open == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%d orders still open: %w", open, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (srv *orderServiceImpl) OnLogout(sessionID quickfix.SessionID) {
+	now := time.Now().UTC()
+	srv.mu.Lock()
+	var unknown []domain.Order
+	for _, o := range srv.orders {
+		if !o.Open() || o.Status == domain.OrderStatusUnknown {
+			continue
+		}
+		o.Status = domain.OrderStatusUnknown
+		o.Updated = now
+		srv.save(*o, nil)
+		unknown = append(unknown, *o)
+	}
+	srv.mu.Unlock()
+
+	if len(unknown) > 0 {
+		ordLog.Warnf("Session %s lost with %d open orders, their status is unknown until the next mass status", sessionID, len(unknown))
+	}
+	for _, o := range unknown {
+		srv.bus.Publish(event.Order{Order: o})
+	}
+}
+
+func (srv *orderServiceImpl) OnOrderMassCancelReport(msg ordermasscancelreport.OrderMassCancelReport, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+	response, err := msg.GetMassCancelResponse()
+	if err != nil {
+		return err
+	}
+	clOrdID := useExactValueIgnoreError(msg.GetClOrdID)
+	srv.mu.Lock()
+	scope, ok := srv.massCancels[clOrdID]
+	delete(srv.massCancels, clOrdID)
+	srv.mu.Unlock()
+
+	if response != enum.MassCancelResponse_CANCEL_REQUEST_REJECTED {
+		ordLog.Infof("[CANCEL_ALL] %s accepted, %d orders affected", clOrdID,
+			useExactValueIgnoreError(msg.GetTotalAffectedOrders))
+		return nil
+	}
+	ordLog.Warnf("[CANCEL_ALL] %s rejected (%s): %s", clOrdID,
+		useExactValueIgnoreError(msg.GetMassCancelRejectReason), useExactValueIgnoreError(msg.GetText))
+	if ok {
+		go srv.cancelEachAfterReject(sessionID, scope)
+	}
+	return nil
+}
+
+func (srv *orderServiceImpl) OnReject(r *domain.Reject, sessionID quickfix.SessionID) {
+	switch r.RefMsgType {
+	case string(enum.MsgType_ORDER_SINGLE):
+		// Checked and rejected under the lock, an execution report may close
+		// the order meanwhile.
+		srv.mu.Lock()
+		o, ok := srv.orders[r.RefID]
+		if !ok || !o.Open() {
+			srv.mu.Unlock()
+			return
+		}
+		order, e := srv.rejectLocked(r.RefID, r.Error())
+		srv.mu.Unlock()
+		srv.publishReject(order, e)
+
+	case string(enum.MsgType_ORDER_CANCEL_REQUEST), string(enum.MsgType_ORDER_CANCEL_REPLACE_REQUEST):
+		srv.mu.Lock()
+		o := srv.order(r.RefID, "")
+		if o == nil {
+			srv.mu.Unlock()
+			return
+		}
+		o.Text = r.Error()
+		o.Updated = time.Now().UTC()
+		order := *o
+		srv.save(order, nil)
+		srv.mu.Unlock()
+		srv.bus.Publish(event.Order{Order: order})
+
+	case string(enum.MsgType_ORDER_MASS_CANCEL_REQUEST):
+		srv.mu.Lock()
+		scope, ok := srv.massCancels[r.RefID]
+		delete(srv.massCancels, r.RefID)
+		srv.mu.Unlock()
+		if !ok {
+			return
+		}
+		go srv.cancelEachAfterReject(sessionID, scope)
+
+	case string(enum.MsgType_ORDER_MASS_STATUS_REQUEST):
+		srv.mu.Lock()
+		delete(srv.massStatuses, r.RefID)
+		srv.mu.Unlock()
+	}
+}
+
+func (srv *orderServiceImpl) OnOrderCancelReject(msg ordercancelreject.OrderCancelReject, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+	clOrdID := useExactValueIgnoreError(msg.GetClOrdID)
+	origClOrdID := useExactValueIgnoreError(msg.GetOrigClOrdID)
+	text := useExactValueIgnoreError(msg.GetText)
+	ordLog.Warnf("Cancel %s of %s rejected: %s %s", clOrdID, origClOrdID,
+		useExactValueIgnoreError(msg.GetCxlRejReason), text)
+
+	srv.mu.Lock()
+	o := srv.order(clOrdID, origClOrdID)
+	if o == nil {
+		srv.mu.Unlock()
+		return nil
+	}
+	if status, err := msg.GetOrdStatus(); err == nil {
+		o.Status = mapper.OrderStatus(status)
+	}
+	o.Text = text
+	o.Updated = time.Now().UTC()
+	order := *o
+	srv.save(order, nil)
+	srv.mu.Unlock()
+
+	srv.bus.Publish(event.Order{Order: order})
+	return nil
+}
+
+// order finds the order of a message by its ClOrdID, the one of its cancel
+// request or its OrigClOrdID. srv.mu must be held.
+func (srv *orderServiceImpl) order(clOrdID, origClOrdID string) *domain.Order {
+	if o, ok := srv.orders[clOrdID]; ok {
+		return o
+	}
+	if id, ok := srv.cancels[clOrdID]; ok {
+		return srv.orders[id]
+	}
+	return srv.orders[origClOrdID]
+}
+
+func (srv *orderServiceImpl) Order(clOrdID string) (domain.Order, bool) {
+	srv.mu.RLock()
+	defer srv.mu.RUnlock()
+	o, ok := srv.orders[clOrdID]
+	if !ok {
+		return domain.Order{}, false
+	}
+	return *o, true
+}
+
+func (srv *orderServiceImpl) Orders(open bool) []domain.Order {
+	srv.mu.RLock()
+	defer srv.mu.RUnlock()
+	out := make([]domain.Order, 0, len(srv.orders))
+	for _, o := range srv.orders {
+		if !open || o.Open() {
+			out = append(out, *o)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].Created.Equal(out[j].Created) {
+			return out[i].Created.Before(out[j].Created)
+		}
+		return out[i].ClOrdID < out[j].ClOrdID
+	})
+	return out
+}
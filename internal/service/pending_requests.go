@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// pendingRequests holds the outcome of the requests sent until their caller
+// waits for it, by request ID: nil once the venue answered, the
+// *domain.Reject once it rejected. The outcome is kept until waited for, so
+// Wait may come after the answer.
+type pendingRequests struct {
+	mu      sync.Mutex
+	results map[string]*requestResult
+}
+
+type requestResult struct {
+	done chan struct{}
+	err  error
+}
+
+func newPendingRequests() *pendingRequests {
+	return &pendingRequests{results: make(map[string]*requestResult)}
+}
+
+// add registers the request id before it is sent.
+func (p *pendingRequests) add(id string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.results[id] = &requestResult{done: make(chan struct{})}
+}
+
+// drop forgets the request id, e.g. when it could not be sent.
+func (p *pendingRequests) drop(id string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.results, id)
+}
+
+// resolve records the outcome of the request id and reports whether it was
+// pending. Only the first outcome counts.
+func (p *pendingRequests) resolve(id string, err error) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	r, ok := p.results[id]
+	if !ok {
+		return false
+	}
+	select {
+	case <-r.done:
+		return false
+	default:
+	}
+	r.err = err
+	close(r.done)
+	return true
+}
+
+// wait returns the outcome of the request id once known, or the error of
+// ctx.
+func (p *pendingRequests) wait(ctx context.Context, id string) error {
+	p.mu.Lock()
+	r, ok := p.results[id]
+	p.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("unknown request %s", id)
+	}
+	select {
+	case <-r.done:
+		p.drop(id)
+		return r.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+)
+
+func TestPendingRequestsWait(t *testing.T) {
+	rejected := &domain.Reject{Kind: domain.RejectMarketData, RefMsgType: "V", RefID: "MD-1", Reason: "unknown_symbol"}
+	tests := []struct {
+		name    string
+		add     bool
+		resolve []error // outcomes recorded in turn
+		want    error
+	}{
+		{name: "answered", add: true, resolve: []error{nil}},
+		{name: "rejected", add: true, resolve: []error{rejected}, want: rejected},
+		{name: "first outcome counts", add: true, resolve: []error{nil, rejected}},
+		{name: "not answered", add: true, want: context.DeadlineExceeded},
+		{name: "unknown", resolve: []error{nil}, want: errors.New("unknown request MD-1")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newPendingRequests()
+			if tt.add {
+				p.add("MD-1")
+			}
+			for _, err := range tt.resolve {
+				p.resolve("MD-1", err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			err := p.wait(ctx, "MD-1")
+			if (err == nil) != (tt.want == nil) || err != nil && err.Error() != tt.want.Error() {
+				t.Fatalf("wait() = %v, want %v", err, tt.want)
+			}
+			var reject *domain.Reject
+			if errors.As(tt.want, &reject) && !errors.As(err, &reject) {
+				t.Fatalf("wait() = %v, want a *domain.Reject", err)
+			}
+		})
+	}
+}
+
+func TestPendingRequestsWaitOnce(t *testing.T) {
+	p := newPendingRequests()
+	p.add("SL-1")
+	done := make(chan error)
+	go func() { done <- p.wait(context.Background(), "SL-1") }()
+	p.resolve("SL-1", nil)
+	if err := <-done; err != nil {
+		t.Fatalf("wait() = %v", err)
+	}
+	if err := p.wait(context.Background(), "SL-1"); err == nil {
+		t.Fatal("second wait() succeeded, want the request forgotten")
+	}
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+	"github.com/phimaker/waanx-fix-simpler/internal/idgen"
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/fix/mapper"
+	"github.com/phimaker/waanx-fix-simpler/internal/position"
+	"github.com/phimaker/waanx-fix-simpler/internal/throttle"
+	"github.com/quickfixgo/enum"
+	"github.com/quickfixgo/fix44/positionreport"
+	"github.com/quickfixgo/fix44/requestforpositionsack"
+	"github.com/quickfixgo/quickfix"
+)
+
+// PositionService reconciles the positions kept from the fills with the ones
+// reported by the venue. The PositionReports answering a RequestForPositions
+// are collected until all of them arrived, then every difference with the
+// kept positions of the account is published as event.PositionBreak.
+type PositionService interface {
+	RouterService
+
+	// RequestForPositions requests the positions of account from the venue.
+	RequestForPositions(ctx context.Context, sessionID quickfix.SessionID, account string) (string, error)
+
+	// OnReject is the RejectHandler dropping the requests the venue
+	// rejected.
+	OnReject(r *domain.Reject, sessionID quickfix.SessionID)
+}
+
+type positionRequest struct {
+	account string
+	total   int // reports expected, zero until known
+	reports []domain.Position
+}
+
+type positionServiceImpl struct {
+	mu       sync.Mutex
+	requests map[string]*positionRequest // by PosReqID
+	keeper   *position.Keeper
+	throttle *throttle.Throttler
+	ids      *idgen.Generator
+	bus      *event.Bus
+}
+
+func NewPositionService(keeper *position.Keeper, throttler *throttle.Throttler, ids *idgen.Generator, bus *event.Bus) PositionService {
+	return &positionServiceImpl{
+		requests: make(map[string]*positionRequest),
+		keeper:   keeper,
+		throttle: throttler,
+		ids:      ids,
+		bus:      bus,
+	}
+}
+
+func (srv *positionServiceImpl) RegisterRouters(route func(beginString string, msgType string, router quickfix.MessageRoute)) {
+	route(requestforpositionsack.Route(srv.OnRequestForPositionsAck))
+	route(positionreport.Route(srv.OnPositionReport))
+}
+
+func (srv *positionServiceImpl) RequestForPositions(ctx context.Context, sessionID quickfix.SessionID, account string) (string, error) {
+	reqID, err := srv.ids.Next(idgen.KindPositions)
+	if err != nil {
+		return "", err
+	}
+	srv.mu.Lock()
+	srv.requests[reqID] = &positionRequest{account: account}
+	srv.mu.Unlock()
+
+	if err := srv.throttle.Send(ctx, mapper.RequestForPositions(reqID, account, time.Now()), sessionID); err != nil {
+		srv.mu.Lock()
+		delete(srv.requests, reqID)
+		srv.mu.Unlock()
+		return "", fmt.Errorf("error sending request for positions: %w", err)
+	}
+	return reqID, nil
+}
+
+func (srv *positionServiceImpl) OnReject(r *domain.Reject, sessionID quickfix.SessionID) {
+	if r.RefMsgType != "AN" {
+		return
+	}
+	srv.mu.Lock()
+	req, ok := srv.requests[r.RefID]
+	delete(srv.requests, r.RefID)
+	srv.mu.Unlock()
+	if ok {
+		ordLog.Errorf("Positions of %q not reconciled: %v", req.account, r)
+	}
+}
+
+func (srv *positionServiceImpl) OnRequestForPositionsAck(msg requestforpositionsack.RequestForPositionsAck, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+	reqID := useExactValueIgnoreError(msg.GetPosReqID)
+	result, err := msg.GetPosReqResult()
+	if err != nil {
+		return err
+	}
+	total := useExactValueIgnoreError(msg.GetTotalNumPosReports)
+
+	switch result {
+	case enum.PosReqResult_VALID_REQUEST:
+		if total > 0 {
+			srv.expect(reqID, total)
+			return nil
+		}
+	case enum.PosReqResult_NO_POSITIONS_FOUND_THAT_MATCH_CRITERIA:
+	default:
+		ordLog.Warnf("Request for positions %s rejected (%s): %s, positions are not reconciled",
+			reqID, result, useExactValueIgnoreError(msg.GetText))
+		srv.mu.Lock()
+		delete(srv.requests, reqID)
+		srv.mu.Unlock()
+		return nil
+	}
+	// No position at the venue.
+	srv.expect(reqID, 0)
+	return nil
+}
+
+// expect sets the number of reports answering reqID, reconciling the account
+// if they all arrived.
+func (srv *positionServiceImpl) expect(reqID string, total int) {
+	srv.mu.Lock()
+	req, ok := srv.requests[reqID]
+	if !ok {
+		srv.mu.Unlock()
+		return
+	}
+	req.total = total
+	done := len(req.reports) >= total
+	if done {
+		delete(srv.requests, reqID)
+	}
+	srv.mu.Unlock()
+
+	if done {
+		srv.reconcile(req)
+	}
+}
+
+func (srv *positionServiceImpl) OnPositionReport(msg positionreport.PositionReport, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+	p, err := mapper.Position(msg)
+	if err != nil {
+		ordLog.Errorf("Error reading position report: %v", err)
+		return err
+	}
+	reqID := useExactValueIgnoreError(msg.GetPosReqID)
+	ordLog.Debugf("Position report %s: %s %s %s", reqID, p.Account, p.Symbol, p.Qty)
+
+	srv.mu.Lock()
+	req, ok := srv.requests[reqID]
+	if !ok {
+		srv.mu.Unlock()
+		return nil
+	}
+	req.reports = append(req.reports, p)
+	if total := useExactValueIgnoreError(msg.GetTotalNumPosReports); total > 0 {
+		req.total = total
+	}
+	done := req.total > 0 && len(req.reports) >= req.total
+	if done {
+		delete(srv.requests, reqID)
+	}
+	srv.mu.Unlock()
+
+	if done {
+		srv.reconcile(req)
+	}
+	return nil
+}
+
+func (srv *positionServiceImpl) reconcile(req *positionRequest) {
+	for _, b := range srv.keeper.Reconcile(req.account, req.reports, time.Now().UTC()) {
+		srv.bus.Publish(b)
+	}
+}
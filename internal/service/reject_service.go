@@ -0,0 +1,175 @@
+package service
+
+import (
+	"sync"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/fix"
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/fix/mapper"
+	"github.com/phimaker/waanx-fix-simpler/internal/metrics"
+	"github.com/phimaker/waanx-fix-simpler/internal/throttle"
+	"github.com/quickfixgo/fix44/businessmessagereject"
+	"github.com/quickfixgo/fix44/reject"
+	"github.com/quickfixgo/quickfix"
+	"github.com/quickfixgo/tag"
+)
+
+var rejectCounter = metrics.NewCounterVec("fix_rejects")
+
+// requestIDTags are the tags holding the ID of the requests sent, by
+// MsgType.
+var requestIDTags = map[string]quickfix.Tag{
+	"x":  tag.SecurityReqID,
+	"e":  tag.SecurityStatusReqID,
+	"g":  tag.TradSesReqID,
+	"V":  tag.MDReqID,
+	"D":  tag.ClOrdID,
+	"F":  tag.ClOrdID,
+	"G":  tag.ClOrdID,
+	"q":  tag.ClOrdID,
+	"AF": tag.MassStatusReqID,
+	"AN": tag.PosReqID,
+}
+
+// maxSent bounds the requests remembered to match the rejects with.
+const maxSent = 10000
+
+type sentRequest struct {
+	msgType string
+	id      string
+}
+
+// RejectHandler is called with every reject, the one of the rejected
+// request failing it.
+type RejectHandler func(r *domain.Reject, sessionID quickfix.SessionID)
+
+// RejectService handles the session level Rejects (3) and the
+// BusinessMessageRejects (j) of the venue. Each is matched with the request
+// it refers to, by RefSeqNum or BusinessRejectRefID, counted by reason,
+// published as event.Reject and handed to the handlers: the service that
+// sent the request fails it with the *domain.Reject. Rejects for exceeding
+// the rate limits of the venue pause the rejected MsgType in the throttler.
+type RejectService interface {
+	RouterService
+
+	// Observe is the fix.MessageObserver remembering the requests sent.
+	Observe(direction string, msg *quickfix.Message, sessionID quickfix.SessionID)
+	// AddHandler registers fn to be called with every reject.
+	AddHandler(fn RejectHandler)
+	// OnLogout forgets the requests sent, their sequence numbers starting
+	// over.
+	OnLogout(sessionID quickfix.SessionID)
+}
+
+type rejectServiceImpl struct {
+	mu       sync.Mutex
+	bySeq    map[int]sentRequest
+	byID     map[string]sentRequest
+	order    []int // sequence numbers of bySeq, oldest first
+	handlers []RejectHandler
+
+	throttler *throttle.Throttler
+	bus       *event.Bus
+}
+
+func NewRejectService(throttler *throttle.Throttler, bus *event.Bus) RejectService {
+	return &rejectServiceImpl{
+		bySeq:     make(map[int]sentRequest),
+		byID:      make(map[string]sentRequest),
+		throttler: throttler,
+		bus:       bus,
+	}
+}
+
+func (srv *rejectServiceImpl) RegisterRouters(route func(beginString string, msgType string, router quickfix.MessageRoute)) {
+	route(reject.Route(srv.OnReject))
+	route(businessmessagereject.Route(srv.OnBusinessMessageReject))
+}
+
+func (srv *rejectServiceImpl) AddHandler(fn RejectHandler) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	srv.handlers = append(srv.handlers, fn)
+}
+
+func (srv *rejectServiceImpl) Observe(direction string, msg *quickfix.Message, sessionID quickfix.SessionID) {
+	if direction != fix.DirectionOut {
+		return
+	}
+	msgType, err := msg.MsgType()
+	if err != nil {
+		return
+	}
+	idTag, ok := requestIDTags[msgType]
+	if !ok {
+		return
+	}
+	seqNum, err := msg.Header.GetInt(tag.MsgSeqNum)
+	if err != nil {
+		return
+	}
+	id, _ := msg.Body.GetString(idTag)
+	sent := sentRequest{msgType: msgType, id: id}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if len(srv.order) == maxSent {
+		oldest := srv.bySeq[srv.order[0]]
+		delete(srv.bySeq, srv.order[0])
+		delete(srv.byID, oldest.id)
+		srv.order = srv.order[1:]
+	}
+	srv.bySeq[seqNum] = sent
+	if id != "" {
+		srv.byID[id] = sent
+	}
+	srv.order = append(srv.order, seqNum)
+}
+
+func (srv *rejectServiceImpl) OnLogout(sessionID quickfix.SessionID) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	srv.bySeq = make(map[int]sentRequest)
+	srv.byID = make(map[string]sentRequest)
+	srv.order = nil
+}
+
+func (srv *rejectServiceImpl) OnReject(msg reject.Reject, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+	srv.handle(mapper.SessionReject(msg), sessionID)
+	return nil
+}
+
+func (srv *rejectServiceImpl) OnBusinessMessageReject(msg businessmessagereject.BusinessMessageReject, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+	srv.handle(mapper.BusinessReject(msg), sessionID)
+	return nil
+}
+
+// handle completes r with the request it refers to and hands it over.
+func (srv *rejectServiceImpl) handle(r domain.Reject, sessionID quickfix.SessionID) {
+	srv.mu.Lock()
+	sent, ok := srv.byID[r.RefID]
+	if !ok || r.RefID == "" {
+		sent, ok = srv.bySeq[r.RefSeqNum]
+	}
+	if ok {
+		if r.RefMsgType == "" {
+			r.RefMsgType = sent.msgType
+		}
+		if r.RefID == "" && sent.msgType == r.RefMsgType {
+			r.RefID = sent.id
+		}
+	}
+	handlers := srv.handlers
+	srv.mu.Unlock()
+
+	rejectCounter.Inc(r.Kind, r.RefMsgType, r.Reason)
+	fixLog.Warnf("[REJECT] %s", r.Error())
+	srv.bus.Publish(event.Reject{Reject: r})
+	if throttle.VenueThrottled(r) {
+		srv.throttler.Backoff(r.RefMsgType, r.Text)
+	}
+	for _, fn := range handlers {
+		fn(&r, sessionID)
+	}
+}
@@ -3,29 +3,70 @@ package service
 import (
 	"context"
 	"fmt"
-	"time"
-
-	"github.com/phimaker/waanx-fix-simpler/internal/logger"
-	// "github.com/phimaker/waanx-fix-simpler/internal/domain"
-
+	"sort"
+	"sync"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/fix/waanx/fix44/securitylist"
+	"github.com/phimaker/waanx-fix-simpler/internal/idgen"
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/fix/mapper"
+	"github.com/phimaker/waanx-fix-simpler/internal/throttle"
 	"github.com/quickfixgo/enum"
 	"github.com/quickfixgo/field"
-	"github.com/quickfixgo/fix44/securitylist"
 	"github.com/quickfixgo/fix44/securitylistrequest"
 	"github.com/quickfixgo/quickfix"
 )
 
+// SecurityListService is the security master: it keeps the instruments of the
+// SecurityList and their current trading status.
 type SecurityListService interface {
 	RouterService
 
 	SecurityListRequest(ctx context.Context, sessionID quickfix.SessionID) (string, error)
+	// Wait blocks until the venue answered the request reqID returned by
+	// SecurityListRequest, and returns the *domain.Reject if it rejected it.
+	Wait(ctx context.Context, reqID string) error
+
+	// Security returns the instrument traded as symbol.
+	Security(symbol string) (domain.Instrument, bool)
+	// SetTradingStatus records the trading status of symbol and returns the
+	// updated instrument.
+	SetTradingStatus(symbol string, status enum.SecurityTradingStatus) domain.Instrument
+	// Halted reports whether orders for symbol must not be sent.
+	Halted(symbol string) bool
+
+	// OnReject is the RejectHandler dropping the requests the venue
+	// rejected.
+	OnReject(r *domain.Reject, sessionID quickfix.SessionID)
 }
 
 type securityListServiceImpl struct {
+	mu         sync.RWMutex
+	securities map[string]domain.Instrument // by symbol
+	requests   *pendingRequests             // by SecurityReqID
+	throttle   *throttle.Throttler
+	ids        *idgen.Generator
 }
 
-func NewSecurityListService() SecurityListService {
-	return &securityListServiceImpl{}
+func NewSecurityListService(throttler *throttle.Throttler, ids *idgen.Generator) SecurityListService {
+	return &securityListServiceImpl{
+		securities: make(map[string]domain.Instrument),
+		requests:   newPendingRequests(),
+		throttle:   throttler,
+		ids:        ids,
+	}
+}
+
+// Snapshot implements Snapshotter.
+func (srv *securityListServiceImpl) Snapshot() any {
+	srv.mu.RLock()
+	defer srv.mu.RUnlock()
+	entries := make([]domain.Instrument, 0, len(srv.securities))
+	for _, entry := range srv.securities {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Symbol < entries[j].Symbol })
+	return entries
 }
 
 func (srv *securityListServiceImpl) RegisterRouters(route func(beginString string, msgType string, router quickfix.MessageRoute)) {
@@ -33,43 +74,112 @@ func (srv *securityListServiceImpl) RegisterRouters(route func(beginString strin
 }
 
 func (srv *securityListServiceImpl) OnSecurityList(msg securitylist.SecurityList, sessionID quickfix.SessionID) quickfix.MessageRejectError {
-	logger.Infof("SecurityList: %v", msg)
+	mdLog.Infof("SecurityList: %v", msg)
 	groups, err := msg.GetNoRelatedSym()
 	if err != nil {
-		logger.Errorf("Error getting NoMDEntries group: %v", err)
+		mdLog.Errorf("Error getting NoMDEntries group: %v", err)
 		return err
 	}
 
 	if groups.Len() == 0 {
-		logger.Error("No MDEntries found")
+		mdLog.Error("No MDEntries found")
 		return quickfix.NewMessageRejectError("No MDEntries found", 0, nil)
 	}
+	if reqID, err := msg.GetSecurityReqID(); err == nil {
+		srv.requests.resolve(reqID, nil)
+	}
 
 	for i := 0; i < groups.Len(); i++ {
 		group := groups.Get(i)
 
-		symbol := useExactValueIgnoreError(group.GetSymbol)
-		securityId := useExactValueIgnoreError(group.GetSecurityID)
-		logger.Infof("[SYMBOL:%s]", symbol)
-		logger.Infof("[SecurityID:%s]", securityId)
+		entry := mapper.Instrument(group)
+		mdLog.Infof("[SYMBOL:%s]", entry.Symbol)
+		mdLog.Infof("[SecurityID:%s]", entry.SecurityID)
+
+		srv.mu.Lock()
+		if prev, ok := srv.securities[entry.Symbol]; ok {
+			entry.TradingStatus, entry.Halted = prev.TradingStatus, prev.Halted
+		}
+		srv.securities[entry.Symbol] = entry
+		srv.mu.Unlock()
 	}
 	return nil
 }
 
+func (srv *securityListServiceImpl) OnReject(r *domain.Reject, sessionID quickfix.SessionID) {
+	if r.RefMsgType != string(enum.MsgType_SECURITY_LIST_REQUEST) {
+		return
+	}
+	if !srv.requests.resolve(r.RefID, r) {
+		return
+	}
+	srv.mu.RLock()
+	known := len(srv.securities)
+	srv.mu.RUnlock()
+	mdLog.Errorf("Security list not loaded, %d instruments known: %v", known, r)
+}
+
+func (srv *securityListServiceImpl) Wait(ctx context.Context, reqID string) error {
+	return srv.requests.wait(ctx, reqID)
+}
+
+func (srv *securityListServiceImpl) Security(symbol string) (domain.Instrument, bool) {
+	srv.mu.RLock()
+	defer srv.mu.RUnlock()
+	sec, ok := srv.securities[symbol]
+	return sec, ok
+}
+
+func (srv *securityListServiceImpl) SetTradingStatus(symbol string, status enum.SecurityTradingStatus) domain.Instrument {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	sec, ok := srv.securities[symbol]
+	if !ok {
+		sec = domain.Instrument{Symbol: symbol}
+	}
+	sec.TradingStatus = securityTradingStatusName(status)
+	sec.Halted = haltedStatus(status)
+	srv.securities[symbol] = sec
+	return sec
+}
+
+func (srv *securityListServiceImpl) Halted(symbol string) bool {
+	srv.mu.RLock()
+	defer srv.mu.RUnlock()
+	return srv.securities[symbol].Halted
+}
+
+// haltedStatus reports whether status stops trading in the instrument.
+func haltedStatus(status enum.SecurityTradingStatus) bool {
+	switch status {
+	case enum.SecurityTradingStatus_OPENING_DELAY,
+		enum.SecurityTradingStatus_TRADING_HALT,
+		enum.SecurityTradingStatus_NO_OPEN,
+		enum.SecurityTradingStatus_NOT_AVAILABLE_FOR_TRADING,
+		enum.SecurityTradingStatus_NOT_TRADED_ON_THIS_MARKET:
+		return true
+	}
+	return false
+}
+
 func (srv *securityListServiceImpl) SecurityListRequest(ctx context.Context, sessionID quickfix.SessionID) (string, error) {
 	return srv.sendSecurityListRequest(ctx, sessionID)
 }
 
 func (srv *securityListServiceImpl) sendSecurityListRequest(ctx context.Context, sessionID quickfix.SessionID) (string, error) {
-	t := time.Now()
-	reqID := fmt.Sprintf("SLR-%d", t.UnixNano())
+	reqID, err := srv.ids.Next(idgen.KindSecurityList)
+	if err != nil {
+		return "", err
+	}
 	req := securitylistrequest.New(
 		field.NewSecurityReqID(reqID),
 		field.NewSecurityListRequestType(enum.SecurityListRequestType_ALL_SECURITIES),
 	)
-	logger.Infof("Request: %v\n", req.ToMessage())
+	mdLog.Infof("Request: %v\n", req.ToMessage())
 
-	if err := quickfix.SendToTarget(req, sessionID); err != nil {
+	srv.requests.add(reqID)
+	if err := srv.throttle.Send(ctx, req, sessionID); err != nil {
+		srv.requests.drop(reqID)
 		return "", fmt.Errorf("Error sending market data request: %v", err)
 	}
 
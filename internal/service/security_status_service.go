@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+	"github.com/phimaker/waanx-fix-simpler/internal/idgen"
+	"github.com/phimaker/waanx-fix-simpler/internal/throttle"
+	"github.com/quickfixgo/enum"
+	"github.com/quickfixgo/field"
+	"github.com/quickfixgo/fix44/securitystatus"
+	"github.com/quickfixgo/fix44/securitystatusrequest"
+	"github.com/quickfixgo/quickfix"
+)
+
+// SecurityStatusService subscribes to the trading status of instruments and
+// publishes an event.SecurityStatus whenever an instrument is halted or
+// resumes.
+type SecurityStatusService interface {
+	RouterService
+
+	SecurityStatusRequest(ctx context.Context, sessionID quickfix.SessionID, symbol string) (string, error)
+}
+
+type securityStatusServiceImpl struct {
+	securities SecurityListService
+	throttle   *throttle.Throttler
+	ids        *idgen.Generator
+	bus        *event.Bus
+}
+
+func NewSecurityStatusService(securities SecurityListService, throttler *throttle.Throttler, ids *idgen.Generator, bus *event.Bus) SecurityStatusService {
+	return &securityStatusServiceImpl{
+		securities: securities,
+		throttle:   throttler,
+		ids:        ids,
+		bus:        bus,
+	}
+}
+
+func (srv *securityStatusServiceImpl) RegisterRouters(route func(beginString string, msgType string, router quickfix.MessageRoute)) {
+	route(securitystatus.Route(srv.OnSecurityStatus))
+}
+
+func (srv *securityStatusServiceImpl) OnSecurityStatus(msg securitystatus.SecurityStatus, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+	symbol, err := msg.GetSymbol()
+	if err != nil {
+		mdLog.Errorf("SecurityStatus without Symbol: %v", err)
+		return err
+	}
+	if !msg.HasSecurityTradingStatus() {
+		mdLog.Debugf("SecurityStatus for %s without SecurityTradingStatus", symbol)
+		return nil
+	}
+	status := useExactValueIgnoreError(msg.GetSecurityTradingStatus)
+
+	prev, _ := srv.securities.Security(symbol)
+	sec := srv.securities.SetTradingStatus(symbol, status)
+	if prev.TradingStatus == sec.TradingStatus {
+		return nil
+	}
+
+	e := event.SecurityStatus{
+		Symbol:     symbol,
+		SecurityID: sec.SecurityID,
+		Status:     sec.TradingStatus,
+		Halted:     sec.Halted,
+		Time:       time.Now(),
+	}
+	if msg.HasHaltReasonChar() {
+		e.HaltReason = haltReasonName(useExactValueIgnoreError(msg.GetHaltReasonChar))
+	}
+	if msg.HasTransactTime() {
+		e.Time = useExactValueIgnoreError(msg.GetTransactTime)
+	}
+
+	switch {
+	case sec.Halted && !prev.Halted:
+		mdLog.Warnf("[HALT] %s: %s %s", symbol, e.Status, e.HaltReason)
+	case !sec.Halted && prev.Halted:
+		mdLog.Infof("[RESUME] %s: %s", symbol, e.Status)
+	default:
+		mdLog.Infof("[STATUS] %s: %s", symbol, e.Status)
+	}
+	srv.bus.Publish(e)
+	return nil
+}
+
+func (srv *securityStatusServiceImpl) SecurityStatusRequest(ctx context.Context, sessionID quickfix.SessionID, symbol string) (string, error) {
+	reqID, err := srv.ids.Next(idgen.KindSecurityStatus)
+	if err != nil {
+		return "", err
+	}
+	req := securitystatusrequest.New(
+		field.NewSecurityStatusReqID(reqID),
+		field.NewSubscriptionRequestType(enum.SubscriptionRequestType_SNAPSHOT_PLUS_UPDATES),
+	)
+	req.SetSymbol(symbol)
+	if sec, ok := srv.securities.Security(symbol); ok && sec.SecurityID != "" {
+		req.SetSecurityID(sec.SecurityID)
+	}
+
+	if err := srv.throttle.Send(ctx, req, sessionID); err != nil {
+		return "", fmt.Errorf("error sending security status request: %w", err)
+	}
+	return reqID, nil
+}
+
+var securityTradingStatusNames = map[enum.SecurityTradingStatus]string{
+	enum.SecurityTradingStatus_OPENING_DELAY:             "OPENING_DELAY",
+	enum.SecurityTradingStatus_TRADING_HALT:              "TRADING_HALT",
+	enum.SecurityTradingStatus_RESUME:                    "RESUME",
+	enum.SecurityTradingStatus_NO_OPEN:                   "NO_OPEN",
+	enum.SecurityTradingStatus_READY_TO_TRADE:            "READY_TO_TRADE",
+	enum.SecurityTradingStatus_NOT_AVAILABLE_FOR_TRADING: "NOT_AVAILABLE_FOR_TRADING",
+	enum.SecurityTradingStatus_NOT_TRADED_ON_THIS_MARKET: "NOT_TRADED_ON_THIS_MARKET",
+	enum.SecurityTradingStatus_UNKNOWN_OR_INVALID:        "UNKNOWN_OR_INVALID",
+	enum.SecurityTradingStatus_PRE_OPEN:                  "PRE_OPEN",
+	enum.SecurityTradingStatus_OPENING_ROTATION:          "OPENING_ROTATION",
+	enum.SecurityTradingStatus_FAST_MARKET:               "FAST_MARKET",
+	enum.SecurityTradingStatus_POST_CLOSE:                "POST_CLOSE",
+}
+
+func securityTradingStatusName(status enum.SecurityTradingStatus) string {
+	if name, ok := securityTradingStatusNames[status]; ok {
+		return name
+	}
+	return string(status)
+}
+
+var haltReasonNames = map[enum.HaltReasonChar]string{
+	enum.HaltReasonChar_NEWS_DISSEMINATION:     "NEWS_DISSEMINATION",
+	enum.HaltReasonChar_ORDER_INFLUX:           "ORDER_INFLUX",
+	enum.HaltReasonChar_ORDER_IMBALANCE:        "ORDER_IMBALANCE",
+	enum.HaltReasonChar_ADDITIONAL_INFORMATION: "ADDITIONAL_INFORMATION",
+	enum.HaltReasonChar_NEW_PENDING:            "NEWS_PENDING",
+	enum.HaltReasonChar_EQUIPMENT_CHANGEOVER:   "EQUIPMENT_CHANGEOVER",
+}
+
+func haltReasonName(reason enum.HaltReasonChar) string {
+	if name, ok := haltReasonNames[reason]; ok {
+		return name
+	}
+	return string(reason)
+}
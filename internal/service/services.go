@@ -0,0 +1,402 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/analytics"
+	"github.com/phimaker/waanx-fix-simpler/internal/bar"
+	"github.com/phimaker/waanx-fix-simpler/internal/calendar"
+	"github.com/phimaker/waanx-fix-simpler/internal/config"
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/dq"
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+	"github.com/phimaker/waanx-fix-simpler/internal/idgen"
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/db"
+	"github.com/phimaker/waanx-fix-simpler/internal/orderstore"
+	"github.com/phimaker/waanx-fix-simpler/internal/position"
+	"github.com/phimaker/waanx-fix-simpler/internal/risk"
+	"github.com/phimaker/waanx-fix-simpler/internal/tape"
+	"github.com/phimaker/waanx-fix-simpler/internal/throttle"
+	"github.com/shopspring/decimal"
+)
+
+// Snapshotter is implemented by services whose state can be captured, e.g. to
+// compare the outcome of a replay with a golden file.
+type Snapshotter interface {
+	Snapshot() any
+}
+
+// Services wires the FIX application with the services handling its messages.
+// It is shared by every command that needs the full message pipeline.
+type Services struct {
+	// Bus carries the events published by the services.
+	Bus *event.Bus
+	// IDs generates the identifiers of the orders and requests sent.
+	IDs *idgen.Generator
+	// Calendar is the trading calendar of the venue, nil without one.
+	Calendar *calendar.Calendar
+
+	Fix            FixService
+	Heartbeat      HeartbeatService
+	SecurityList   SecurityListService
+	SecurityStatus SecurityStatusService
+	TradingSession TradingSessionService
+	MarketData     MarketDataService
+	// Tape holds the recent trades of every symbol, without duplicates.
+	Tape *tape.Tape
+	// Analytics holds the metrics derived from the books of MarketData.
+	Analytics *analytics.Analyzer
+	// Quality checks the data of MarketData. Its Run loop, flagging the
+	// symbols without updates, is started by the commands that need it.
+	Quality *dq.Monitor
+
+	// Risk checks every order sent by Orders.
+	Risk   *risk.Checker
+	Orders OrderService
+	// OrderStore records the orders, nil when they are only kept in memory.
+	OrderStore orderstore.Store
+	// Positions are kept from the fills of Orders and reconciled with the
+	// venue by PositionReports.
+	Positions       *position.Keeper
+	PositionReports PositionService
+
+	// Throttle limits the rate of the messages sent.
+	Throttle *throttle.Throttler
+	// Rejects matches the rejects of the venue with the requests sent and
+	// fails them, pausing Throttle when the venue throttles.
+	Rejects RejectService
+
+	// DB is the database of the db section, only opened when a service
+	// needs it, nil otherwise.
+	DB *sql.DB
+
+	// Bars aggregates the trades published by MarketData. Its Run loop is
+	// started by the commands that need closed bars.
+	Bars *bar.Aggregator
+
+	// Schedule connects Fix during the sessions of Calendar, nil without a
+	// calendar. Its Run loop is started instead of Fix by the commands
+	// following the venue sessions.
+	Schedule *SessionScheduler
+}
+
+// NewServices creates all services and registers their routers.
+func NewServices(ctx context.Context, cfg *config.Config) (*Services, error) {
+	ids, err := idgen.New(*cfg.IDs)
+	if err != nil {
+		return nil, err
+	}
+	var cal *calendar.Calendar
+	if cfg.Calendar.Path != "" {
+		if cal, err = calendar.Load(cfg.Calendar.Path); err != nil {
+			return nil, err
+		}
+	}
+	bus := event.NewBus()
+	s := &Services{
+		Bus:       bus,
+		IDs:       ids,
+		Calendar:  cal,
+		Heartbeat: NewHeartbeatService(),
+		Tape:      tape.New(cfg.MarketData.TapeSize),
+	}
+	s.Throttle = s.newThrottler(cfg.Throttle)
+	s.SecurityList = NewSecurityListService(s.Throttle, ids)
+	s.TradingSession = NewTradingSessionService(s.Throttle, ids, bus)
+	if s.Analytics, err = newAnalyzer(cfg.MarketData.Analytics); err != nil {
+		return nil, err
+	}
+	s.SecurityStatus = NewSecurityStatusService(s.SecurityList, s.Throttle, ids, bus)
+	s.Quality = s.newQualityMonitor(cfg.MarketData)
+	s.MarketData = NewMarketDataService(bus, s.Tape, s.Analytics, s.Quality, s.Throttle, ids)
+	positions, err := s.newPositionKeeper(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	s.Positions = positions
+	s.PositionReports = NewPositionService(s.Positions, s.Throttle, ids, bus)
+	s.Risk = s.newRiskChecker(cfg.Risk)
+	if s.OrderStore, err = s.newOrderStore(ctx, cfg); err != nil {
+		return nil, err
+	}
+	s.Orders = NewOrderService(s.Risk, s.Positions, s.OrderStore, s.Throttle, ids, bus)
+	if err := s.Orders.Recover(ctx); err != nil {
+		return nil, err
+	}
+
+	s.Rejects = NewRejectService(s.Throttle, bus)
+
+	bars, err := newBarAggregator(bus, cfg.MarketData.Bars, cal)
+	if err != nil {
+		return nil, err
+	}
+	s.Bars = bars
+
+	fixSrv, err := NewFIXService(cfg.Fix, s.Heartbeat, s.SecurityList, ids)
+	if err != nil {
+		return nil, err
+	}
+	s.Fix = fixSrv
+	s.Fix.RegisterRouters(ctx)
+	if cal != nil {
+		s.Schedule = NewSessionScheduler(s.Fix, cal)
+	}
+
+	for _, srv := range []RouterService{s.SecurityStatus, s.TradingSession, s.MarketData, s.Orders, s.PositionReports, s.Rejects} {
+		srv.RegisterRouters(s.Fix.Application().AddRouter)
+	}
+	s.Fix.Application().AddFilter(s.Orders.Filter)
+	// Last, so the messages sent without Throttle.Send only take tokens once
+	// the other filters let them through. Those sent with it took theirs
+	// before the filters ran, and get them back when one blocks them.
+	s.Fix.Application().AddFilter(s.Throttle.Filter)
+	s.Fix.Application().AddLogoutHandler(s.Orders.OnLogout)
+	s.Fix.Application().AddObserver(s.Rejects.Observe)
+	s.Fix.Application().AddLogoutHandler(s.Rejects.OnLogout)
+	s.Rejects.AddHandler(s.Orders.OnReject)
+	s.Rejects.AddHandler(s.PositionReports.OnReject)
+	s.Rejects.AddHandler(s.MarketData.OnReject)
+	s.Rejects.AddHandler(s.SecurityList.OnReject)
+
+	return s, nil
+}
+
+// Snapshot returns the state of every service implementing Snapshotter.
+func (s *Services) Snapshot() map[string]any {
+	named := map[string]any{
+		"securityList":   s.SecurityList,
+		"tradingSession": s.TradingSession,
+		"marketData":     s.MarketData,
+		"bars":           s.Bars,
+		"analytics":      s.Analytics,
+		"quality":        s.Quality,
+		"risk":           s.Risk,
+		"orders":         s.Orders,
+		"positions":      s.Positions,
+		"throttle":       s.Throttle,
+	}
+
+	out := make(map[string]any, len(named))
+	for name, srv := range named {
+		if sn, ok := srv.(Snapshotter); ok {
+			out[name] = sn.Snapshot()
+		}
+	}
+	return out
+}
+
+// newAnalyzer computes the volatility when both its window and its sample
+// are set, setting only one of them being an error.
+func newAnalyzer(cfg config.Analytics) (*analytics.Analyzer, error) {
+	var opts []analytics.AnalyzerOpt
+	if cfg.Levels > 0 {
+		opts = append(opts, analytics.WithLevels(cfg.Levels))
+	}
+	switch {
+	case cfg.VolWindow > 0 && cfg.VolSample > 0:
+		opts = append(opts, analytics.WithVolatility(cfg.VolWindow, cfg.VolSample))
+	case cfg.VolWindow > 0 || cfg.VolSample > 0:
+		return nil, errors.New("error configuring analytics: vol-window and vol-sample must be set together")
+	}
+	return analytics.NewAnalyzer(opts...), nil
+}
+
+// newQualityMonitor only flags stale symbols while they are not halted, the
+// configured trading session is open and so is the calendar.
+func (s *Services) newQualityMonitor(cfg *config.MarketData) *dq.Monitor {
+	quality := cfg.Quality
+	opts := []dq.MonitorOpt{
+		dq.WithSymbols(cfg.Symbols...),
+		dq.WithPriceJump(quality.JumpTicks, quality.JumpPercent, func(symbol string) decimal.Decimal {
+			instrument, _ := s.SecurityList.Security(symbol)
+			return instrument.TickSize
+		}),
+		dq.WithTradingHours(func(symbol string) bool {
+			if s.Calendar != nil && !s.Calendar.Open(time.Now()) {
+				return false
+			}
+			return s.trading(symbol, quality.TradingSessionID)
+		}),
+	}
+	if quality.StaleAfter > 0 {
+		opts = append(opts, dq.WithStaleAfter(quality.StaleAfter))
+	}
+	return dq.NewMonitor(s.Bus, opts...)
+}
+
+// newThrottler follows the throttle section of the configuration.
+func (s *Services) newThrottler(cfg *config.Throttle) *throttle.Throttler {
+	throttler := throttle.NewThrottler(*cfg, s.Bus)
+	config.OnChange(func(e config.ChangeEvent) {
+		if e.Changed(config.SectionThrottle) {
+			throttler.Apply(*e.New.Throttle)
+		}
+	})
+	return throttler
+}
+
+// newRiskChecker follows the risk section of the configuration. Orders are
+// checked against the security master and the trading status, collared
+// around the last trade or the mid.
+func (s *Services) newRiskChecker(cfg *config.Risk) *risk.Checker {
+	checker := risk.NewChecker(*cfg,
+		risk.WithInstruments(s.SecurityList.Security),
+		risk.WithTradingHours(func(symbol string) bool {
+			return s.trading(symbol, config.GetConfig().Risk.TradingSessionID)
+		}),
+		risk.WithReferencePrice(s.referencePrice),
+		risk.WithOpenOrders(func() []domain.Order { return s.Orders.Orders(true) }),
+		risk.WithExposure(s.Positions),
+	)
+	config.OnChange(func(e config.ChangeEvent) {
+		if e.Changed(config.SectionRisk) {
+			checker.Apply(*e.New.Risk)
+		}
+	})
+	return checker
+}
+
+// Close closes the order store and the database.
+func (s *Services) Close() error {
+	var errs []error
+	if s.OrderStore != nil {
+		errs = append(errs, s.OrderStore.Close())
+	}
+	if s.DB != nil {
+		errs = append(errs, s.DB.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// newOrderStore opens the store of the orders section, nil without one.
+func (s *Services) newOrderStore(ctx context.Context, cfg *config.Config) (orderstore.Store, error) {
+	switch cfg.Orders.Store {
+	case "":
+		return nil, nil
+	case orderstore.TypeJournal:
+		path := cfg.Orders.JournalPath
+		if path == "" {
+			path = "orders.journal"
+		}
+		return orderstore.NewJournal(path)
+	case orderstore.TypeSQL:
+		database, err := s.database(ctx, cfg.Db)
+		if err != nil {
+			return nil, err
+		}
+		return orderstore.NewSQLStore(ctx, database)
+	default:
+		return nil, fmt.Errorf("unknown order store %q", cfg.Orders.Store)
+	}
+}
+
+// newPositionKeeper marks the positions at the mid of the books and loads
+// the start of day positions from a file or the database.
+func (s *Services) newPositionKeeper(ctx context.Context, cfg *config.Config) (*position.Keeper, error) {
+	opts := []position.KeeperOpt{position.WithMarks(s.mid)}
+	if s.Calendar != nil {
+		opts = append(opts, position.WithTradingDays(s.Calendar.DayStart))
+	}
+	keeper := position.NewKeeper(opts...)
+
+	var sod []domain.Position
+	var err error
+	switch {
+	case cfg.Positions.SODPath != "":
+		sod, err = position.LoadFile(cfg.Positions.SODPath)
+	case cfg.Positions.SODTable != "":
+		var database *sql.DB
+		if database, err = s.database(ctx, cfg.Db); err == nil {
+			sod, err = position.LoadTable(ctx, database, cfg.Positions.SODTable)
+		}
+	default:
+		return keeper, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	keeper.Load(sod)
+	return keeper, nil
+}
+
+// database returns DB, opening it on first use.
+func (s *Services) database(ctx context.Context, cfg *config.Db) (*sql.DB, error) {
+	if s.DB != nil {
+		return s.DB, nil
+	}
+	if !db.Configured(cfg) {
+		return nil, errors.New("no database configured in the db section")
+	}
+	database, err := db.Open(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	s.DB = database
+	return database, nil
+}
+
+// mid returns the mid of the book of symbol.
+func (s *Services) mid(symbol string) (decimal.Decimal, bool) {
+	b, ok := s.MarketData.Book(symbol, 1)
+	if !ok {
+		return decimal.Zero, false
+	}
+	mid := b.Quote().Mid()
+	return mid, mid.IsPositive()
+}
+
+// trading reports whether symbol is not halted and the trading session
+// tradingSessionID, if set, is open.
+func (s *Services) trading(symbol, tradingSessionID string) bool {
+	if s.SecurityList.Halted(symbol) {
+		return false
+	}
+	return tradingSessionID == "" || s.TradingSession.Open(tradingSessionID)
+}
+
+// referencePrice returns the last trade of symbol, or the mid of its book.
+func (s *Services) referencePrice(symbol string) (decimal.Decimal, bool) {
+	if trades := s.Tape.Last(symbol, 1); len(trades) > 0 {
+		return trades[0].Price, true
+	}
+	return s.mid(symbol)
+}
+
+// newBarAggregator aligns bars to the trading days of cal, or to midnight in
+// the time zone of the bars without one.
+func newBarAggregator(bus *event.Bus, cfg config.Bars, cal *calendar.Calendar) (*bar.Aggregator, error) {
+	intervals, err := bar.ParseIntervals(cfg.Intervals)
+	if err != nil {
+		return nil, err
+	}
+	var days bar.Calendar = cal
+	if cal == nil {
+		loc := time.UTC
+		if cfg.TimeZone != "" {
+			if loc, err = time.LoadLocation(cfg.TimeZone); err != nil {
+				return nil, fmt.Errorf("error loading bar time zone: %w", err)
+			}
+		}
+		days = bar.LocationCalendar{Location: loc}
+	}
+
+	opts := []bar.AggregatorOpt{
+		bar.WithIntervals(intervals...),
+		bar.WithCalendar(days),
+	}
+	if cfg.Grace > 0 {
+		opts = append(opts, bar.WithGrace(cfg.Grace))
+	}
+	if cfg.StorePath != "" {
+		store, err := bar.NewFileStore(cfg.StorePath)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, bar.WithStore(store))
+	}
+	return bar.NewAggregator(bus, opts...), nil
+}
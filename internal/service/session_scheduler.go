@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/calendar"
+)
+
+// connectRetry is how long the scheduler waits to connect again after failing
+// to create or start the FIX client during a session.
+const connectRetry = 30 * time.Second
+
+// SessionScheduler connects the FIX session while the trading calendar is
+// open, logs it out while it is closed and resets its sequence numbers every
+// day at the reset time of the venue, logging it back on with
+// ResetSeqNumFlag=Y, or on the next logon when it is logged out then.
+type SessionScheduler struct {
+	fix FixService
+	cal *calendar.Calendar
+	now func() time.Time
+}
+
+func NewSessionScheduler(fix FixService, cal *calendar.Calendar) *SessionScheduler {
+	return &SessionScheduler{
+		fix: fix,
+		cal: cal,
+		now: time.Now,
+	}
+}
+
+// Run follows the calendar until ctx is done. It replaces Start of the FIX
+// service, which must still be stopped.
+func (s *SessionScheduler) Run(ctx context.Context) {
+	for {
+		now := s.now()
+		wake := s.cal.NextChange(now)
+		if s.cal.Open(now) {
+			if err := s.fix.Connect(); err != nil {
+				fixLog.Errorf("Error connecting for the session: %v", err)
+				wake = now.Add(connectRetry)
+			}
+		} else if s.fix.Connected() {
+			fixLog.Infof("Venue closed, logging out until %s", formatWake(wake))
+			s.fix.Disconnect()
+		}
+		reset, hasReset := s.cal.NextSequenceReset(now)
+		if hasReset && (wake.IsZero() || reset.Before(wake)) {
+			wake = reset
+		}
+
+		var timer *time.Timer
+		var fire <-chan time.Time
+		if !wake.IsZero() {
+			timer = time.NewTimer(wake.Sub(now))
+			fire = timer.C
+		}
+		select {
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return
+		case <-fire:
+		}
+		if hasReset && !s.now().Before(reset) {
+			s.fix.ResetSequence()
+		}
+	}
+}
+
+func formatWake(t time.Time) string {
+	if t.IsZero() {
+		return "further notice"
+	}
+	return t.Format(time.RFC3339)
+}
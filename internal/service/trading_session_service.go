@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+	"github.com/phimaker/waanx-fix-simpler/internal/idgen"
+	"github.com/phimaker/waanx-fix-simpler/internal/throttle"
+	"github.com/quickfixgo/enum"
+	"github.com/quickfixgo/field"
+	"github.com/quickfixgo/fix44/tradingsessionstatus"
+	"github.com/quickfixgo/fix44/tradingsessionstatusrequest"
+	"github.com/quickfixgo/quickfix"
+)
+
+// TradingSessionService tracks the phase of the venue trading sessions and
+// publishes an event.SessionStatus on every change.
+type TradingSessionService interface {
+	RouterService
+
+	TradingSessionStatusRequest(ctx context.Context, sessionID quickfix.SessionID) (string, error)
+
+	// Open reports whether the trading session is open. Before any status has
+	// been received every session is assumed open.
+	Open(tradingSessionID string) bool
+}
+
+type tradingSession struct {
+	TradingSessionID string             `json:"tradingSessionId"`
+	Status           enum.TradSesStatus `json:"status"`
+	Updated          time.Time          `json:"updated"`
+}
+
+type tradingSessionServiceImpl struct {
+	mu       sync.RWMutex
+	sessions map[string]tradingSession
+	throttle *throttle.Throttler
+	ids      *idgen.Generator
+	bus      *event.Bus
+}
+
+func NewTradingSessionService(throttler *throttle.Throttler, ids *idgen.Generator, bus *event.Bus) TradingSessionService {
+	return &tradingSessionServiceImpl{
+		sessions: make(map[string]tradingSession),
+		throttle: throttler,
+		ids:      ids,
+		bus:      bus,
+	}
+}
+
+// Snapshot implements Snapshotter.
+func (srv *tradingSessionServiceImpl) Snapshot() any {
+	srv.mu.RLock()
+	defer srv.mu.RUnlock()
+	out := make([]tradingSession, 0, len(srv.sessions))
+	for _, s := range srv.sessions {
+		out = append(out, s)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].TradingSessionID < out[j].TradingSessionID })
+	return out
+}
+
+func (srv *tradingSessionServiceImpl) RegisterRouters(route func(beginString string, msgType string, router quickfix.MessageRoute)) {
+	route(tradingsessionstatus.Route(srv.OnTradingSessionStatus))
+}
+
+func (srv *tradingSessionServiceImpl) OnTradingSessionStatus(msg tradingsessionstatus.TradingSessionStatus, sessionID quickfix.SessionID) quickfix.MessageRejectError {
+	id, err := msg.GetTradingSessionID()
+	if err != nil {
+		mdLog.Errorf("TradingSessionStatus without TradingSessionID: %v", err)
+		return err
+	}
+	status, err := msg.GetTradSesStatus()
+	if err != nil {
+		mdLog.Errorf("TradingSessionStatus without TradSesStatus: %v", err)
+		return err
+	}
+	if status == enum.TradSesStatus_REQUEST_REJECTED {
+		mdLog.Errorf("TradingSessionStatusRequest rejected for %s: %s", id, useExactValueIgnoreError(msg.GetText))
+		return nil
+	}
+
+	s := tradingSession{TradingSessionID: string(id), Status: status, Updated: time.Now()}
+	srv.mu.Lock()
+	prev, seen := srv.sessions[s.TradingSessionID]
+	srv.sessions[s.TradingSessionID] = s
+	srv.mu.Unlock()
+	if seen && prev.Status == status {
+		return nil
+	}
+
+	mdLog.Infof("[SESSION] %s: %s", s.TradingSessionID, tradSesStatusName(status))
+	srv.bus.Publish(event.SessionStatus{
+		TradingSessionID: s.TradingSessionID,
+		Status:           tradSesStatusName(status),
+		Open:             status == enum.TradSesStatus_OPEN,
+		Time:             s.Updated,
+	})
+	return nil
+}
+
+func (srv *tradingSessionServiceImpl) Open(tradingSessionID string) bool {
+	srv.mu.RLock()
+	defer srv.mu.RUnlock()
+	s, ok := srv.sessions[tradingSessionID]
+	return !ok || s.Status == enum.TradSesStatus_OPEN
+}
+
+func (srv *tradingSessionServiceImpl) TradingSessionStatusRequest(ctx context.Context, sessionID quickfix.SessionID) (string, error) {
+	reqID, err := srv.ids.Next(idgen.KindTradingSession)
+	if err != nil {
+		return "", err
+	}
+	req := tradingsessionstatusrequest.New(
+		field.NewTradSesReqID(reqID),
+		field.NewSubscriptionRequestType(enum.SubscriptionRequestType_SNAPSHOT_PLUS_UPDATES),
+	)
+
+	if err := srv.throttle.Send(ctx, req, sessionID); err != nil {
+		return "", fmt.Errorf("error sending trading session status request: %w", err)
+	}
+	return reqID, nil
+}
+
+var tradSesStatusNames = map[enum.TradSesStatus]string{
+	enum.TradSesStatus_UNKNOWN:          "UNKNOWN",
+	enum.TradSesStatus_HALTED:           "HALTED",
+	enum.TradSesStatus_OPEN:             "OPEN",
+	enum.TradSesStatus_CLOSED:           "CLOSED",
+	enum.TradSesStatus_PRE_OPEN:         "PRE_OPEN",
+	enum.TradSesStatus_PRE_CLOSE:        "PRE_CLOSE",
+	enum.TradSesStatus_REQUEST_REJECTED: "REQUEST_REJECTED",
+}
+
+func tradSesStatusName(status enum.TradSesStatus) string {
+	if name, ok := tradSesStatusNames[status]; ok {
+		return name
+	}
+	return string(status)
+}
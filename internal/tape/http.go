@@ -0,0 +1,52 @@
+package tape
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/infrastructure/api"
+)
+
+// Handler serves the tape of a symbol.
+//
+//	GET ?symbol=BTC-USDT&last=100          the last 100 trades (default 100)
+//	GET ?symbol=BTC-USDT&since=<RFC 3339>  the trades since a time
+//	GET                                    the symbols with trades
+func Handler(tp *Tape) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			api.WriteError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+
+		q := r.URL.Query()
+		symbol := q.Get("symbol")
+		if symbol == "" {
+			api.WriteJSON(w, http.StatusOK, tp.Symbols())
+			return
+		}
+
+		if s := q.Get("since"); s != "" {
+			since, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				api.WriteError(w, http.StatusBadRequest, fmt.Errorf("invalid since: %w", err))
+				return
+			}
+			api.WriteJSON(w, http.StatusOK, tp.Since(symbol, since))
+			return
+		}
+
+		n := 100
+		if s := q.Get("last"); s != "" {
+			var err error
+			if n, err = strconv.Atoi(s); err != nil || n < 0 {
+				api.WriteError(w, http.StatusBadRequest, fmt.Errorf("invalid last %q", s))
+				return
+			}
+		}
+		api.WriteJSON(w, http.StatusOK, tp.Last(symbol, n))
+	})
+}
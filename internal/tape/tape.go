@@ -0,0 +1,134 @@
+// Package tape keeps the recent trades of every symbol, time and sales style.
+package tape
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/metrics"
+)
+
+// DefaultSize is the number of trades kept per symbol.
+const DefaultSize = 1000
+
+var duplicateCounter = metrics.NewCounterVec("tape_duplicates")
+
+// Tape is a bounded ring of trades per symbol. Trades already seen, by
+// TradeID or by time, price and size when the venue sends no ID, are
+// rejected, so the same print received in a snapshot and an incremental or
+// again after a reconnect is only counted once.
+type Tape struct {
+	mu      sync.RWMutex
+	size    int
+	symbols map[string]*symbolTape
+}
+
+type symbolTape struct {
+	trades []domain.Trade // ring, next is the oldest once full
+	next   int
+	full   bool
+
+	// seen holds the keys of the last len(keys) trades, more than the ring
+	// so duplicates are caught after the trade itself has rotated out.
+	seen    map[string]struct{}
+	keys    []string
+	nextKey int
+}
+
+func New(size int) *Tape {
+	if size <= 0 {
+		size = DefaultSize
+	}
+	return &Tape{size: size, symbols: make(map[string]*symbolTape)}
+}
+
+// Add appends t to the tape of its symbol and reports false if it is a
+// duplicate.
+func (tp *Tape) Add(t domain.Trade) bool {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	st, ok := tp.symbols[t.Symbol]
+	if !ok {
+		st = &symbolTape{
+			trades: make([]domain.Trade, tp.size),
+			seen:   make(map[string]struct{}, 4*tp.size),
+			keys:   make([]string, 4*tp.size),
+		}
+		tp.symbols[t.Symbol] = st
+	}
+
+	k := key(t)
+	if _, dup := st.seen[k]; dup {
+		duplicateCounter.Inc(t.Symbol)
+		return false
+	}
+	if old := st.keys[st.nextKey]; old != "" {
+		delete(st.seen, old)
+	}
+	st.keys[st.nextKey] = k
+	st.nextKey = (st.nextKey + 1) % len(st.keys)
+	st.seen[k] = struct{}{}
+
+	st.trades[st.next] = t
+	st.next = (st.next + 1) % len(st.trades)
+	if st.next == 0 {
+		st.full = true
+	}
+	return true
+}
+
+// Last returns the last n trades of symbol, oldest first.
+func (tp *Tape) Last(symbol string, n int) []domain.Trade {
+	all := tp.all(symbol)
+	if n > 0 && n < len(all) {
+		all = all[len(all)-n:]
+	}
+	return all
+}
+
+// Since returns the trades of symbol at or after since, oldest first.
+func (tp *Tape) Since(symbol string, since time.Time) []domain.Trade {
+	all := tp.all(symbol)
+	i := sort.Search(len(all), func(i int) bool { return !all[i].Time.Before(since) })
+	return all[i:]
+}
+
+// Symbols returns the symbols with trades.
+func (tp *Tape) Symbols() []string {
+	tp.mu.RLock()
+	defer tp.mu.RUnlock()
+	out := make([]string, 0, len(tp.symbols))
+	for s := range tp.symbols {
+		out = append(out, s)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// all returns the trades of symbol sorted by trade time.
+func (tp *Tape) all(symbol string) []domain.Trade {
+	tp.mu.RLock()
+	defer tp.mu.RUnlock()
+	st, ok := tp.symbols[symbol]
+	if !ok {
+		return nil
+	}
+
+	var out []domain.Trade
+	if st.full {
+		out = append(out, st.trades[st.next:]...)
+	}
+	out = append(out, st.trades[:st.next]...)
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Time.Before(out[j].Time) })
+	return out
+}
+
+func key(t domain.Trade) string {
+	if t.TradeID != "" {
+		return t.TradeID
+	}
+	return t.Time.UTC().Format(time.RFC3339Nano) + "|" + t.Price.String() + "|" + t.Size.String()
+}
@@ -0,0 +1,111 @@
+package tape
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+var t0 = time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC)
+
+func trade(id string, offset time.Duration, price string) domain.Trade {
+	return domain.Trade{Symbol: "BTC-USDT", TradeID: id, Price: decimal.RequireFromString(price),
+		Size: decimal.NewFromInt(1), Time: t0.Add(offset)}
+}
+
+func ids(trades []domain.Trade) []string {
+	var out []string
+	for _, t := range trades {
+		out = append(out, t.TradeID+"@"+t.Price.String())
+	}
+	return out
+}
+
+func TestTapeAdd(t *testing.T) {
+	tests := []struct {
+		name   string
+		size   int
+		trades []domain.Trade
+		added  []bool
+		last   []string
+	}{
+		{
+			name:   "duplicate TradeID",
+			size:   10,
+			trades: []domain.Trade{trade("T1", 0, "100"), trade("T2", time.Second, "101"), trade("T1", 2*time.Second, "102")},
+			added:  []bool{true, true, false},
+			last:   []string{"T1@100", "T2@101"},
+		},
+		{
+			name:   "duplicate without TradeID",
+			size:   10,
+			trades: []domain.Trade{trade("", 0, "100"), trade("", 0, "100"), trade("", 0, "101")},
+			added:  []bool{true, false, true},
+			last:   []string{"@100", "@101"},
+		},
+		{
+			name:   "sorted by trade time",
+			size:   10,
+			trades: []domain.Trade{trade("T2", time.Second, "101"), trade("T1", 0, "100")},
+			added:  []bool{true, true},
+			last:   []string{"T1@100", "T2@101"},
+		},
+		{
+			name: "ring keeps the last trades and their keys",
+			size: 2,
+			trades: []domain.Trade{
+				trade("T1", 0, "100"), trade("T2", time.Second, "101"), trade("T3", 2*time.Second, "102"),
+				trade("T1", 3*time.Second, "100"),
+			},
+			added: []bool{true, true, true, false},
+			last:  []string{"T2@101", "T3@102"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp := New(tt.size)
+			var added []bool
+			for _, tr := range tt.trades {
+				added = append(added, tp.Add(tr))
+			}
+			if !reflect.DeepEqual(added, tt.added) {
+				t.Errorf("Add() = %v, want %v", added, tt.added)
+			}
+			if got := ids(tp.Last("BTC-USDT", 0)); !reflect.DeepEqual(got, tt.last) {
+				t.Errorf("Last() = %v, want %v", got, tt.last)
+			}
+		})
+	}
+}
+
+func TestTapeQueries(t *testing.T) {
+	tp := New(10)
+	for i, price := range []string{"100", "101", "102", "103"} {
+		tp.Add(trade("T"+price, time.Duration(i)*time.Second, price))
+	}
+
+	tests := []struct {
+		name string
+		got  []domain.Trade
+		want []string
+	}{
+		{name: "last 2", got: tp.Last("BTC-USDT", 2), want: []string{"T102@102", "T103@103"}},
+		{name: "last more than kept", got: tp.Last("BTC-USDT", 10), want: []string{"T100@100", "T101@101", "T102@102", "T103@103"}},
+		{name: "since", got: tp.Since("BTC-USDT", t0.Add(2*time.Second)), want: []string{"T102@102", "T103@103"}},
+		{name: "since after the last", got: tp.Since("BTC-USDT", t0.Add(time.Minute)), want: nil},
+		{name: "unknown symbol", got: tp.Last("ETH-USDT", 2), want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ids(tt.got); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got := tp.Symbols(); !reflect.DeepEqual(got, []string{"BTC-USDT"}) {
+		t.Errorf("Symbols() = %v", got)
+	}
+}
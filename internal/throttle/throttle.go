@@ -0,0 +1,329 @@
+// Package throttle limits the rate of the messages sent to the venue with
+// token buckets for the session and by MsgType.
+package throttle
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/config"
+	"github.com/phimaker/waanx-fix-simpler/internal/domain"
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+	"github.com/phimaker/waanx-fix-simpler/internal/logger"
+	"github.com/phimaker/waanx-fix-simpler/internal/metrics"
+	"github.com/quickfixgo/quickfix"
+	"github.com/quickfixgo/tag"
+)
+
+// Policies of config.Throttle.
+const (
+	PolicyQueue  = "queue"
+	PolicyReject = "reject"
+)
+
+var (
+	log = logger.Named(logger.ComponentFix)
+
+	queuedCounter   = metrics.NewCounterVec("throttle_queued")
+	rejectedCounter = metrics.NewCounterVec("throttle_rejected")
+	backoffCounter  = metrics.NewCounterVec("throttle_venue_rejects")
+)
+
+// Rejection is the error of a message not sent because of a limit.
+type Rejection struct {
+	MsgType string        `json:"msgType"`
+	Wait    time.Duration `json:"wait"` // until the limit would allow it
+}
+
+func (r *Rejection) Error() string {
+	return fmt.Sprintf("throttled %s, limit allows it in %s", r.MsgType, r.Wait)
+}
+
+// bucket holds up to burst tokens, refilled at rate per second. Tokens go
+// negative for the messages queued.
+type bucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(l config.Limit, now time.Time) *bucket {
+	if l.Rate <= 0 {
+		return nil
+	}
+	per := l.Per
+	if per <= 0 {
+		per = time.Second
+	}
+	burst := l.Burst
+	if burst <= 0 {
+		burst = l.Rate
+	}
+	return &bucket{
+		rate:   float64(l.Rate) / per.Seconds(),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   now,
+	}
+}
+
+func (b *bucket) refill(now time.Time) {
+	b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+}
+
+// wait returns how long until a token is available.
+func (b *bucket) wait() time.Duration {
+	if b.tokens >= 1 {
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// Throttler applies the limits of config.Throttle to the application
+// messages sent. It is safe for concurrent use.
+type Throttler struct {
+	mu       sync.Mutex
+	cfg      config.Throttle
+	session  *bucket
+	msgTypes map[string]*bucket // by lower case MsgType
+	paused   map[string]time.Time
+	// sending holds the messages being sent by Send, which took their
+	// tokens already.
+	sending map[*quickfix.Message]struct{}
+	now     func() time.Time
+	after   func(time.Duration) <-chan time.Time
+	bus     *event.Bus
+}
+
+type ThrottlerOpt func(*Throttler)
+
+// WithClock sets the clock the buckets are refilled by and the timer of the
+// messages queued.
+func WithClock(now func() time.Time, after func(time.Duration) <-chan time.Time) ThrottlerOpt {
+	return func(t *Throttler) {
+		t.now, t.after = now, after
+	}
+}
+
+func NewThrottler(cfg config.Throttle, bus *event.Bus, opts ...ThrottlerOpt) *Throttler {
+	t := &Throttler{
+		paused:  make(map[string]time.Time),
+		sending: make(map[*quickfix.Message]struct{}),
+		now:     time.Now,
+		after:   time.After,
+		bus:     bus,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	t.Apply(cfg)
+	return t
+}
+
+// Apply replaces the limits, e.g. after a configuration reload. The tokens
+// left are kept, up to the new bursts.
+func (t *Throttler) Apply(cfg config.Throttle) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	t.cfg = cfg
+	t.session = carry(t.session, newBucket(cfg.Session, now), now)
+	msgTypes := make(map[string]*bucket, len(cfg.MsgTypes))
+	for msgType, l := range cfg.MsgTypes {
+		msgType = strings.ToLower(msgType)
+		msgTypes[msgType] = carry(t.msgTypes[msgType], newBucket(l, now), now)
+	}
+	t.msgTypes = msgTypes
+}
+
+func carry(prev, next *bucket, now time.Time) *bucket {
+	if prev != nil && next != nil {
+		prev.refill(now)
+		next.tokens = min(next.burst, prev.tokens)
+	}
+	return next
+}
+
+// Send sends msg once the limits allow it, waiting up to MaxWait with the
+// queue policy, and fails with a *Rejection beyond or with the error of ctx.
+// The wait is taken by the caller rather than in the send path of the
+// session. A message failing to be sent, e.g. blocked by the risk checks of
+// an OutgoingFilter, gets its tokens back. A nil Throttler sends at once.
+func (t *Throttler) Send(ctx context.Context, msg quickfix.Messagable, sessionID quickfix.SessionID) error {
+	m := msg.ToMessage()
+	if t == nil {
+		return quickfix.SendToTarget(m, sessionID)
+	}
+	msgType, reject := m.MsgType()
+	if reject != nil {
+		return quickfix.SendToTarget(m, sessionID)
+	}
+	buckets, err := t.wait(ctx, msgType)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	t.sending[m] = struct{}{}
+	t.mu.Unlock()
+	sendErr := quickfix.SendToTarget(m, sessionID)
+	t.mu.Lock()
+	delete(t.sending, m)
+	t.mu.Unlock()
+	if sendErr != nil {
+		t.refund(buckets)
+	}
+	return sendErr
+}
+
+// wait takes a token of the limits of msgType, waits until it is due and
+// returns the buckets it was taken from.
+func (t *Throttler) wait(ctx context.Context, msgType string) ([]*bucket, error) {
+	wait, buckets, err := t.take(msgType, true)
+	if err != nil || wait <= 0 {
+		return buckets, err
+	}
+	queuedCounter.Inc(msgType)
+	log.Infof("[THROTTLED] Delaying %s by %s", msgType, wait)
+	t.bus.Publish(event.Throttle{MsgType: msgType, Action: event.ThrottleQueued, Wait: wait, Time: t.now().UTC()})
+	select {
+	case <-t.after(wait):
+		return buckets, nil
+	case <-ctx.Done():
+		t.refund(buckets)
+		return nil, ctx.Err()
+	}
+}
+
+// refund gives back the tokens taken from buckets by a message not sent.
+func (t *Throttler) refund(buckets []*bucket) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, b := range buckets {
+		b.tokens = min(b.burst, b.tokens+1)
+	}
+}
+
+// take takes a token of the buckets of msgType and returns how long the
+// message must wait for it. It fails with a *Rejection when the message is
+// over a limit and cannot wait, queue false forbidding any wait.
+func (t *Throttler) take(msgType string, queue bool) (time.Duration, []*bucket, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	buckets := make([]*bucket, 0, 2)
+	for _, b := range []*bucket{t.session, t.msgTypes[strings.ToLower(msgType)]} {
+		if b != nil {
+			b.refill(now)
+			buckets = append(buckets, b)
+		}
+	}
+	var wait time.Duration
+	if until, ok := t.paused[msgType]; ok {
+		if wait = until.Sub(now); wait <= 0 {
+			delete(t.paused, msgType)
+		}
+	}
+	for _, b := range buckets {
+		wait = max(wait, b.wait())
+	}
+	if wait > 0 && (!queue || t.cfg.Policy == PolicyReject || wait > t.cfg.MaxWait) {
+		rejectedCounter.Inc(msgType)
+		log.Warnf("[THROTTLED] Rejecting %s, limit allows it in %s", msgType, wait)
+		t.bus.Publish(event.Throttle{MsgType: msgType, Action: event.ThrottleRejected, Wait: wait, Time: now.UTC()})
+		return wait, nil, &Rejection{MsgType: msgType, Wait: wait}
+	}
+	for _, b := range buckets {
+		b.tokens--
+	}
+	return wait, buckets, nil
+}
+
+// Filter is the fix.OutgoingFilter applying the limits to the application
+// messages not sent by Send: one over a limit is rejected with a *Rejection,
+// as waiting would hold the send path of the session, heartbeats included.
+// Resent messages (PossDupFlag) are not limited, quickfix gap filling the
+// ones failing.
+func (t *Throttler) Filter(msg *quickfix.Message, sessionID quickfix.SessionID) error {
+	msgType, err := msg.MsgType()
+	if err != nil {
+		return nil
+	}
+	if possDup, err := msg.Header.GetBool(tag.PossDupFlag); err == nil && possDup {
+		return nil
+	}
+	t.mu.Lock()
+	_, sending := t.sending[msg]
+	t.mu.Unlock()
+	if sending {
+		return nil
+	}
+	_, _, rejectErr := t.take(msgType, false)
+	return rejectErr
+}
+
+// Backoff pauses msgType for the configured backoff after the venue rejected
+// one of them for exceeding its limits.
+func (t *Throttler) Backoff(msgType, text string) {
+	t.mu.Lock()
+	now := t.now()
+	backoff := t.cfg.Backoff
+	t.paused[msgType] = now.Add(backoff)
+	t.mu.Unlock()
+
+	backoffCounter.Inc(msgType)
+	log.Warnf("[THROTTLED] Venue throttled %s, pausing it for %s: %s", msgType, backoff, text)
+	t.bus.Publish(event.Throttle{MsgType: msgType, Action: event.ThrottleBackoff, Wait: backoff, Text: text, Time: now.UTC()})
+}
+
+// Status is the state of a limit.
+type Status struct {
+	MsgType string  `json:"msgType,omitempty"` // empty for the session
+	Rate    float64 `json:"rate"`              // per second
+	Burst   float64 `json:"burst"`
+	Tokens  float64 `json:"tokens"`
+}
+
+// Snapshot implements service.Snapshotter.
+func (t *Throttler) Snapshot() any {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var out []Status
+	if t.session != nil {
+		out = append(out, Status{Rate: t.session.rate, Burst: t.session.burst, Tokens: t.session.tokens})
+	}
+	msgTypes := make([]string, 0, len(t.msgTypes))
+	for msgType, b := range t.msgTypes {
+		if b != nil {
+			msgTypes = append(msgTypes, msgType)
+		}
+	}
+	sort.Strings(msgTypes)
+	for _, msgType := range msgTypes {
+		b := t.msgTypes[msgType]
+		out = append(out, Status{MsgType: msgType, Rate: b.rate, Burst: b.burst, Tokens: b.tokens})
+	}
+	return out
+}
+
+// VenueThrottled reports whether the venue rejected a message for exceeding
+// its limits, by the reason of r or, for venues rejecting with another one,
+// its text.
+func VenueThrottled(r domain.Reject) bool {
+	if r.Reason == domain.RejectReasonThrottled {
+		return true
+	}
+	text := strings.ToLower(r.Text)
+	for _, s := range []string{"throttl", "rate limit", "too many"} {
+		if strings.Contains(text, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,175 @@
+package throttle
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/phimaker/waanx-fix-simpler/internal/config"
+	"github.com/phimaker/waanx-fix-simpler/internal/event"
+	"github.com/quickfixgo/quickfix"
+	"github.com/quickfixgo/tag"
+)
+
+// clock is a manual clock whose timers fire at once.
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time { return c.now }
+
+func (c *clock) After(time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+func newTestThrottler(cfg config.Throttle) (*Throttler, *clock) {
+	c := &clock{now: time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC)}
+	return NewThrottler(cfg, event.NewBus(), WithClock(c.Now, c.After)), c
+}
+
+func TestThrottlerTake(t *testing.T) {
+	type take struct {
+		after   time.Duration // advance of the clock before the take
+		msgType string
+		queue   bool
+		wait    time.Duration
+		reject  bool
+	}
+	tests := []struct {
+		name  string
+		cfg   config.Throttle
+		takes []take
+	}{
+		{
+			name: "no limits",
+			cfg:  config.Throttle{Policy: PolicyQueue, MaxWait: time.Second},
+			takes: []take{
+				{msgType: "D", queue: true},
+				{msgType: "D"},
+			},
+		},
+		{
+			name: "session burst then queued",
+			cfg:  config.Throttle{Session: config.Limit{Rate: 2}, Policy: PolicyQueue, MaxWait: time.Second},
+			takes: []take{
+				{msgType: "D", queue: true},
+				{msgType: "F", queue: true},
+				{msgType: "D", queue: true, wait: 500 * time.Millisecond},
+				{msgType: "D", queue: true, wait: time.Second},
+				{msgType: "D", queue: true, wait: 1500 * time.Millisecond, reject: true},
+			},
+		},
+		{
+			name: "refilled over time",
+			cfg:  config.Throttle{Session: config.Limit{Rate: 1}, Policy: PolicyQueue, MaxWait: time.Second},
+			takes: []take{
+				{msgType: "D", queue: true},
+				{after: 500 * time.Millisecond, msgType: "D", queue: true, wait: 500 * time.Millisecond},
+				{after: 2 * time.Second, msgType: "D", queue: true},
+			},
+		},
+		{
+			name: "rejected without queue",
+			cfg:  config.Throttle{Session: config.Limit{Rate: 1}, Policy: PolicyQueue, MaxWait: time.Second},
+			takes: []take{
+				{msgType: "D"},
+				{msgType: "D", wait: time.Second, reject: true},
+				{after: time.Second, msgType: "D"},
+			},
+		},
+		{
+			name: "reject policy",
+			cfg:  config.Throttle{Session: config.Limit{Rate: 1}, Policy: PolicyReject, MaxWait: time.Second},
+			takes: []take{
+				{msgType: "D", queue: true},
+				{msgType: "D", queue: true, wait: time.Second, reject: true},
+			},
+		},
+		{
+			name: "msg type limit case insensitive",
+			cfg: config.Throttle{
+				MsgTypes: map[string]config.Limit{"v": {Rate: 1, Per: time.Minute}},
+				Policy:   PolicyQueue,
+				MaxWait:  time.Second,
+			},
+			takes: []take{
+				{msgType: "V", queue: true},
+				{msgType: "D", queue: true},
+				{msgType: "V", queue: true, wait: time.Minute, reject: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th, c := newTestThrottler(tt.cfg)
+			for i, tk := range tt.takes {
+				c.now = c.now.Add(tk.after)
+				wait, _, err := th.take(tk.msgType, tk.queue)
+				var rejection *Rejection
+				if rejected := errors.As(err, &rejection); rejected != tk.reject {
+					t.Fatalf("take %d: error %v, want rejected %t", i, err, tk.reject)
+				}
+				if wait != tk.wait {
+					t.Fatalf("take %d: wait %s, want %s", i, wait, tk.wait)
+				}
+			}
+		})
+	}
+}
+
+func TestThrottlerBackoff(t *testing.T) {
+	th, c := newTestThrottler(config.Throttle{Policy: PolicyQueue, MaxWait: time.Second, Backoff: 2 * time.Second})
+	th.Backoff("D", "rate limit")
+
+	if _, _, err := th.take("D", true); err == nil {
+		t.Fatal("take during a backoff longer than max-wait succeeded")
+	}
+	if wait, _, err := th.take("F", true); err != nil || wait != 0 {
+		t.Fatalf("take of another MsgType = %s, %v", wait, err)
+	}
+	c.now = c.now.Add(time.Second + time.Millisecond)
+	if wait, _, err := th.take("D", true); err != nil || wait != 999*time.Millisecond {
+		t.Fatalf("take at the end of the backoff = %s, %v, want 999ms", wait, err)
+	}
+}
+
+func TestThrottlerWaitRefund(t *testing.T) {
+	th, _ := newTestThrottler(config.Throttle{Session: config.Limit{Rate: 1}, Policy: PolicyQueue, MaxWait: time.Second})
+
+	buckets, err := th.wait(context.Background(), "D")
+	if err != nil {
+		t.Fatal(err)
+	}
+	th.refund(buckets)
+	if wait, _, err := th.take("D", false); err != nil || wait != 0 {
+		t.Fatalf("take after a refund = %s, %v, want the refunded token", wait, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	th.after = func(time.Duration) <-chan time.Time { return nil }
+	if _, err := th.wait(ctx, "D"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("wait with a canceled context = %v", err)
+	}
+	if tokens := th.session.tokens; tokens != 0 {
+		t.Fatalf("tokens after a canceled wait = %v, want 0", tokens)
+	}
+}
+
+func TestThrottlerSendRefund(t *testing.T) {
+	th, _ := newTestThrottler(config.Throttle{Session: config.Limit{Rate: 1}, Policy: PolicyQueue, MaxWait: time.Second})
+	msg := quickfix.NewMessage()
+	msg.Header.SetString(tag.MsgType, "D")
+
+	// No such session, so SendToTarget fails as it does when a filter
+	// blocks the message.
+	if err := th.Send(context.Background(), msg, quickfix.SessionID{BeginString: "FIX.4.4", SenderCompID: "A", TargetCompID: "B"}); err == nil {
+		t.Fatal("Send() to an unknown session succeeded")
+	}
+	if tokens := th.session.tokens; tokens != 1 {
+		t.Fatalf("tokens after a failed send = %v, want 1", tokens)
+	}
+}